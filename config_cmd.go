@@ -0,0 +1,99 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+
+	"atlassian-mcp-server/internal/domain"
+	"atlassian-mcp-server/internal/infrastructure"
+)
+
+// runValidateConfigCommand implements the "validate-config" subcommand.
+// It strictly parses and validates the configuration and, with -probe, calls each
+// tool's current-user endpoint with its default credentials.
+func runValidateConfigCommand(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("validate-config", flag.ContinueOnError)
+	fs.SetOutput(stdout)
+	configPath := fs.String("config", "config.yaml", "Path to configuration file")
+	probe := fs.Bool("probe", false, "Check connectivity and credentials for each configured tool")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	config, err := domain.LoadConfig(*configPath)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "%s: configuration is valid\n", *configPath)
+
+	if !*probe {
+		return nil
+	}
+
+	var credentialStore domain.CredentialProvider
+	if config.Credentials.Backend != "" {
+		store, err := infrastructure.NewCredentialStore(config.Credentials)
+		if err != nil {
+			return fmt.Errorf("failed to open credential store: %w", err)
+		}
+		credentialStore = store
+	}
+	authManager := domain.NewAuthenticationManagerFromConfigWithProvider(config, credentialStore)
+
+	failed := 0
+	for _, tool := range []struct {
+		name      string
+		config    *domain.ToolConfig
+		newClient func(baseURL string, httpClient *http.Client) identityResolver
+	}{
+		{"jira", config.Tools.Jira, func(u string, c *http.Client) identityResolver { return infrastructure.NewJiraClient(u, c) }},
+		{"confluence", config.Tools.Confluence, func(u string, c *http.Client) identityResolver { return infrastructure.NewConfluenceClient(u, c) }},
+		{"bitbucket", config.Tools.Bitbucket, func(u string, c *http.Client) identityResolver { return infrastructure.NewBitbucketClient(u, c) }},
+		{"bamboo", config.Tools.Bamboo, func(u string, c *http.Client) identityResolver { return infrastructure.NewBambooClient(u, c) }},
+	} {
+		if tool.config == nil {
+			continue
+		}
+		if tool.config.Auth == nil {
+			fmt.Fprintf(stdout, "%s: skipped (no default credentials)\n", tool.name)
+			continue
+		}
+
+		identity, err := probeTool(tool.name, tool.config.BaseURL, authManager, tool.newClient)
+		if err != nil {
+			fmt.Fprintf(stdout, "%s: FAILED: %v\n", tool.name, err)
+			failed++
+			continue
+		}
+		fmt.Fprintf(stdout, "%s: ok (authenticated as %s)\n", tool.name, identity.Username)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d tool(s) failed the connectivity check", failed)
+	}
+
+	return nil
+}
+
+// probeTool resolves the identity behind a tool's default credentials.
+func probeTool(tool, baseURL string, authManager *domain.AuthenticationManager, newClient func(string, *http.Client) identityResolver) (*domain.Identity, error) {
+	httpClient, err := authManager.GetAuthenticatedClient(tool)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(baseURL, httpClient).WhoAmI()
+}
+
+// runConfigSchemaCommand implements the "config-schema" subcommand by writing
+// the configuration JSON Schema to stdout.
+func runConfigSchemaCommand(stdout io.Writer) error {
+	data, err := domain.MarshalConfigSchema()
+	if err != nil {
+		return fmt.Errorf("failed to generate schema: %w", err)
+	}
+
+	_, err = stdout.Write(data)
+	return err
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+
+	"atlassian-mcp-server/internal/domain"
+	"atlassian-mcp-server/internal/infrastructure"
+)
+
+// credentialsUsage describes the credentials subcommand.
+const credentialsUsage = `Usage: atlassian-mcp-server credentials <command> [flags]
+
+Manage credentials in the store configured under "credentials" in config.yaml.
+Tools reference stored credentials with "auth: {source: store}".
+
+Commands:
+  set <tool>      Store credentials for a tool; the password or token is read from stdin
+  list            List tools with stored credentials
+  remove <tool>   Delete the stored credentials for a tool
+
+Flags:
+  -config string  Path to configuration file (default "config.yaml")
+  -type string    Authentication type for set: "basic" or "token" (default "token")
+  -username string
+                  Username for basic authentication (set only)
+`
+
+// runCredentialsCommand implements the "credentials" subcommand.
+// Secrets are read from stdin so they never appear in the process list or shell history.
+func runCredentialsCommand(args []string, stdin io.Reader, stdout io.Writer) error {
+	if len(args) == 0 {
+		fmt.Fprint(stdout, credentialsUsage)
+		return fmt.Errorf("missing credentials command")
+	}
+
+	command := args[0]
+	fs := flag.NewFlagSet("credentials "+command, flag.ContinueOnError)
+	configPath := fs.String("config", "config.yaml", "Path to configuration file")
+	authType := fs.String("type", "token", "Authentication type: basic or token")
+	username := fs.String("username", "", "Username for basic authentication")
+	positional, err := parseInterspersed(fs, args[1:])
+	if err != nil {
+		return err
+	}
+
+	store, err := openCredentialStore(*configPath)
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case "set":
+		tool, err := toolArgument(positional)
+		if err != nil {
+			return err
+		}
+		if *authType == "basic" && *username == "" {
+			return fmt.Errorf("-username is required for basic authentication")
+		}
+
+		secret, err := readSecret(stdin)
+		if err != nil {
+			return err
+		}
+
+		creds := &domain.Credentials{Type: domain.ParseAuthType(*authType)}
+		switch *authType {
+		case "basic":
+			creds.Username = *username
+			creds.Password = secret
+		case "token":
+			creds.Token = secret
+		default:
+			return fmt.Errorf("invalid auth type '%s': must be 'basic' or 'token'", *authType)
+		}
+
+		if err := store.Set(tool, creds); err != nil {
+			return fmt.Errorf("failed to store credentials for %s: %w", tool, err)
+		}
+		fmt.Fprintf(stdout, "Stored %s credentials for %s\n", *authType, tool)
+
+	case "list":
+		tools, err := store.List()
+		if err != nil {
+			return fmt.Errorf("failed to list credentials: %w", err)
+		}
+		for _, tool := range tools {
+			fmt.Fprintln(stdout, tool)
+		}
+
+	case "remove":
+		tool, err := toolArgument(positional)
+		if err != nil {
+			return err
+		}
+
+		if err := store.Remove(tool); err != nil {
+			if errors.Is(err, domain.ErrCredentialNotFound) {
+				return fmt.Errorf("no stored credentials for %s", tool)
+			}
+			return fmt.Errorf("failed to remove credentials for %s: %w", tool, err)
+		}
+		fmt.Fprintf(stdout, "Removed credentials for %s\n", tool)
+
+	default:
+		fmt.Fprint(stdout, credentialsUsage)
+		return fmt.Errorf("unknown credentials command: %s", command)
+	}
+
+	return nil
+}
+
+// openCredentialStore reads only the credential store section of the configuration,
+// so credentials can be managed before the rest of the file is complete.
+func openCredentialStore(configPath string) (domain.CredentialStore, error) {
+	storeConfig, err := domain.LoadCredentialStoreConfig(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if storeConfig.Backend == "" {
+		return nil, fmt.Errorf("no credentials backend configured in %s", configPath)
+	}
+
+	return infrastructure.NewCredentialStore(*storeConfig)
+}
+
+// parseInterspersed parses flags both before and after positional arguments, so
+// "set jira -type token" and "set -type token jira" are equivalent, and returns the
+// positional arguments.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// toolArgument returns the single tool name positional argument.
+func toolArgument(positional []string) (string, error) {
+	if len(positional) != 1 {
+		return "", fmt.Errorf("expected exactly one tool name (jira, confluence, bitbucket or bamboo)")
+	}
+
+	tool := positional[0]
+	switch tool {
+	case "jira", "confluence", "bitbucket", "bamboo":
+		return tool, nil
+	default:
+		return "", fmt.Errorf("unknown tool '%s': must be jira, confluence, bitbucket or bamboo", tool)
+	}
+}
+
+// readSecret reads a single line secret from stdin. When stdin is a terminal the
+// secret is read with echo disabled so it never appears on screen or in scrollback.
+func readSecret(stdin io.Reader) (string, error) {
+	var line string
+	if f, ok := stdin.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		fmt.Fprint(os.Stderr, "Enter secret: ")
+		secret, err := term.ReadPassword(int(f.Fd()))
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return "", fmt.Errorf("failed to read secret: %w", err)
+		}
+		line = string(secret)
+	} else {
+		var err error
+		line, err = bufio.NewReader(stdin).ReadString('\n')
+		if err != nil && err != io.EOF {
+			return "", fmt.Errorf("failed to read secret: %w", err)
+		}
+	}
+
+	secret := strings.TrimRight(line, "\r\n")
+	if secret == "" {
+		return "", fmt.Errorf("secret must not be empty")
+	}
+
+	return secret, nil
+}
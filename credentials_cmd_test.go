@@ -0,0 +1,224 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"atlassian-mcp-server/internal/domain"
+	"atlassian-mcp-server/internal/infrastructure"
+)
+
+// writeCredentialsConfig writes a configuration using a file credential store in a
+// temporary directory and returns its path.
+func writeCredentialsConfig(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv(domain.DefaultCredentialsPassphraseEnv, "correct horse")
+
+	configPath := filepath.Join(dir, "config.yaml")
+	content := "credentials:\n  backend: file\n  path: " + filepath.Join(dir, "credentials.enc") + "\n"
+	if err := os.WriteFile(configPath, []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	return configPath
+}
+
+func TestParseInterspersed(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           []string
+		wantPositional []string
+		wantType       string
+		wantUsername   string
+		wantErr        bool
+	}{
+		{
+			name:           "flags before tool",
+			args:           []string{"-type", "basic", "-username", "jdoe", "jira"},
+			wantPositional: []string{"jira"},
+			wantType:       "basic",
+			wantUsername:   "jdoe",
+		},
+		{
+			name:           "flags after tool",
+			args:           []string{"jira", "-type", "basic", "-username", "jdoe"},
+			wantPositional: []string{"jira"},
+			wantType:       "basic",
+			wantUsername:   "jdoe",
+		},
+		{
+			name:           "flags around tool",
+			args:           []string{"-type", "basic", "jira", "-username=jdoe"},
+			wantPositional: []string{"jira"},
+			wantType:       "basic",
+			wantUsername:   "jdoe",
+		},
+		{
+			name:           "several positionals",
+			args:           []string{"jira", "bamboo", "-type", "token"},
+			wantPositional: []string{"jira", "bamboo"},
+			wantType:       "token",
+		},
+		{
+			name:     "no arguments",
+			args:     []string{},
+			wantType: "token",
+		},
+		{
+			name:    "unknown flag",
+			args:    []string{"jira", "-verbose"},
+			wantErr: true,
+		},
+		{
+			name:    "missing flag value",
+			args:    []string{"jira", "-username"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("credentials set", flag.ContinueOnError)
+			fs.SetOutput(&bytes.Buffer{})
+			authType := fs.String("type", "token", "")
+			username := fs.String("username", "", "")
+
+			positional, err := parseInterspersed(fs, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseInterspersed() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if strings.Join(positional, ",") != strings.Join(tt.wantPositional, ",") {
+				t.Errorf("positional = %v, want %v", positional, tt.wantPositional)
+			}
+			if *authType != tt.wantType || *username != tt.wantUsername {
+				t.Errorf("type = %q, username = %q, want %q, %q", *authType, *username, tt.wantType, tt.wantUsername)
+			}
+		})
+	}
+}
+
+func TestRunCredentialsCommand_Errors(t *testing.T) {
+	configPath := writeCredentialsConfig(t)
+
+	tests := []struct {
+		name    string
+		args    []string
+		stdin   string
+		wantErr string
+	}{
+		{
+			name:    "missing command",
+			args:    []string{},
+			wantErr: "missing credentials command",
+		},
+		{
+			name:    "unknown command",
+			args:    []string{"show", "-config", configPath},
+			wantErr: "unknown credentials command: show",
+		},
+		{
+			name:    "basic without username",
+			args:    []string{"set", "jira", "-type", "basic", "-config", configPath},
+			stdin:   "secret\n",
+			wantErr: "-username is required for basic authentication",
+		},
+		{
+			name:    "invalid auth type",
+			args:    []string{"set", "jira", "-type", "oauth", "-config", configPath},
+			stdin:   "secret\n",
+			wantErr: "invalid auth type 'oauth'",
+		},
+		{
+			name:    "missing tool",
+			args:    []string{"set", "-config", configPath},
+			stdin:   "secret\n",
+			wantErr: "expected exactly one tool name",
+		},
+		{
+			name:    "unknown tool",
+			args:    []string{"remove", "github", "-config", configPath},
+			wantErr: "unknown tool 'github'",
+		},
+		{
+			name:    "empty secret",
+			args:    []string{"set", "jira", "-config", configPath},
+			stdin:   "\n",
+			wantErr: "secret must not be empty",
+		},
+		{
+			name:    "remove without stored credentials",
+			args:    []string{"remove", "bamboo", "-config", configPath},
+			wantErr: "no stored credentials for bamboo",
+		},
+		{
+			name:    "no backend configured",
+			args:    []string{"list", "-config", filepath.Join(t.TempDir(), "missing.yaml")},
+			wantErr: "configuration file not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			err := runCredentialsCommand(tt.args, strings.NewReader(tt.stdin), &stdout)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("runCredentialsCommand(%v) error = %v, want %q", tt.args, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRunCredentialsCommand_SetListRemove(t *testing.T) {
+	configPath := writeCredentialsConfig(t)
+
+	run := func(stdin string, args ...string) string {
+		t.Helper()
+		var stdout bytes.Buffer
+		if err := runCredentialsCommand(args, strings.NewReader(stdin), &stdout); err != nil {
+			t.Fatalf("runCredentialsCommand(%v) error = %v", args, err)
+		}
+		return stdout.String()
+	}
+
+	if out := run("jira-pat\n", "set", "jira", "-config", configPath); out != "Stored token credentials for jira\n" {
+		t.Errorf("set jira output = %q", out)
+	}
+	if out := run("ci-secret\r\n", "set", "-config", configPath, "bamboo", "-type", "basic", "-username", "ci"); out != "Stored basic credentials for bamboo\n" {
+		t.Errorf("set bamboo output = %q", out)
+	}
+
+	storeConfig, err := domain.LoadCredentialStoreConfig(configPath)
+	if err != nil {
+		t.Fatalf("LoadCredentialStoreConfig() error = %v", err)
+	}
+	store, err := infrastructure.NewCredentialStore(*storeConfig)
+	if err != nil {
+		t.Fatalf("NewCredentialStore() error = %v", err)
+	}
+	creds, err := store.Get("jira")
+	if err != nil || creds.Type != domain.TokenAuth || creds.Token != "jira-pat" {
+		t.Errorf("Get(jira) = %+v, %v, want token jira-pat", creds, err)
+	}
+	creds, err = store.Get("bamboo")
+	if err != nil || creds.Type != domain.BasicAuth || creds.Username != "ci" || creds.Password != "ci-secret" {
+		t.Errorf("Get(bamboo) = %+v, %v, want basic ci/ci-secret", creds, err)
+	}
+
+	if out := run("", "list", "-config", configPath); out != "bamboo\njira\n" {
+		t.Errorf("list output = %q, want bamboo and jira", out)
+	}
+
+	if out := run("", "remove", "jira", "-config", configPath); out != "Removed credentials for jira\n" {
+		t.Errorf("remove output = %q", out)
+	}
+	if out := run("", "list", "-config", configPath); out != "bamboo\n" {
+		t.Errorf("list output after remove = %q, want bamboo", out)
+	}
+}
@@ -2,7 +2,12 @@ module atlassian-mcp-server
 
 go 1.24.1
 
+require gopkg.in/yaml.v3 v3.0.1
+
 require (
-	github.com/leanovate/gopter v0.2.11 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
+	github.com/godbus/dbus/v5 v5.1.0
+	github.com/leanovate/gopter v0.2.11
+	golang.org/x/term v0.36.0
 )
+
+require golang.org/x/sys v0.37.0 // indirect
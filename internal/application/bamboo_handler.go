@@ -33,6 +33,7 @@ const (
 	ToolBambooGetBuildLog           = "bamboo_get_build_log"
 	ToolBambooGetDeploymentProjects = "bamboo_get_deployment_projects"
 	ToolBambooTriggerDeployment     = "bamboo_trigger_deployment"
+	ToolBambooWhoAmI                = "bamboo_whoami"
 )
 
 // ToolName returns the identifier for this handler.
@@ -135,6 +136,15 @@ func (h *BambooHandler) ListTools() []domain.ToolDefinition {
 				Required: []string{"projectId", "environmentId"},
 			},
 		},
+		{
+			Name:        ToolBambooWhoAmI,
+			Description: "Show the Bamboo user the server is authenticated as",
+			InputSchema: domain.JSONSchema{
+				Type:       "object",
+				Properties: map[string]interface{}{},
+				Required:   []string{},
+			},
+		},
 	}
 }
 
@@ -161,6 +171,8 @@ func (h *BambooHandler) Handle(ctx context.Context, req *domain.ToolRequest) (*d
 		return h.handleGetDeploymentProjects(ctx, req.Arguments)
 	case ToolBambooTriggerDeployment:
 		return h.handleTriggerDeployment(ctx, req.Arguments)
+	case ToolBambooWhoAmI:
+		return h.handleWhoAmI(ctx, req.Arguments)
 	default:
 		return nil, &domain.Error{
 			Code:    domain.MethodNotFound,
@@ -289,3 +301,15 @@ func (h *BambooHandler) handleTriggerDeployment(ctx context.Context, args map[st
 	// Transform the response
 	return h.mapper.MapToToolResponse(result)
 }
+
+// handleWhoAmI handles the bamboo_whoami tool call.
+func (h *BambooHandler) handleWhoAmI(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Call the Bamboo client
+	identity, err := h.client.WhoAmI()
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(identity)
+}
@@ -134,6 +134,7 @@ func TestBambooHandler_ListTools(t *testing.T) {
 		ToolBambooGetBuildLog,
 		ToolBambooGetDeploymentProjects,
 		ToolBambooTriggerDeployment,
+		ToolBambooWhoAmI,
 	}
 
 	if len(tools) != len(expectedTools) {
@@ -1073,3 +1074,26 @@ func TestBambooHandler_AllOperations(t *testing.T) {
 		})
 	}
 }
+
+func TestBambooHandler_HandleWhoAmI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/latest/currentUser" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"name":"ci-bot","fullName":"CI Bot","email":"ci@example.com"}`))
+	}))
+	defer server.Close()
+
+	client := infrastructure.NewBambooClient(server.URL, server.Client())
+	handler := NewBambooHandler(client, &mockResponseMapper{})
+
+	resp, err := handler.Handle(context.Background(), &domain.ToolRequest{Name: ToolBambooWhoAmI})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !contains(resp.Content[0].Text, `"username": "ci-bot"`) {
+		t.Errorf("expected username in response, got %s", resp.Content[0].Text)
+	}
+}
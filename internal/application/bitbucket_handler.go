@@ -34,6 +34,7 @@ const (
 	ToolBitbucketMergePullRequest  = "bitbucket_merge_pull_request"
 	ToolBitbucketGetCommits        = "bitbucket_get_commits"
 	ToolBitbucketGetFileContent    = "bitbucket_get_file_content"
+	ToolBitbucketWhoAmI            = "bitbucket_whoami"
 )
 
 // ToolName returns the identifier for this handler.
@@ -248,6 +249,15 @@ func (h *BitbucketHandler) ListTools() []domain.ToolDefinition {
 				Required: []string{"project", "repo", "path"},
 			},
 		},
+		{
+			Name:        ToolBitbucketWhoAmI,
+			Description: "Show the Bitbucket user the server is authenticated as",
+			InputSchema: domain.JSONSchema{
+				Type:       "object",
+				Properties: map[string]interface{}{},
+				Required:   []string{},
+			},
+		},
 	}
 }
 
@@ -276,6 +286,8 @@ func (h *BitbucketHandler) Handle(ctx context.Context, req *domain.ToolRequest)
 		return h.handleGetCommits(ctx, req.Arguments)
 	case ToolBitbucketGetFileContent:
 		return h.handleGetFileContent(ctx, req.Arguments)
+	case ToolBitbucketWhoAmI:
+		return h.handleWhoAmI(ctx, req.Arguments)
 	default:
 		return nil, &domain.Error{
 			Code:    domain.MethodNotFound,
@@ -556,3 +568,15 @@ func (h *BitbucketHandler) handleGetFileContent(ctx context.Context, args map[st
 		"content": content,
 	})
 }
+
+// handleWhoAmI handles the bitbucket_whoami tool call.
+func (h *BitbucketHandler) handleWhoAmI(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Call the Bitbucket client
+	identity, err := h.client.WhoAmI()
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(identity)
+}
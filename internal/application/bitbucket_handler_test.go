@@ -155,6 +155,7 @@ func TestBitbucketHandler_ListTools(t *testing.T) {
 		ToolBitbucketMergePullRequest,
 		ToolBitbucketGetCommits,
 		ToolBitbucketGetFileContent,
+		ToolBitbucketWhoAmI,
 	}
 
 	if len(tools) != len(expectedTools) {
@@ -1543,3 +1544,29 @@ func TestBitbucketHandler_OptionalParameterCombinations(t *testing.T) {
 		}
 	})
 }
+
+func TestBitbucketHandler_HandleWhoAmI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/plugins/servlet/applinks/whoami":
+			w.Write([]byte("jdoe"))
+		case "/rest/api/1.0/users":
+			w.Write([]byte(`{"values":[{"name":"jdoe","slug":"jdoe","displayName":"Jane Doe","emailAddress":"jdoe@example.com"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := infrastructure.NewBitbucketClient(server.URL, server.Client())
+	handler := NewBitbucketHandler(client, &mockResponseMapper{})
+
+	resp, err := handler.Handle(context.Background(), &domain.ToolRequest{Name: ToolBitbucketWhoAmI})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !contains(resp.Content[0].Text, `"displayName": "Jane Doe"`) {
+		t.Errorf("expected display name in response, got %s", resp.Content[0].Text)
+	}
+}
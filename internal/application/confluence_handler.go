@@ -33,6 +33,7 @@ const (
 	ToolConfluenceSearchCQL      = "confluence_search_cql"
 	ToolConfluenceGetSpaces      = "confluence_get_spaces"
 	ToolConfluenceGetPageHistory = "confluence_get_page_history"
+	ToolConfluenceWhoAmI         = "confluence_whoami"
 )
 
 // ToolName returns the identifier for this handler.
@@ -176,6 +177,15 @@ func (h *ConfluenceHandler) ListTools() []domain.ToolDefinition {
 				Required: []string{"pageId"},
 			},
 		},
+		{
+			Name:        ToolConfluenceWhoAmI,
+			Description: "Show the Confluence user the server is authenticated as",
+			InputSchema: domain.JSONSchema{
+				Type:       "object",
+				Properties: map[string]interface{}{},
+				Required:   []string{},
+			},
+		},
 	}
 }
 
@@ -202,6 +212,8 @@ func (h *ConfluenceHandler) Handle(ctx context.Context, req *domain.ToolRequest)
 		return h.handleGetSpaces(ctx, req.Arguments)
 	case ToolConfluenceGetPageHistory:
 		return h.handleGetPageHistory(ctx, req.Arguments)
+	case ToolConfluenceWhoAmI:
+		return h.handleWhoAmI(ctx, req.Arguments)
 	default:
 		return nil, &domain.Error{
 			Code:    domain.MethodNotFound,
@@ -413,3 +425,15 @@ func (h *ConfluenceHandler) handleGetPageHistory(ctx context.Context, args map[s
 	// Transform the response
 	return h.mapper.MapToToolResponse(history)
 }
+
+// handleWhoAmI handles the confluence_whoami tool call.
+func (h *ConfluenceHandler) handleWhoAmI(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Call the Confluence client
+	identity, err := h.client.WhoAmI()
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(identity)
+}
@@ -0,0 +1,597 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"atlassian-mcp-server/internal/domain"
+	"atlassian-mcp-server/internal/infrastructure"
+)
+
+// jiraAgileTools returns the definitions of the Jira Agile board, sprint and backlog tools.
+func jiraAgileTools() []domain.ToolDefinition {
+	return []domain.ToolDefinition{
+		{
+			Name:        ToolJiraListBoards,
+			Description: "List Jira Software boards, optionally filtered by project, type or name",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"projectKey": map[string]interface{}{
+						"type":        "string",
+						"description": "Only boards of this project (optional)",
+					},
+					"type": map[string]interface{}{
+						"type":        "string",
+						"description": "Only boards of this type (optional)",
+						"enum":        []string{"scrum", "kanban"},
+					},
+					"name": map[string]interface{}{
+						"type":        "string",
+						"description": "Only boards whose name contains this text (optional)",
+					},
+					"startAt": map[string]interface{}{
+						"type":        "integer",
+						"description": "The index of the first result to return (0-based, optional)",
+					},
+					"maxResults": map[string]interface{}{
+						"type":        "integer",
+						"description": "The maximum number of results to return (optional)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{},
+			},
+		},
+		{
+			Name:        ToolJiraGetSprints,
+			Description: "List the sprints of a scrum board, optionally only active, future or closed ones",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"boardId": map[string]interface{}{
+						"type":        "integer",
+						"description": "The board ID",
+					},
+					"state": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string", "enum": []string{"future", "active", "closed"}},
+						"description": "Only sprints in these states (optional, default all)",
+					},
+					"startAt": map[string]interface{}{
+						"type":        "integer",
+						"description": "The index of the first result to return (0-based, optional)",
+					},
+					"maxResults": map[string]interface{}{
+						"type":        "integer",
+						"description": "The maximum number of results to return (optional)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"boardId"},
+			},
+		},
+		{
+			Name:        ToolJiraGetSprintIssues,
+			Description: "List the issues in a sprint, optionally filtered by JQL",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"sprintId": map[string]interface{}{
+						"type":        "integer",
+						"description": "The sprint ID",
+					},
+					"jql": map[string]interface{}{
+						"type":        "string",
+						"description": "Additional JQL filter (optional, e.g., assignee = currentUser())",
+					},
+					"startAt": map[string]interface{}{
+						"type":        "integer",
+						"description": "The index of the first result to return (0-based, optional)",
+					},
+					"maxResults": map[string]interface{}{
+						"type":        "integer",
+						"description": "The maximum number of results to return (optional)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"sprintId"},
+			},
+		},
+		{
+			Name:        ToolJiraMoveToSprint,
+			Description: "Move issues into a sprint",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"sprintId": map[string]interface{}{
+						"type":        "integer",
+						"description": "The sprint ID",
+					},
+					"issueKeys": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Issue keys (e.g., [\"TEST-1\", \"TEST-2\"])",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"sprintId", "issueKeys"},
+			},
+		},
+		{
+			Name:        ToolJiraMoveToBacklog,
+			Description: "Move issues out of their sprint into the backlog",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKeys": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Issue keys (e.g., [\"TEST-1\", \"TEST-2\"])",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKeys"},
+			},
+		},
+		{
+			Name:        ToolJiraRankIssues,
+			Description: "Rank issues before or after another issue on the board, keeping their given order",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKeys": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Issue keys (e.g., [\"TEST-1\", \"TEST-2\"])",
+					},
+					"rankBefore": map[string]interface{}{
+						"type":        "string",
+						"description": "Place the issues before this issue",
+					},
+					"rankAfter": map[string]interface{}{
+						"type":        "string",
+						"description": "Place the issues after this issue",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKeys"},
+			},
+		},
+		{
+			Name:        ToolJiraStartSprint,
+			Description: "Start a future sprint",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"sprintId": map[string]interface{}{
+						"type":        "integer",
+						"description": "The sprint ID",
+					},
+					"startDate": map[string]interface{}{
+						"type":        "string",
+						"description": "Start of the sprint (RFC 3339 or YYYY-MM-DD, defaults to the planned date or now)",
+					},
+					"endDate": map[string]interface{}{
+						"type":        "string",
+						"description": "End of the sprint (RFC 3339 or YYYY-MM-DD, required unless already planned)",
+					},
+					"goal": map[string]interface{}{
+						"type":        "string",
+						"description": "Sprint goal (optional)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"sprintId"},
+			},
+		},
+		{
+			Name:        ToolJiraCloseSprint,
+			Description: "Close an active sprint, optionally moving its unfinished issues to another sprint (otherwise they return to the backlog)",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"sprintId": map[string]interface{}{
+						"type":        "integer",
+						"description": "The sprint ID",
+					},
+					"moveIncompleteTo": map[string]interface{}{
+						"type":        "integer",
+						"description": "Sprint to move unfinished issues to (optional)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"sprintId"},
+			},
+		},
+	}
+}
+
+// handleListBoards handles the jira_list_boards tool call.
+func (h *JiraHandler) handleListBoards(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	projectKey, _ := getStringParam(args, "projectKey", false)
+	boardType, _ := getStringParam(args, "type", false)
+	name, _ := getStringParam(args, "name", false)
+	startAt, err := getIntParam(args, "startAt", false)
+	if err != nil {
+		return nil, err
+	}
+	maxResults, err := getIntParam(args, "maxResults", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	boards, err := client.GetBoards(&infrastructure.BoardOptions{
+		StartAt:        startAt,
+		MaxResults:     maxResults,
+		Type:           boardType,
+		Name:           name,
+		ProjectKeyOrID: projectKey,
+	})
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(boards)
+}
+
+// handleGetSprints handles the jira_get_sprints tool call.
+func (h *JiraHandler) handleGetSprints(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	boardID, err := getIntParam(args, "boardId", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	stateNames, err := getStringArrayParam(args, "state", false)
+	if err != nil {
+		return nil, err
+	}
+	states, err := domain.ParseSprintStates(stateNames)
+	if err != nil {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: err.Error(),
+		}
+	}
+	startAt, err := getIntParam(args, "startAt", false)
+	if err != nil {
+		return nil, err
+	}
+	maxResults, err := getIntParam(args, "maxResults", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	sprints, err := client.GetSprints(boardID, &infrastructure.SprintOptions{
+		StartAt:    startAt,
+		MaxResults: maxResults,
+		States:     states,
+	})
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(sprints)
+}
+
+// handleGetSprintIssues handles the jira_get_sprint_issues tool call.
+func (h *JiraHandler) handleGetSprintIssues(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	sprintID, err := getIntParam(args, "sprintId", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	jql, _ := getStringParam(args, "jql", false)
+	startAt, err := getIntParam(args, "startAt", false)
+	if err != nil {
+		return nil, err
+	}
+	maxResults, err := getIntParam(args, "maxResults", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	results, err := client.GetSprintIssues(sprintID, &infrastructure.SearchOptions{
+		JQL:        jql,
+		StartAt:    startAt,
+		MaxResults: maxResults,
+	})
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(results)
+}
+
+// handleMoveToSprint handles the jira_move_to_sprint tool call.
+func (h *JiraHandler) handleMoveToSprint(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	sprintID, err := getIntParam(args, "sprintId", true)
+	if err != nil {
+		return nil, err
+	}
+	issueKeys, err := getIssueKeysParam(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	err = client.MoveIssuesToSprint(sprintID, issueKeys)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Return success response
+	return h.mapper.MapToToolResponse(map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("Moved %d issue(s) to sprint %d", len(issueKeys), sprintID),
+	})
+}
+
+// getIssueKeysParam reads the required, non-empty issueKeys list.
+func getIssueKeysParam(args map[string]interface{}) ([]string, error) {
+	issueKeys, err := getStringArrayParam(args, "issueKeys", true)
+	if err != nil {
+		return nil, err
+	}
+	if len(issueKeys) == 0 {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "issueKeys must contain at least one issue key",
+		}
+	}
+	return issueKeys, nil
+}
+
+// handleMoveToBacklog handles the jira_move_to_backlog tool call.
+func (h *JiraHandler) handleMoveToBacklog(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKeys, err := getIssueKeysParam(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	err = client.MoveIssuesToBacklog(issueKeys)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Return success response
+	return h.mapper.MapToToolResponse(map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("Moved %d issue(s) to the backlog", len(issueKeys)),
+	})
+}
+
+// handleRankIssues handles the jira_rank_issues tool call.
+func (h *JiraHandler) handleRankIssues(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKeys, err := getIssueKeysParam(args)
+	if err != nil {
+		return nil, err
+	}
+	rankBefore, _ := getStringParam(args, "rankBefore", false)
+	rankAfter, _ := getStringParam(args, "rankAfter", false)
+	if (rankBefore == "") == (rankAfter == "") {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "exactly one of rankBefore or rankAfter is required",
+		}
+	}
+
+	// Call the Jira client
+	err = client.RankIssues(&domain.RankRequest{
+		Issues:          issueKeys,
+		RankBeforeIssue: rankBefore,
+		RankAfterIssue:  rankAfter,
+	})
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Return success response
+	return h.mapper.MapToToolResponse(map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("Ranked %d issue(s)", len(issueKeys)),
+	})
+}
+
+// handleStartSprint handles the jira_start_sprint tool call.
+func (h *JiraHandler) handleStartSprint(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	sprintID, err := getIntParam(args, "sprintId", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	startDate, err := getSprintDateParam(args, "startDate")
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := getSprintDateParam(args, "endDate")
+	if err != nil {
+		return nil, err
+	}
+	goal, _ := getStringParam(args, "goal", false)
+
+	// Dates planned on the sprint are kept unless overridden
+	sprint, err := client.GetSprint(sprintID)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+	if sprint.State != domain.SprintStateFuture {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("sprint %d is %s; only future sprints can be started", sprintID, sprint.State),
+		}
+	}
+	if startDate == "" && sprint.StartDate == "" {
+		startDate = time.Now().Format(domain.AgileTimeLayout)
+	}
+	if endDate == "" && sprint.EndDate == "" {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("sprint %d has no planned end date; endDate is required", sprintID),
+		}
+	}
+
+	// Call the Jira client
+	started, err := client.UpdateSprint(sprintID, &domain.SprintUpdate{
+		State:     domain.SprintStateActive,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Goal:      goal,
+	})
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(started)
+}
+
+// getSprintDateParam reads an optional date parameter and converts it to the Agile API format.
+func getSprintDateParam(args map[string]interface{}, name string) (string, error) {
+	value, err := getStringParam(args, name, false)
+	if err != nil || value == "" {
+		return value, err
+	}
+	t, err := domain.ParseJiraTime(value)
+	if err != nil {
+		return "", &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("invalid %s: %v", name, err),
+		}
+	}
+	return t.Format(domain.AgileTimeLayout), nil
+}
+
+// handleCloseSprint handles the jira_close_sprint tool call.
+func (h *JiraHandler) handleCloseSprint(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	sprintID, err := getIntParam(args, "sprintId", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	moveIncompleteTo, err := getIntParam(args, "moveIncompleteTo", false)
+	if err != nil {
+		return nil, err
+	}
+	if moveIncompleteTo == sprintID {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "moveIncompleteTo must be a different sprint",
+		}
+	}
+
+	// Move unfinished issues first; Jira would otherwise return them to the backlog
+	moved := []string{}
+	if moveIncompleteTo > 0 {
+		for {
+			results, err := client.GetSprintIssues(sprintID, &infrastructure.SearchOptions{
+				JQL:        "statusCategory != Done",
+				StartAt:    len(moved),
+				MaxResults: agileIssuePageSize,
+				Fields:     []string{"status"},
+			})
+			if err != nil {
+				return nil, h.mapper.MapError(err)
+			}
+			for _, issue := range results.Issues {
+				moved = append(moved, issue.Key)
+			}
+			if len(results.Issues) == 0 || len(moved) >= results.Total {
+				break
+			}
+		}
+		if len(moved) > 0 {
+			if err := client.MoveIssuesToSprint(moveIncompleteTo, moved); err != nil {
+				return nil, h.mapper.MapError(err)
+			}
+		}
+	}
+
+	// Call the Jira client
+	_, err = client.UpdateSprint(sprintID, &domain.SprintUpdate{State: domain.SprintStateClosed})
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Return success response
+	message := fmt.Sprintf("Sprint %d closed", sprintID)
+	if moveIncompleteTo > 0 {
+		message += fmt.Sprintf("; moved %d unfinished issue(s) to sprint %d", len(moved), moveIncompleteTo)
+	}
+	return h.mapper.MapToToolResponse(map[string]interface{}{
+		"success": true,
+		"message": message,
+		"moved":   moved,
+	})
+}
+
+// agileIssuePageSize is the page size used when collecting sprint issues.
+const agileIssuePageSize = 100
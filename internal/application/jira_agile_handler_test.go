@@ -0,0 +1,184 @@
+package application
+
+import (
+	"net/http"
+	"testing"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraAgileRoutes serves board 7 with active sprint 42 holding TEST-1 and TEST-2, and
+// future sprint 43.
+var jiraAgileRoutes = mockJiraRoutes{
+	"GET /rest/agile/1.0/board":            jiraReply(http.StatusOK, `{"startAt":0,"maxResults":50,"total":1,"isLast":true,"values":[{"id":7,"name":"TEST board","type":"scrum"}]}`),
+	"GET /rest/agile/1.0/board/7/sprint":   jiraReply(http.StatusOK, `{"startAt":0,"maxResults":50,"isLast":true,"values":[{"id":42,"name":"Sprint 1","state":"active"}]}`),
+	"GET /rest/agile/1.0/sprint/42":        jiraReply(http.StatusOK, `{"id":42,"name":"Sprint 1","state":"active"}`),
+	"GET /rest/agile/1.0/sprint/43":        jiraReply(http.StatusOK, `{"id":43,"name":"Sprint 2","state":"future"}`),
+	"POST /rest/agile/1.0/sprint/42":       jiraReply(http.StatusOK, `{"id":43,"name":"Sprint 2","state":"active"}`),
+	"POST /rest/agile/1.0/sprint/43":       jiraReply(http.StatusOK, `{"id":43,"name":"Sprint 2","state":"active"}`),
+	"GET /rest/agile/1.0/sprint/42/issue":  jiraReply(http.StatusOK, `{"startAt":0,"maxResults":100,"total":2,"issues":[{"key":"TEST-1"},{"key":"TEST-2"}]}`),
+	"POST /rest/agile/1.0/sprint/43/issue": jiraReply(http.StatusNoContent, ""),
+	"POST /rest/agile/1.0/backlog/issue":   jiraReply(http.StatusNoContent, ""),
+	"PUT /rest/agile/1.0/issue/rank":       jiraReply(http.StatusNoContent, ""),
+}
+
+// movedIssues checks that the tool call sent TEST-1 and TEST-2 in a single request.
+func movedIssues(request string) func(*testing.T, *mockJira, *domain.ToolResponse, error) {
+	return func(t *testing.T, m *mockJira, _ *domain.ToolResponse, _ error) {
+		t.Helper()
+		if log := m.log(); len(log) != 1 || log[0] != request {
+			t.Errorf("expected %s, got %v", request, log)
+		}
+		if issues, _ := m.body(request)["issues"].([]interface{}); len(issues) != 2 {
+			t.Errorf("unexpected payload: %v", m.body(request))
+		}
+	}
+}
+
+func TestJiraHandler_BoardsAndSprints(t *testing.T) {
+	runJiraToolCases(t, jiraAgileRoutes, []jiraToolCase{
+		{
+			name: "list boards",
+			tool: ToolJiraListBoards,
+			args: map[string]interface{}{"projectKey": "TEST"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var boards domain.BoardPage
+				decodeResponse(t, resp, &boards)
+				if log := m.log(); len(boards.Values) != 1 || boards.Values[0].ID != 7 || !contains(log[0], "projectKeyOrId=TEST") {
+					t.Errorf("unexpected boards: %+v (%v)", boards, log)
+				}
+			},
+		},
+		{
+			name: "list sprints",
+			tool: ToolJiraGetSprints,
+			args: map[string]interface{}{"boardId": float64(7), "state": []interface{}{"active", "future"}},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if log := m.log(); len(log) != 1 || log[0] != "GET /rest/agile/1.0/board/7/sprint?state=active%2Cfuture" {
+					t.Errorf("unexpected requests: %v", log)
+				}
+			},
+		},
+		{
+			name:     "unknown sprint state",
+			tool:     ToolJiraGetSprints,
+			args:     map[string]interface{}{"boardId": float64(7), "state": "open"},
+			wantCode: domain.InvalidParams,
+			check:    noRequests,
+		},
+		{
+			name: "sprint issues",
+			tool: ToolJiraGetSprintIssues,
+			args: map[string]interface{}{"sprintId": float64(42)},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var results domain.SearchResults
+				decodeResponse(t, resp, &results)
+				if len(results.Issues) != 2 {
+					t.Errorf("unexpected sprint issues: %+v", results)
+				}
+			},
+		},
+	})
+}
+
+func TestJiraHandler_MoveAndRankIssues(t *testing.T) {
+	runJiraToolCases(t, jiraAgileRoutes, []jiraToolCase{
+		{
+			name:  "move to sprint",
+			tool:  ToolJiraMoveToSprint,
+			args:  map[string]interface{}{"sprintId": float64(43), "issueKeys": []interface{}{"TEST-1", "TEST-2"}},
+			check: movedIssues("POST /rest/agile/1.0/sprint/43/issue"),
+		},
+		{
+			name:  "move to backlog",
+			tool:  ToolJiraMoveToBacklog,
+			args:  map[string]interface{}{"issueKeys": "TEST-1, TEST-2"},
+			check: movedIssues("POST /rest/agile/1.0/backlog/issue"),
+		},
+		{
+			name:  "rank",
+			tool:  ToolJiraRankIssues,
+			args:  map[string]interface{}{"issueKeys": []interface{}{"TEST-1", "TEST-2"}, "rankBefore": "TEST-3"},
+			check: movedIssues("PUT /rest/agile/1.0/issue/rank"),
+		},
+		{
+			name:     "empty issue keys",
+			tool:     ToolJiraMoveToBacklog,
+			args:     map[string]interface{}{"issueKeys": []interface{}{}},
+			wantCode: domain.InvalidParams,
+			check:    noRequests,
+		},
+		{
+			name:     "rank without target",
+			tool:     ToolJiraRankIssues,
+			args:     map[string]interface{}{"issueKeys": []interface{}{"TEST-1"}},
+			wantCode: domain.InvalidParams,
+			check:    noRequests,
+		},
+		{
+			name:     "rank with both targets",
+			tool:     ToolJiraRankIssues,
+			args:     map[string]interface{}{"issueKeys": []interface{}{"TEST-1"}, "rankBefore": "TEST-3", "rankAfter": "TEST-4"},
+			wantCode: domain.InvalidParams,
+			check:    noRequests,
+		},
+	})
+}
+
+func TestJiraHandler_StartAndCloseSprint(t *testing.T) {
+	runJiraToolCases(t, jiraAgileRoutes, []jiraToolCase{
+		{
+			name:     "start without end date",
+			tool:     ToolJiraStartSprint,
+			args:     map[string]interface{}{"sprintId": float64(43)},
+			wantCode: domain.InvalidParams,
+			wantMsg:  "endDate is required",
+		},
+		{
+			name:     "start active sprint",
+			tool:     ToolJiraStartSprint,
+			args:     map[string]interface{}{"sprintId": float64(42), "endDate": "2024-01-29"},
+			wantCode: domain.InvalidParams,
+			wantMsg:  "only future sprints",
+			check:    noWrites,
+		},
+		{
+			name: "start",
+			tool: ToolJiraStartSprint,
+			args: map[string]interface{}{"sprintId": float64(43), "startDate": "2024-01-15", "endDate": "2024-01-29", "goal": "Ship it"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				update := m.body("POST /rest/agile/1.0/sprint/43")
+				if update["state"] != "active" || update["startDate"] != "2024-01-15T00:00:00.000Z" || update["endDate"] != "2024-01-29T00:00:00.000Z" || update["goal"] != "Ship it" {
+					t.Errorf("unexpected sprint update: %v", update)
+				}
+			},
+		},
+		{
+			name: "close moving unfinished issues",
+			tool: ToolJiraCloseSprint,
+			args: map[string]interface{}{"sprintId": float64(42), "moveIncompleteTo": float64(43)},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				log := m.log()
+				if len(log) != 3 || !contains(log[0], "statusCategory") || log[1] != "POST /rest/agile/1.0/sprint/43/issue" || log[2] != "POST /rest/agile/1.0/sprint/42" {
+					t.Errorf("unexpected requests: %v", log)
+				}
+				if update := m.body("POST /rest/agile/1.0/sprint/42"); update["state"] != "closed" {
+					t.Errorf("expected sprint to be closed: %v", update)
+				}
+				if !contains(resp.Content[0].Text, "moved 2 unfinished issue(s) to sprint 43") {
+					t.Errorf("unexpected response: %s", resp.Content[0].Text)
+				}
+			},
+		},
+		{
+			name: "close",
+			tool: ToolJiraCloseSprint,
+			args: map[string]interface{}{"sprintId": float64(42)},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if log := m.log(); len(log) != 1 || log[0] != "POST /rest/agile/1.0/sprint/42" {
+					t.Errorf("expected only the close request, got %v", log)
+				}
+			},
+		},
+	})
+}
@@ -0,0 +1,226 @@
+package application
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"unicode/utf8"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraAttachmentTools returns the definitions of the Jira attachment tools.
+func jiraAttachmentTools() []domain.ToolDefinition {
+	return []domain.ToolDefinition{
+		{
+			Name:        ToolJiraListAttachments,
+			Description: "List the attachments on a Jira issue with their IDs, sizes and MIME types",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+		{
+			Name:        ToolJiraGetAttachment,
+			Description: "Download a Jira attachment: text files are returned inline, other files as a base64 resource (up to 10 MiB)",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"attachmentId": map[string]interface{}{
+						"type":        "string",
+						"description": "The attachment ID (see jira_list_attachments)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"attachmentId"},
+			},
+		},
+		{
+			Name:        ToolJiraAddAttachment,
+			Description: "Attach a file to a Jira issue (up to 10 MiB)",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"filename": map[string]interface{}{
+						"type":        "string",
+						"description": "The file name, including extension (e.g., log.txt)",
+					},
+					"content": map[string]interface{}{
+						"type":        "string",
+						"description": "Text content of the file (optional if contentBase64 is provided)",
+					},
+					"contentBase64": map[string]interface{}{
+						"type":        "string",
+						"description": "Base64-encoded file content (optional if content is provided)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey", "filename"},
+			},
+		},
+	}
+}
+
+// maxAttachmentSize limits attachment downloads and uploads (10 MiB).
+const maxAttachmentSize = 10 << 20
+
+// handleListAttachments handles the jira_list_attachments tool call.
+func (h *JiraHandler) handleListAttachments(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	attachments, err := client.GetAttachments(issueKey)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(attachments)
+}
+
+// handleGetAttachment handles the jira_get_attachment tool call.
+// Text content is returned as a text block and binary content as a base64 resource blob.
+func (h *JiraHandler) handleGetAttachment(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	attachmentID, err := getStringParam(args, "attachmentId", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the size before downloading
+	attachment, err := client.GetAttachment(attachmentID)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+	if attachment.Size > maxAttachmentSize {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("attachment %s is %d bytes; only attachments up to %d bytes can be downloaded", attachment.Filename, attachment.Size, maxAttachmentSize),
+		}
+	}
+
+	// Call the Jira client
+	content, err := client.DownloadAttachment(attachment, maxAttachmentSize)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Return text inline and everything else as a blob
+	if domain.IsTextMimeType(attachment.MimeType) && utf8.Valid(content) {
+		return &domain.ToolResponse{
+			Content: []domain.ContentBlock{
+				{
+					Type: "text",
+					Text: string(content),
+				},
+			},
+		}, nil
+	}
+
+	uri := attachment.Content
+	if uri == "" {
+		uri = fmt.Sprintf("%s/secure/attachment/%s/%s", client.BaseURL(), attachment.ID, attachment.Filename)
+	}
+	return &domain.ToolResponse{
+		Content: []domain.ContentBlock{
+			{
+				Type: "resource",
+				Resource: &domain.Resource{
+					URI:      uri,
+					MimeType: attachment.MimeType,
+					Blob:     base64.StdEncoding.EncodeToString(content),
+				},
+			},
+		},
+	}, nil
+}
+
+// handleAddAttachment handles the jira_add_attachment tool call.
+func (h *JiraHandler) handleAddAttachment(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+	filename, err := getStringParam(args, "filename", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the content as text or base64 (exactly one is required)
+	text, err := getStringParam(args, "content", false)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := getStringParam(args, "contentBase64", false)
+	if err != nil {
+		return nil, err
+	}
+	_, hasText := args["content"]
+	_, hasEncoded := args["contentBase64"]
+	if hasText == hasEncoded {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "exactly one of content or contentBase64 must be provided",
+		}
+	}
+
+	content := []byte(text)
+	if hasEncoded {
+		content, err = base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, &domain.Error{
+				Code:    domain.InvalidParams,
+				Message: fmt.Sprintf("contentBase64 is not valid base64: %v", err),
+			}
+		}
+	}
+	if len(content) > maxAttachmentSize {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("attachment is %d bytes; the limit is %d bytes", len(content), maxAttachmentSize),
+		}
+	}
+
+	// Call the Jira client
+	attachments, err := client.AddAttachment(issueKey, filename, content)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(attachments)
+}
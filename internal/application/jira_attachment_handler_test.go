@@ -0,0 +1,143 @@
+package application
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"testing"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// pngHeader is the content of the PNG attachment served by jiraAttachmentRoutes.
+var pngHeader = []byte{0x89, 'P', 'N', 'G', 0x0d, 0x0a, 0x1a, 0x0a}
+
+// jiraAttachmentRoutes serves a text attachment (1), a PNG attachment (2) and an
+// attachment over the size limit (3) on TEST-123, and accepts uploads to TEST-123.
+var jiraAttachmentRoutes = mockJiraRoutes{
+	"/rest/api/2/issue/TEST-123": jiraReply(http.StatusOK, `{"fields":{"attachment":[{"id":"1","filename":"log.txt","size":11,"mimeType":"text/plain"}]}}`),
+	"/rest/api/2/attachment/1":   jiraReply(http.StatusOK, `{"id":"1","filename":"log.txt","size":11,"mimeType":"text/plain; charset=UTF-8"}`),
+	"/rest/api/2/attachment/2": func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"2","filename":"image.png","size":8,"mimeType":"image/png","content":"http://` + r.Host + `/secure/attachment/2/image.png"}`))
+	},
+	"/rest/api/2/attachment/3":       jiraReply(http.StatusOK, `{"id":"3","filename":"huge.bin","size":104857600,"mimeType":"application/octet-stream"}`),
+	"/secure/attachment/1/log.txt":   jiraReply(http.StatusOK, "hello world"),
+	"/secure/attachment/2/image.png": jiraReply(http.StatusOK, string(pngHeader)),
+	"/rest/api/2/issue/TEST-123/attachments": func(w http.ResponseWriter, r *http.Request) {
+		if _, _, err := r.FormFile("file"); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`[{"id":"4","filename":"upload.bin","size":3,"mimeType":"application/octet-stream"}]`))
+	},
+}
+
+// uploaded checks that content was uploaded to TEST-123.
+func uploaded(content string) func(*testing.T, *mockJira, *domain.ToolResponse, error) {
+	return func(t *testing.T, m *mockJira, _ *domain.ToolResponse, _ error) {
+		t.Helper()
+		var got []byte
+		for _, request := range m.received() {
+			if request.URL.Path != "/rest/api/2/issue/TEST-123/attachments" {
+				continue
+			}
+			upload := &http.Request{Method: request.Method, Header: request.Header, Body: io.NopCloser(bytes.NewReader(request.Raw))}
+			file, _, err := upload.FormFile("file")
+			if err != nil {
+				t.Fatalf("failed to parse upload: %v", err)
+			}
+			got, _ = io.ReadAll(file)
+		}
+		if string(got) != content {
+			t.Errorf("expected upload %q, got %q", content, got)
+		}
+	}
+}
+
+func TestJiraHandler_Attachments(t *testing.T) {
+	runJiraToolCases(t, jiraAttachmentRoutes, []jiraToolCase{
+		{
+			name: "list",
+			tool: ToolJiraListAttachments,
+			args: map[string]interface{}{"issueKey": "TEST-123"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if !contains(resp.Content[0].Text, "log.txt") {
+					t.Errorf("unexpected response: %s", resp.Content[0].Text)
+				}
+			},
+		},
+		{
+			name: "text attachment inline",
+			tool: ToolJiraGetAttachment,
+			args: map[string]interface{}{"attachmentId": "1"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if resp.Content[0].Type != "text" || resp.Content[0].Text != "hello world" {
+					t.Errorf("expected inline text, got %+v", resp.Content[0])
+				}
+			},
+		},
+		{
+			name: "binary attachment as blob",
+			tool: ToolJiraGetAttachment,
+			args: map[string]interface{}{"attachmentId": "2"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				block := resp.Content[0]
+				if block.Type != "resource" || block.Resource == nil {
+					t.Fatalf("expected resource block, got %+v", block)
+				}
+				if block.Resource.MimeType != "image/png" || block.Resource.Blob != base64.StdEncoding.EncodeToString(pngHeader) {
+					t.Errorf("unexpected resource: %+v", block.Resource)
+				}
+				if !contains(block.Resource.URI, "/secure/attachment/2/image.png") {
+					t.Errorf("unexpected resource URI: %s", block.Resource.URI)
+				}
+			},
+		},
+		{
+			name:     "attachment over the size limit",
+			tool:     ToolJiraGetAttachment,
+			args:     map[string]interface{}{"attachmentId": "3"},
+			wantCode: domain.InvalidParams,
+			wantMsg:  "huge.bin",
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if log := m.log(); len(log) != 1 {
+					t.Errorf("expected only the metadata request, got %v", log)
+				}
+			},
+		},
+		{
+			name:  "upload text content",
+			tool:  ToolJiraAddAttachment,
+			args:  map[string]interface{}{"issueKey": "TEST-123", "filename": "notes.txt", "content": "abc"},
+			check: uploaded("abc"),
+		},
+		{
+			name:  "upload base64 content",
+			tool:  ToolJiraAddAttachment,
+			args:  map[string]interface{}{"issueKey": "TEST-123", "filename": "upload.bin", "contentBase64": base64.StdEncoding.EncodeToString([]byte{1, 2, 3})},
+			check: uploaded("\x01\x02\x03"),
+		},
+		{
+			name:     "both contents",
+			tool:     ToolJiraAddAttachment,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "filename": "upload.bin", "content": "abc", "contentBase64": "YWJj"},
+			wantCode: domain.InvalidParams,
+			check:    noRequests,
+		},
+		{
+			name:     "no content",
+			tool:     ToolJiraAddAttachment,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "filename": "upload.bin"},
+			wantCode: domain.InvalidParams,
+			check:    noRequests,
+		},
+		{
+			name:     "invalid base64",
+			tool:     ToolJiraAddAttachment,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "filename": "upload.bin", "contentBase64": "not base64!"},
+			wantCode: domain.InvalidParams,
+			check:    noRequests,
+		},
+	})
+}
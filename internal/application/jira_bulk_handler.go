@@ -0,0 +1,417 @@
+package application
+
+import (
+	"context"
+	"sync"
+
+	"atlassian-mcp-server/internal/domain"
+	"atlassian-mcp-server/internal/infrastructure"
+)
+
+// jiraBulkTools returns the definitions of the JQL-driven Jira bulk tools.
+func jiraBulkTools() []domain.ToolDefinition {
+	return []domain.ToolDefinition{
+		{
+			Name:        ToolJiraBulkUpdate,
+			Description: "Set fields on every issue matching a JQL query or in a list of keys, reporting the outcome per issue",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"jql": map[string]interface{}{
+						"type":        "string",
+						"description": "JQL selecting the issues (required unless issueKeys is given)",
+					},
+					"issueKeys": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Issue keys to change (required unless jql is given)",
+					},
+					"maxIssues": map[string]interface{}{
+						"type":        "number",
+						"description": "Maximum number of issues to change (optional, default: 100, max: 1000)",
+					},
+					"concurrency": map[string]interface{}{
+						"type":        "number",
+						"description": "Number of issues changed in parallel (optional, default: 5, max: 10)",
+					},
+					"dryRun": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Only list the issues that would be changed (optional, default: false)",
+					},
+					"fields": map[string]interface{}{
+						"type":        "object",
+						"description": "Fields to set keyed by field name or ID, e.g. {\"priority\": \"High\", \"Story Points\": 3}",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"fields"},
+			},
+		},
+		{
+			Name:        ToolJiraBulkTransition,
+			Description: "Transition every issue matching a JQL query or in a list of keys, reporting the outcome per issue. A dry run checks that the transition is available on each issue",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"jql": map[string]interface{}{
+						"type":        "string",
+						"description": "JQL selecting the issues (required unless issueKeys is given)",
+					},
+					"issueKeys": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Issue keys to change (required unless jql is given)",
+					},
+					"maxIssues": map[string]interface{}{
+						"type":        "number",
+						"description": "Maximum number of issues to change (optional, default: 100, max: 1000)",
+					},
+					"concurrency": map[string]interface{}{
+						"type":        "number",
+						"description": "Number of issues changed in parallel (optional, default: 5, max: 10)",
+					},
+					"dryRun": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Only list the issues that would be changed (optional, default: false)",
+					},
+					"transitionId": map[string]interface{}{
+						"type":        "string",
+						"description": "The transition ID (optional if transitionName or toStatus is provided)",
+					},
+					"transitionName": map[string]interface{}{
+						"type":        "string",
+						"description": "The transition name (optional if transitionId or toStatus is provided)",
+					},
+					"toStatus": map[string]interface{}{
+						"type":        "string",
+						"description": "The target status name, e.g. Done (optional if transitionId or transitionName is provided)",
+					},
+					"resolution": map[string]interface{}{
+						"type":        "string",
+						"description": "Resolution name to set, e.g. Fixed (optional)",
+					},
+					"fixVersions": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Fix version names to set (optional)",
+					},
+					"comment": map[string]interface{}{
+						"type":        "string",
+						"description": "Comment to add with each transition (optional)",
+					},
+					"fields": map[string]interface{}{
+						"type":        "object",
+						"description": "Other screen fields keyed by field name or ID (optional)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{},
+			},
+		},
+		{
+			Name:        ToolJiraBulkComment,
+			Description: "Add the same comment to every issue matching a JQL query or in a list of keys, reporting the outcome per issue",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"jql": map[string]interface{}{
+						"type":        "string",
+						"description": "JQL selecting the issues (required unless issueKeys is given)",
+					},
+					"issueKeys": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Issue keys to change (required unless jql is given)",
+					},
+					"maxIssues": map[string]interface{}{
+						"type":        "number",
+						"description": "Maximum number of issues to change (optional, default: 100, max: 1000)",
+					},
+					"concurrency": map[string]interface{}{
+						"type":        "number",
+						"description": "Number of issues changed in parallel (optional, default: 5, max: 10)",
+					},
+					"dryRun": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Only list the issues that would be changed (optional, default: false)",
+					},
+					"body": map[string]interface{}{
+						"type":        "string",
+						"description": "The comment text",
+					},
+					"format":     getInputFormatSchema(),
+					"visibility": getCommentVisibilitySchema(),
+					"auth":       getAuthSchema(),
+				},
+				Required: []string{"body"},
+			},
+		},
+	}
+}
+
+// Limits for bulk operations.
+const (
+	defaultBulkSize        = 100
+	maxBulkSize            = 1000
+	bulkPageSize           = 100
+	defaultBulkConcurrency = 5
+	maxBulkConcurrency     = 10
+)
+
+// bulkOptions are the arguments shared by the bulk tools.
+type bulkOptions struct {
+	targets     []domain.BulkIssueResult // The issues to change, in order
+	matched     int                      // The number of issues selected before the cap
+	concurrency int
+	dryRun      bool
+}
+
+// getBulkOptions reads the issue selection (jql or issueKeys), cap, concurrency and
+// dry-run flag of a bulk tool, and pages through the JQL results up to the cap.
+func (h *JiraHandler) getBulkOptions(client *infrastructure.JiraClient, args map[string]interface{}) (*bulkOptions, error) {
+	jql, _ := getStringParam(args, "jql", false)
+	issueKeys, err := getStringArrayParam(args, "issueKeys", false)
+	if err != nil {
+		return nil, err
+	}
+	if (jql == "") == (len(issueKeys) == 0) {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "exactly one of jql or issueKeys is required",
+		}
+	}
+
+	maxIssues, err := getIntParam(args, "maxIssues", false)
+	if err != nil {
+		return nil, err
+	}
+	if maxIssues <= 0 {
+		maxIssues = defaultBulkSize
+	}
+	if maxIssues > maxBulkSize {
+		maxIssues = maxBulkSize
+	}
+	concurrency, err := getIntParam(args, "concurrency", false)
+	if err != nil {
+		return nil, err
+	}
+	if concurrency <= 0 {
+		concurrency = defaultBulkConcurrency
+	}
+	if concurrency > maxBulkConcurrency {
+		concurrency = maxBulkConcurrency
+	}
+	dryRun, err := getBoolParam(args, "dryRun", false)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &bulkOptions{concurrency: concurrency, dryRun: dryRun}
+
+	// An explicit key list is only capped
+	if len(issueKeys) > 0 {
+		options.matched = len(issueKeys)
+		if len(issueKeys) > maxIssues {
+			issueKeys = issueKeys[:maxIssues]
+		}
+		for _, issueKey := range issueKeys {
+			options.targets = append(options.targets, domain.BulkIssueResult{IssueKey: issueKey})
+		}
+		return options, nil
+	}
+
+	// Page through the matching issues
+	for len(options.targets) < maxIssues {
+		pageSize := maxIssues - len(options.targets)
+		if pageSize > bulkPageSize {
+			pageSize = bulkPageSize
+		}
+		results, err := client.SearchJQL(jql, &infrastructure.SearchOptions{
+			JQL:        jql,
+			StartAt:    len(options.targets),
+			MaxResults: pageSize,
+			Fields:     []string{"summary"},
+		})
+		if err != nil {
+			return nil, h.mapper.MapError(err)
+		}
+		for _, issue := range results.Issues {
+			options.targets = append(options.targets, domain.BulkIssueResult{
+				IssueKey: issue.Key,
+				Summary:  issue.Fields.Summary,
+			})
+		}
+		options.matched = results.Total
+		if len(results.Issues) == 0 || len(options.targets) >= results.Total {
+			break
+		}
+	}
+	return options, nil
+}
+
+// runBulk calls apply for every target with at most options.concurrency calls in
+// flight and records each outcome. apply must not change anything on a dry run.
+func runBulk(operation string, options *bulkOptions, apply func(issueKey string) error) *domain.BulkResult {
+	targets := options.targets
+	succeeded := domain.BulkStatusSucceeded
+	if options.dryRun {
+		succeeded = domain.BulkStatusPlanned
+	}
+
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, options.concurrency)
+	for i := range targets {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(target *domain.BulkIssueResult) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			if err := apply(target.IssueKey); err != nil {
+				target.Status = domain.BulkStatusFailed
+				target.Error = err.Error()
+				return
+			}
+			target.Status = succeeded
+		}(&targets[i])
+	}
+	wg.Wait()
+
+	return domain.NewBulkResult(operation, options.dryRun, options.matched, targets)
+}
+
+// handleBulkUpdate handles the jira_bulk_update tool call.
+func (h *JiraHandler) handleBulkUpdate(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	fields, err := h.resolveFieldValues(client, args)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "fields must contain at least one field",
+		}
+	}
+	options, err := h.getBulkOptions(client, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the update to each issue
+	result := runBulk("update", options, func(issueKey string) error {
+		if options.dryRun {
+			return nil
+		}
+		return client.UpdateIssue(issueKey, &domain.JiraIssueUpdate{
+			Fields: domain.JiraFieldsUpdate{Extra: fields},
+		})
+	})
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(result)
+}
+
+// handleBulkTransition handles the jira_bulk_transition tool call.
+// The transition is resolved per issue, since issues may follow different workflows.
+func (h *JiraHandler) handleBulkTransition(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	target, _ := getStringParam(args, "transitionId", false)
+	if target == "" {
+		target, _ = getStringParam(args, "transitionName", false)
+	}
+	if target == "" {
+		target, _ = getStringParam(args, "toStatus", false)
+	}
+	if target == "" {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "either transitionId, transitionName or toStatus must be provided",
+		}
+	}
+
+	// Build the transition request shared by all issues
+	var transition domain.IssueTransition
+	if err := h.buildTransitionFields(client, args, &transition); err != nil {
+		return nil, err
+	}
+	options, err := h.getBulkOptions(client, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve and perform the transition on each issue
+	result := runBulk("transition", options, func(issueKey string) error {
+		available, err := client.GetTransitions(issueKey)
+		if err != nil {
+			return err
+		}
+		issueTransition := transition
+		if err := resolveTransition(available, issueKey, target, &issueTransition); err != nil {
+			return err
+		}
+		if options.dryRun {
+			return nil
+		}
+		return client.TransitionIssue(issueKey, &issueTransition)
+	})
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(result)
+}
+
+// handleBulkComment handles the jira_bulk_comment tool call.
+func (h *JiraHandler) handleBulkComment(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	body, err := getStringParam(args, "body", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	visibility, err := getCommentVisibility(args)
+	if err != nil {
+		return nil, err
+	}
+	format, err := getInputFormat(args)
+	if err != nil {
+		return nil, err
+	}
+	body = toWikiMarkup(body, format)
+	options, err := h.getBulkOptions(client, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the comment to each issue
+	result := runBulk("comment", options, func(issueKey string) error {
+		if options.dryRun {
+			return nil
+		}
+		return client.AddComment(issueKey, &domain.Comment{
+			Body:       body,
+			Visibility: visibility,
+		})
+	})
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(result)
+}
@@ -0,0 +1,169 @@
+package application
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraBulkRoutes serves a search for TEST-1..TEST-3 in pages of two, where TEST-3
+// cannot be closed, and accepts updates, transitions and comments on all issues
+// except comments on TEST-2.
+var jiraBulkRoutes = mockJiraRoutes{
+	"GET /rest/api/2/search": func(w http.ResponseWriter, r *http.Request) {
+		if startAt := r.URL.Query().Get("startAt"); startAt == "" || startAt == "0" {
+			w.Write([]byte(`{"total":3,"issues":[{"key":"TEST-1","fields":{"summary":"One"}},{"key":"TEST-2","fields":{"summary":"Two"}}]}`))
+			return
+		}
+		w.Write([]byte(`{"total":3,"issues":[{"key":"TEST-3","fields":{"summary":"Three"}}]}`))
+	},
+	"GET /rest/api/2/field":                    jiraReply(http.StatusOK, `[{"id":"priority","name":"Priority","schema":{"type":"priority","system":"priority"}}]`),
+	"GET /rest/api/2/issue/*/transitions":      jiraReply(http.StatusOK, `{"transitions":[{"id":"31","name":"Close","to":{"name":"Done"}}]}`),
+	"GET /rest/api/2/issue/TEST-3/transitions": jiraReply(http.StatusOK, `{"transitions":[{"id":"11","name":"Start","to":{"name":"In Progress"}}]}`),
+	"POST /rest/api/2/issue/*/transitions":     jiraReply(http.StatusNoContent, ""),
+	"POST /rest/api/2/issue/*/comment":         jiraReply(http.StatusCreated, `{"id":"1"}`),
+	"/rest/api/2/issue/TEST-2/comment":         jiraReply(http.StatusForbidden, `{"errorMessages":["No permission"]}`),
+	"PUT /rest/api/2/issue/*":                  jiraReply(http.StatusNoContent, ""),
+}
+
+// bulkWrites returns the POST and PUT requests received by m.
+func bulkWrites(m *mockJira) []string {
+	var writes []string
+	for _, request := range m.received() {
+		if request.Method == http.MethodPost || request.Method == http.MethodPut {
+			writes = append(writes, request.Method+" "+request.URL.Path)
+		}
+	}
+	return writes
+}
+
+func TestJiraHandler_BulkUpdate(t *testing.T) {
+	// Updates take a moment so that concurrent ones overlap
+	var mu sync.Mutex
+	var inFlight, maxInUse int
+	slowUpdate := func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		maxInUse = max(maxInUse, inFlight)
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	runJiraToolCases(t, jiraBulkRoutes, []jiraToolCase{
+		{
+			name:   "concurrent updates",
+			routes: mockJiraRoutes{"PUT /rest/api/2/issue/*": slowUpdate},
+			tool:   ToolJiraBulkUpdate,
+			args: map[string]interface{}{
+				"jql":         "project = TEST",
+				"fields":      map[string]interface{}{"Priority": "High"},
+				"concurrency": float64(2),
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var result domain.BulkResult
+				decodeResponse(t, resp, &result)
+				if result.Matched != 3 || result.Succeeded != 3 || result.Failed != 0 || result.Truncated {
+					t.Fatalf("unexpected result: %+v", result)
+				}
+				if result.Issues[2].IssueKey != "TEST-3" || result.Issues[2].Summary != "Three" {
+					t.Errorf("expected issues in search order, got %+v", result.Issues)
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				if writes := bulkWrites(m); len(writes) != 3 || maxInUse > 2 {
+					t.Errorf("expected 3 updates with at most 2 in flight, got %v (max %d)", writes, maxInUse)
+				}
+			},
+		},
+		{
+			name:     "no selection",
+			tool:     ToolJiraBulkUpdate,
+			args:     map[string]interface{}{"fields": map[string]interface{}{"Priority": "High"}},
+			wantCode: domain.InvalidParams,
+			check:    noWrites,
+		},
+		{
+			name: "both selections",
+			tool: ToolJiraBulkUpdate,
+			args: map[string]interface{}{
+				"jql":       "project = TEST",
+				"issueKeys": []interface{}{"TEST-1"},
+				"fields":    map[string]interface{}{"Priority": "High"},
+			},
+			wantCode: domain.InvalidParams,
+			check:    noWrites,
+		},
+		{
+			name:     "no fields",
+			tool:     ToolJiraBulkUpdate,
+			args:     map[string]interface{}{"jql": "project = TEST", "fields": map[string]interface{}{}},
+			wantCode: domain.InvalidParams,
+			check:    noWrites,
+		},
+	})
+}
+
+func TestJiraHandler_BulkTransition(t *testing.T) {
+	runJiraToolCases(t, jiraBulkRoutes, []jiraToolCase{
+		{
+			name: "dry run",
+			tool: ToolJiraBulkTransition,
+			args: map[string]interface{}{"jql": "project = TEST", "toStatus": "Done", "dryRun": true},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var result domain.BulkResult
+				decodeResponse(t, resp, &result)
+				if !result.DryRun || result.Planned != 2 || result.Failed != 1 || result.Issues[2].Status != domain.BulkStatusFailed {
+					t.Fatalf("unexpected dry run result: %+v", result)
+				}
+				noWrites(t, m, resp, err)
+			},
+		},
+		{
+			name: "issue limit",
+			tool: ToolJiraBulkTransition,
+			args: map[string]interface{}{"issueKeys": []interface{}{"TEST-1", "TEST-2", "TEST-3"}, "toStatus": "Done", "maxIssues": float64(2)},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var result domain.BulkResult
+				decodeResponse(t, resp, &result)
+				if result.Matched != 3 || result.Succeeded != 2 || !result.Truncated || len(result.Issues) != 2 {
+					t.Fatalf("unexpected result: %+v", result)
+				}
+				if writes := bulkWrites(m); len(writes) != 2 {
+					t.Errorf("expected 2 transitions, got %v", writes)
+				}
+			},
+		},
+	})
+}
+
+func TestJiraHandler_BulkComment(t *testing.T) {
+	runJiraToolCases(t, jiraBulkRoutes, []jiraToolCase{
+		{
+			name: "partial failure",
+			tool: ToolJiraBulkComment,
+			args: map[string]interface{}{"jql": "project = TEST", "body": "Released in 2.0"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var result domain.BulkResult
+				decodeResponse(t, resp, &result)
+				if result.Succeeded != 2 || result.Failed != 1 {
+					t.Fatalf("unexpected result: %+v", result)
+				}
+				if failed := result.Issues[1]; failed.IssueKey != "TEST-2" || !contains(failed.Error, "No permission") {
+					t.Errorf("expected TEST-2 to fail, got %+v", failed)
+				}
+				if queries := searchQueries(m); len(queries) != 2 {
+					t.Errorf("expected two search pages, got %v", queries)
+				}
+			},
+		},
+	})
+}
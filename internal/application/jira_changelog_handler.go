@@ -0,0 +1,148 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraChangelogTools returns the definitions of the Jira changelog tools.
+func jiraChangelogTools() []domain.ToolDefinition {
+	return []domain.ToolDefinition{
+		{
+			Name:        ToolJiraGetChangelog,
+			Description: "Get the change history of a Jira issue as field changes with author and time, oldest first",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"fields": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Only changes to these fields, by name or ID (optional, e.g., [\"status\", \"assignee\"])",
+					},
+					"startAt": map[string]interface{}{
+						"type":        "integer",
+						"description": "The index of the first history entry to return (0-based, optional)",
+					},
+					"maxResults": map[string]interface{}{
+						"type":        "integer",
+						"description": "The maximum number of history entries to return (optional)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+		{
+			Name:        ToolJiraGetTimeInStatus,
+			Description: "Compute how long a Jira issue has spent in each status, from its changelog",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+	}
+}
+
+// handleGetChangelog handles the jira_get_changelog tool call.
+func (h *JiraHandler) handleGetChangelog(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	fields, err := getStringArrayParam(args, "fields", false)
+	if err != nil {
+		return nil, err
+	}
+	startAt, err := getIntParam(args, "startAt", false)
+	if err != nil {
+		return nil, err
+	}
+	maxResults, err := getIntParam(args, "maxResults", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	changelog, err := client.GetChangelog(issueKey, startAt, maxResults)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(&domain.IssueChangelog{
+		IssueKey:   issueKey,
+		StartAt:    changelog.StartAt,
+		MaxResults: changelog.MaxResults,
+		Total:      changelog.Total,
+		Changes:    domain.FlattenChangelog(changelog.Histories, fields),
+	})
+}
+
+// Changelog paging used to compute time in status.
+const (
+	changelogPageSize   = 100
+	maxChangelogEntries = 5000
+)
+
+// handleGetTimeInStatus handles the jira_get_time_in_status tool call.
+func (h *JiraHandler) handleGetTimeInStatus(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	issue, err := client.GetIssue(issueKey)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Collect the whole history
+	var histories []domain.ChangeHistory
+	for len(histories) < maxChangelogEntries {
+		changelog, err := client.GetChangelog(issueKey, len(histories), changelogPageSize)
+		if err != nil {
+			return nil, h.mapper.MapError(err)
+		}
+		histories = append(histories, changelog.Histories...)
+		if len(changelog.Histories) == 0 || len(histories) >= changelog.Total {
+			break
+		}
+	}
+
+	// Transform the response
+	timeInStatus, err := domain.ComputeTimeInStatus(issue.Key, issue.Fields.Created, issue.Fields.Status.Name, histories, time.Now())
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+	return h.mapper.MapToToolResponse(timeInStatus)
+}
@@ -0,0 +1,68 @@
+package application
+
+import (
+	"net/http"
+	"testing"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraChangelogRoutes serves TEST-123, created 2024-01-01, moved to In Progress on
+// 2024-01-02 and to Done on 2024-01-03, with a changelog of one entry per page.
+var jiraChangelogRoutes = mockJiraRoutes{
+	"GET /rest/api/2/issue/TEST-123/changelog": func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("startAt") != "0" {
+			w.Write([]byte(`{"startAt":1,"maxResults":100,"total":2,"values":[
+				{"id":"101","author":{"name":"asmith"},"created":"2024-01-03T10:00:00.000+0000","items":[
+					{"field":"status","fromString":"In Progress","toString":"Done"}
+				]}
+			]}`))
+			return
+		}
+		w.Write([]byte(`{"startAt":0,"maxResults":1,"total":2,"values":[
+			{"id":"100","author":{"name":"jdoe","displayName":"John Doe"},"created":"2024-01-02T10:00:00.000+0000","items":[
+				{"field":"status","fromString":"Open","toString":"In Progress"},
+				{"field":"assignee","toString":"John Doe"}
+			]}
+		]}`))
+	},
+	"GET /rest/api/2/issue/TEST-123": jiraReply(http.StatusOK, `{"key":"TEST-123","fields":{"created":"2024-01-01T10:00:00.000+0000","status":{"name":"Done"}}}`),
+}
+
+func TestJiraHandler_Changelog(t *testing.T) {
+	runJiraToolCases(t, jiraChangelogRoutes, []jiraToolCase{
+		{
+			name: "status changes of the first page",
+			tool: ToolJiraGetChangelog,
+			args: map[string]interface{}{"issueKey": "TEST-123", "fields": []interface{}{"status"}},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var changelog domain.IssueChangelog
+				decodeResponse(t, resp, &changelog)
+				if changelog.IssueKey != "TEST-123" || changelog.Total != 2 || len(changelog.Changes) != 1 {
+					t.Fatalf("unexpected changelog: %+v", changelog)
+				}
+				if change := changelog.Changes[0]; change.Author != "jdoe" || change.From != "Open" || change.To != "In Progress" {
+					t.Errorf("unexpected change: %+v", change)
+				}
+			},
+		},
+		{
+			name: "time in status",
+			tool: ToolJiraGetTimeInStatus,
+			args: map[string]interface{}{"issueKey": "TEST-123"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var result domain.TimeInStatus
+				decodeResponse(t, resp, &result)
+				if result.CurrentStatus != "Done" || len(result.Statuses) != 3 {
+					t.Fatalf("unexpected result: %+v", result)
+				}
+				if result.Statuses[0].Status != "Open" || result.Statuses[0].Duration != "1d" || result.Statuses[1].Duration != "1d" {
+					t.Errorf("unexpected durations: %+v", result.Statuses)
+				}
+				if result.Statuses[2].Status != "Done" || result.Statuses[2].Seconds <= 0 {
+					t.Errorf("expected time in the current status to run until now: %+v", result.Statuses[2])
+				}
+			},
+		},
+	})
+}
@@ -0,0 +1,542 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"atlassian-mcp-server/internal/domain"
+	"atlassian-mcp-server/internal/infrastructure"
+)
+
+// jiraCloneTools returns the definitions of the Jira clone and move tools.
+func jiraCloneTools() []domain.ToolDefinition {
+	return []domain.ToolDefinition{
+		{
+			Name:        ToolJiraCloneIssue,
+			Description: "Clone a Jira issue, optionally into another project. Field values are carried over where the target create screen accepts them (components, versions and options are matched by name) and the clone is linked to the original with \"clones\". The response lists the fields, links and attachments that could not be copied",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The key of the issue to clone (e.g., PROJ-123)",
+					},
+					"targetProject": map[string]interface{}{
+						"type":        "string",
+						"description": "The key of the project to create the clone in (optional, defaults to the original's project)",
+					},
+					"issueType": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue type of the clone by name or ID (optional, defaults to the issue type of the same name)",
+					},
+					"summary": map[string]interface{}{
+						"type":        "string",
+						"description": "The summary of the clone (optional, defaults to \"CLONE - \" followed by the original summary)",
+					},
+					"includeSubtasks": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Also clone the sub-tasks under the clone (optional, default false)",
+					},
+					"includeLinks": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Re-create the issue links of the original on the clone (optional, default false)",
+					},
+					"includeAttachments": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Copy the attachments of the original, up to 10 MiB each (optional, default false)",
+					},
+					"fields": map[string]interface{}{
+						"type":        "object",
+						"description": "Field values overriding the copied ones, keyed by field name or ID, e.g. {\"Story Points\": 5} (optional). Use it for required fields of the target the source has no value for",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+		{
+			Name:        ToolJiraMoveIssue,
+			Description: "Move a Jira issue to another project by re-creating it there with its sub-tasks, links and attachments. Issue types are mapped by name, and the new issue is transitioned to the status of the original (or the status given in statusMapping). Comments and work logs are not copied: the original and its sub-tasks are kept with them and commented with their new keys but not linked to the new issues, which replace them. The response lists what could not be carried over",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The key of the issue to move (e.g., PROJ-123)",
+					},
+					"targetProject": map[string]interface{}{
+						"type":        "string",
+						"description": "The key of the project to move the issue to",
+					},
+					"issueType": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue type in the target project by name or ID (optional, defaults to the issue type of the same name)",
+					},
+					"statusMapping": map[string]interface{}{
+						"type":        "object",
+						"description": "Target statuses keyed by original status, e.g. {\"In Review\": \"In Progress\"} (optional). Unmapped statuses keep their name",
+					},
+					"sourceTransition": map[string]interface{}{
+						"type":        "string",
+						"description": "A transition to apply to the original and its sub-tasks after the move, by transition ID, name or target status (e.g., \"Done\") (optional)",
+					},
+					"fields": map[string]interface{}{
+						"type":        "object",
+						"description": "Field values overriding the copied ones, keyed by field name or ID, e.g. {\"Story Points\": 5} (optional). Use it for required fields of the target the source has no value for",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey", "targetProject"},
+			},
+		},
+	}
+}
+
+// clonePrefix is prepended to the summary of clones, as Jira does when cloning in the UI.
+const clonePrefix = "CLONE - "
+
+// handleCloneIssue handles the jira_clone_issue tool call.
+func (h *JiraHandler) handleCloneIssue(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	targetProject, _ := getStringParam(args, "targetProject", false)
+	issueType, _ := getStringParam(args, "issueType", false)
+	summary, _ := getStringParam(args, "summary", false)
+	includeSubtasks, err := getBoolParam(args, "includeSubtasks", false)
+	if err != nil {
+		return nil, err
+	}
+	includeLinks, err := getBoolParam(args, "includeLinks", false)
+	if err != nil {
+		return nil, err
+	}
+	includeAttachments, err := getBoolParam(args, "includeAttachments", false)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := h.resolveFieldValues(client, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load the source issue with field names for reporting
+	source, err := client.GetIssue(issueKey, "names")
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+	if targetProject == "" {
+		targetProject = source.Fields.Project.Key
+	}
+	if summary == "" {
+		summary = clonePrefix + source.Fields.Summary
+	}
+	parentKey, err := copyParentKey(source, targetProject, "cloned")
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the copy
+	result, err := h.copyIssue(client, source, &issueCopyOptions{
+		projectKey:  targetProject,
+		issueType:   issueType,
+		summary:     summary,
+		fields:      fields,
+		subtasks:    includeSubtasks,
+		links:       includeLinks,
+		attachments: includeAttachments,
+	}, parentKey)
+	if err != nil {
+		return nil, err
+	}
+	h.linkCopyToSource(client, result)
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(result)
+}
+
+// handleMoveIssue handles the jira_move_issue tool call.
+func (h *JiraHandler) handleMoveIssue(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+	targetProject, err := getStringParam(args, "targetProject", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	issueType, _ := getStringParam(args, "issueType", false)
+	sourceTransition, _ := getStringParam(args, "sourceTransition", false)
+	mapping, err := getObjectParam(args, "statusMapping", false)
+	if err != nil {
+		return nil, err
+	}
+	statusMapping := make(map[string]string, len(mapping))
+	for from, to := range mapping {
+		status, ok := to.(string)
+		if !ok || status == "" {
+			return nil, &domain.Error{
+				Code:    domain.InvalidParams,
+				Message: fmt.Sprintf("statusMapping: status for '%s' must be a non-empty string", from),
+			}
+		}
+		statusMapping[strings.ToLower(from)] = status
+	}
+	fields, err := h.resolveFieldValues(client, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load the source issue with field names for reporting
+	source, err := client.GetIssue(issueKey, "names")
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+	if strings.EqualFold(source.Fields.Project.Key, targetProject) && issueType == "" {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("%s is already in project %s", issueKey, source.Fields.Project.Key),
+		}
+	}
+	parentKey, err := copyParentKey(source, targetProject, "moved")
+	if err != nil {
+		return nil, err
+	}
+
+	// Re-create the issue with its sub-tasks, links and attachments
+	result, err := h.copyIssue(client, source, &issueCopyOptions{
+		projectKey:    targetProject,
+		issueType:     issueType,
+		summary:       source.Fields.Summary,
+		fields:        fields,
+		subtasks:      true,
+		links:         true,
+		attachments:   true,
+		statusMapping: statusMapping,
+	}, parentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Point the originals at the new issues and optionally close them; unlike clones,
+	// moved issues are not linked to their originals, which they replace
+	h.markMoved(client, result, sourceTransition)
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(result)
+}
+
+// markMoved comments on the original of a moved issue and of each moved sub-task with
+// the new key and applies transition (if set) to them, sub-tasks first so that workflows
+// requiring closed sub-tasks let the parent close. Failures are reported as skipped.
+func (h *JiraHandler) markMoved(client *infrastructure.JiraClient, result *domain.IssueCopy, transition string) {
+	for _, subtask := range result.Subtasks {
+		h.markMoved(client, subtask, transition)
+	}
+
+	comment := &domain.Comment{Body: fmt.Sprintf("This issue was moved to %s.", result.Key)}
+	if err := client.AddComment(result.Source, comment); err != nil {
+		result.Skipped = append(result.Skipped, domain.SkippedField{Field: "comment", Name: result.Source, Reason: err.Error()})
+	}
+	if transition != "" {
+		if err := h.transitionIssueTo(client, result.Source, transition); err != nil {
+			result.Skipped = append(result.Skipped, domain.SkippedField{Field: "status", Name: result.Source, Reason: err.Error()})
+		}
+	}
+}
+
+// issueCopyOptions controls what copyIssue carries over to the new issue.
+type issueCopyOptions struct {
+	projectKey    string
+	issueType     string                 // Issue type name or ID; the source's type if empty
+	summary       string                 // The source's summary if empty
+	fields        map[string]interface{} // Field values overriding the copied ones
+	subtasks      bool
+	links         bool
+	attachments   bool
+	statusMapping map[string]string // Target status by lower-case source status; nil keeps the initial status
+}
+
+// copyParentKey returns the parent a copy of source is created under: the parent of
+// a sub-task, which must stay in its parent's project, or "" for other issues.
+func copyParentKey(source *domain.JiraIssue, projectKey, action string) (string, error) {
+	parentKey, err := source.Fields.ParentKey()
+	if err != nil {
+		return "", &domain.Error{
+			Code:    domain.InternalError,
+			Message: err.Error(),
+		}
+	}
+	if parentKey != "" && !strings.EqualFold(source.Fields.Project.Key, projectKey) {
+		return "", &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("%s is a sub-task of %s; sub-tasks can only be %s with their parent", source.Key, parentKey, action),
+		}
+	}
+	return parentKey, nil
+}
+
+// copyIssue creates a copy of source in the project of opts, as a sub-task of parentKey
+// if set. Fields, links, attachments and sub-tasks that cannot be carried over are
+// reported in the result; only a failure to create the issue itself is an error.
+func (h *JiraHandler) copyIssue(client *infrastructure.JiraClient, source *domain.JiraIssue, opts *issueCopyOptions, parentKey string) (*domain.IssueCopy, error) {
+	// Map the issue type
+	issueTypes, err := client.GetCreateMetaIssueTypes(opts.projectKey)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+	var issueType *domain.CreateMetaIssueType
+	if opts.issueType != "" {
+		issueType, err = domain.ResolveCreateMetaIssueType(issueTypes, opts.projectKey, opts.issueType)
+	} else {
+		issueType, err = domain.MapIssueType(issueTypes, opts.projectKey, source.Fields.IssueType)
+	}
+	if err != nil {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: err.Error(),
+		}
+	}
+
+	// Copy the field values the target create screen accepts
+	metaFields, err := client.GetCreateMetaFields(opts.projectKey, string(issueType.ID))
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+	values, skipped := domain.CopyFieldValues(source, metaFields, issueType.Name, opts.projectKey)
+	if assignee, ok := values["assignee"].(map[string]interface{}); ok && !strings.EqualFold(source.Fields.Project.Key, opts.projectKey) {
+		name, _ := assignee["name"].(string)
+		if !h.isAssignable(client, opts.projectKey, name) {
+			delete(values, "assignee")
+			skipped = append(skipped, domain.SkippedField{
+				Field:  "assignee",
+				Name:   "Assignee",
+				Reason: fmt.Sprintf("%s cannot be assigned issues in %s", name, opts.projectKey),
+			})
+		}
+	}
+	for id, value := range opts.fields {
+		values[id] = value
+	}
+
+	summary := opts.summary
+	if summary == "" {
+		summary = source.Fields.Summary
+	}
+	createReq := &domain.JiraIssueCreate{
+		Fields: domain.JiraFieldsCreate{
+			Summary:   summary,
+			IssueType: domain.IssueTypeRef{ID: string(issueType.ID)},
+			Project:   domain.ProjectRef{Key: opts.projectKey},
+			Extra:     values,
+		},
+	}
+	if parentKey != "" {
+		createReq.Fields.Parent = &domain.IssueRef{Key: parentKey}
+	}
+
+	// Report required fields the source has no value for
+	provided := map[string]bool{"summary": true, "issuetype": true, "project": true, "parent": parentKey != ""}
+	for id := range values {
+		provided[id] = true
+	}
+	if missing := domain.MissingRequiredFields(metaFields, provided); len(missing) > 0 {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: domain.FormatMissingFields(issueType.Name, opts.projectKey, missing) + "\nprovide them with the fields parameter",
+			Data: map[string]interface{}{
+				"missingFields": missing,
+			},
+		}
+	}
+
+	// Call the Jira client
+	created, err := client.CreateIssue(createReq)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+	result := &domain.IssueCopy{
+		Source:    source.Key,
+		Key:       created.Key,
+		Project:   opts.projectKey,
+		IssueType: issueType.Name,
+		Skipped:   append(skipped, domain.UncopiedContent(source, opts.links, opts.attachments)...),
+	}
+
+	// Bring the copy to the mapped status
+	if opts.statusMapping != nil {
+		status := source.Fields.Status.Name
+		if mapped, ok := opts.statusMapping[strings.ToLower(status)]; ok {
+			status = mapped
+		}
+		result.Status = h.mapStatus(client, result, status)
+	}
+
+	if opts.links {
+		h.copyIssueLinks(client, source, result)
+	}
+	if opts.attachments {
+		h.copyAttachments(client, source, result)
+	}
+
+	// Copy the sub-tasks under the new issue
+	if opts.subtasks {
+		keys, err := source.Fields.SubtaskKeys()
+		if err != nil {
+			result.Skipped = append(result.Skipped, domain.SkippedField{Field: "subtasks", Reason: err.Error()})
+		}
+		subtaskOpts := &issueCopyOptions{
+			projectKey:    opts.projectKey,
+			links:         opts.links,
+			attachments:   opts.attachments,
+			statusMapping: opts.statusMapping,
+		}
+		for _, key := range keys {
+			subtask, err := client.GetIssue(key, "names")
+			if err == nil {
+				var copied *domain.IssueCopy
+				if copied, err = h.copyIssue(client, subtask, subtaskOpts, created.Key); err == nil {
+					result.Subtasks = append(result.Subtasks, copied)
+					continue
+				}
+			}
+			result.Skipped = append(result.Skipped, domain.SkippedField{Field: "subtasks", Name: key, Reason: err.Error()})
+		}
+	}
+
+	return result, nil
+}
+
+// isAssignable reports whether the user can be assigned issues in the project.
+// If the check itself fails the user is assumed to be assignable.
+func (h *JiraHandler) isAssignable(client *infrastructure.JiraClient, projectKey, username string) bool {
+	users, err := client.GetAssignableUsers(&infrastructure.AssignableUserOptions{
+		ProjectKey: projectKey,
+		Query:      username,
+		MaxResults: 50,
+	})
+	if err != nil {
+		return true
+	}
+	for _, user := range users {
+		if strings.EqualFold(user.Name, username) {
+			return true
+		}
+	}
+	return false
+}
+
+// mapStatus transitions a copied issue to the status with the given name and returns
+// the status it ends up in. Statuses that cannot be reached in one transition are
+// reported as skipped.
+func (h *JiraHandler) mapStatus(client *infrastructure.JiraClient, result *domain.IssueCopy, status string) string {
+	issue, err := client.GetIssue(result.Key)
+	if err != nil {
+		result.Skipped = append(result.Skipped, domain.SkippedField{Field: "status", Name: status, Reason: err.Error()})
+		return ""
+	}
+	if strings.EqualFold(issue.Fields.Status.Name, status) {
+		return issue.Fields.Status.Name
+	}
+
+	if err := h.transitionIssueTo(client, result.Key, status); err != nil {
+		result.Skipped = append(result.Skipped, domain.SkippedField{Field: "status", Name: status, Reason: err.Error()})
+		return issue.Fields.Status.Name
+	}
+	return status
+}
+
+// transitionIssueTo applies the transition identified by target (a transition ID,
+// name or target status) to the issue.
+func (h *JiraHandler) transitionIssueTo(client *infrastructure.JiraClient, issueKey, target string) error {
+	available, err := client.GetTransitions(issueKey)
+	if err != nil {
+		return err
+	}
+	var transition domain.IssueTransition
+	if err := resolveTransition(available, issueKey, target, &transition); err != nil {
+		return err
+	}
+	return client.TransitionIssue(issueKey, &transition)
+}
+
+// copyIssueLinks re-creates the links of source on the copy.
+func (h *JiraHandler) copyIssueLinks(client *infrastructure.JiraClient, source *domain.JiraIssue, result *domain.IssueCopy) {
+	links, err := source.Fields.IssueLinks()
+	if err != nil {
+		result.Skipped = append(result.Skipped, domain.SkippedField{Field: "issuelinks", Reason: err.Error()})
+		return
+	}
+	for _, link := range links {
+		if err := client.CreateIssueLink(link.LinkRequest(result.Key)); err != nil {
+			result.Skipped = append(result.Skipped, domain.SkippedField{Field: "issuelinks", Name: link.String(), Reason: err.Error()})
+			continue
+		}
+		result.Links = append(result.Links, link.String())
+	}
+}
+
+// copyAttachments downloads the attachments of source and uploads them to the copy.
+func (h *JiraHandler) copyAttachments(client *infrastructure.JiraClient, source *domain.JiraIssue, result *domain.IssueCopy) {
+	attachments, err := client.GetAttachments(source.Key)
+	if err != nil {
+		result.Skipped = append(result.Skipped, domain.SkippedField{Field: "attachment", Reason: err.Error()})
+		return
+	}
+	for i := range attachments {
+		attachment := &attachments[i]
+		if attachment.Size > maxAttachmentSize {
+			result.Skipped = append(result.Skipped, domain.SkippedField{
+				Field:  "attachment",
+				Name:   attachment.Filename,
+				Reason: fmt.Sprintf("%d bytes exceeds the limit of %d bytes", attachment.Size, maxAttachmentSize),
+			})
+			continue
+		}
+		content, err := client.DownloadAttachment(attachment, maxAttachmentSize)
+		if err == nil {
+			_, err = client.AddAttachment(result.Key, attachment.Filename, content)
+		}
+		if err != nil {
+			result.Skipped = append(result.Skipped, domain.SkippedField{Field: "attachment", Name: attachment.Filename, Reason: err.Error()})
+			continue
+		}
+		result.Attachments = append(result.Attachments, attachment.Filename)
+	}
+}
+
+// linkCopyToSource links the copy to its source with "<copy> clones <source>".
+func (h *JiraHandler) linkCopyToSource(client *infrastructure.JiraClient, result *domain.IssueCopy) {
+	linkTypes, err := client.GetIssueLinkTypes()
+	if err == nil {
+		var link *domain.IssueLinkRequest
+		if link, err = domain.NewIssueLinkRequest(linkTypes, result.Key, "clones", result.Source); err == nil {
+			err = client.CreateIssueLink(link)
+		}
+	}
+	if err != nil {
+		result.Skipped = append(result.Skipped, domain.SkippedField{Field: "issuelinks", Name: "clones " + result.Source, Reason: err.Error()})
+		return
+	}
+	result.Links = append(result.Links, "clones "+result.Source)
+}
@@ -0,0 +1,240 @@
+package application
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraIssueWithAttachments serves issue, or only its attachments when the request
+// asks for the attachment field.
+func jiraIssueWithAttachments(issue, attachments string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("fields") == "attachment" {
+			w.Write([]byte(attachments))
+			return
+		}
+		w.Write([]byte(issue))
+	}
+}
+
+// jiraCloneRoutes serves bug TEST-1, in review with a component, a custom field, a link
+// to TEST-2, two attachments, two comments, a work log and sub-task TEST-3, and a PLAT
+// project whose Bug create screen only has summary, description, assignee and an API
+// component. Created sub-tasks become PLAT-2 and other issues PLAT-1.
+var jiraCloneRoutes = mockJiraRoutes{
+	"/rest/api/2/issue/TEST-1": jiraIssueWithAttachments(`{"key":"TEST-1","fields":{
+		"summary":"Login fails","description":"Steps","issuetype":{"id":"1","name":"Bug"},
+		"project":{"key":"TEST"},"status":{"name":"In Review"},"assignee":{"name":"jdoe"},
+		"components":[{"id":"100","name":"API"},{"id":"101","name":"Legacy"}],"customfield_10004":"note",
+		"issuelinks":[{"id":"7","type":{"name":"Blocks","inward":"is blocked by","outward":"blocks"},"outwardIssue":{"key":"TEST-2"}}],
+		"subtasks":[{"key":"TEST-3"}],
+		"comment":{"startAt":0,"maxResults":2,"total":2,"comments":[{"id":"1","body":"Seen on prod"},{"id":"2","body":"Fixed?"}]},
+		"worklog":{"startAt":0,"maxResults":1,"total":1,"worklogs":[{"id":"3","timeSpentSeconds":3600}]}},
+		"names":{"customfield_10004":"Internal Notes","comment":"Comment","worklog":"Log Work"}}`,
+		`{"key":"TEST-1","fields":{"attachment":[{"id":"50","filename":"log.txt","size":5},{"id":"51","filename":"dump.bin","size":104857600}]}}`),
+	"/rest/api/2/issue/TEST-3": jiraIssueWithAttachments(
+		`{"key":"TEST-3","fields":{"summary":"Write test","issuetype":{"id":"4","name":"Sub-task","subtask":true},"project":{"key":"TEST"},"status":{"name":"Open"},"parent":{"key":"TEST-1"}}}`,
+		`{"key":"TEST-3","fields":{}}`),
+	"/rest/api/2/issue/createmeta/*/issuetypes": jiraReply(http.StatusOK, `{"isLast":true,"values":[{"id":"1","name":"Bug"},{"id":"5","name":"Technical task","subtask":true}]}`),
+	"/rest/api/2/issue/createmeta/*/issuetypes/1": jiraReply(http.StatusOK, `{"isLast":true,"values":[{"fieldId":"summary","name":"Summary","required":true},{"fieldId":"description","name":"Description"},
+		{"fieldId":"assignee","name":"Assignee","schema":{"type":"user"}},
+		{"fieldId":"components","name":"Component/s","allowedValues":[{"id":"900","name":"API"}]}]}`),
+	"/rest/api/2/issue/createmeta/*/issuetypes/5": jiraReply(http.StatusOK, `{"isLast":true,"values":[{"fieldId":"summary","name":"Summary","required":true},{"fieldId":"parent","name":"Parent","required":true}]}`),
+	"/rest/api/2/user/assignable/search":          jiraReply(http.StatusOK, `[]`),
+	"POST /rest/api/2/issue": func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Fields map[string]json.RawMessage `json:"fields"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusCreated)
+		if _, subtask := body.Fields["parent"]; subtask {
+			w.Write([]byte(`{"key":"PLAT-2"}`))
+			return
+		}
+		w.Write([]byte(`{"key":"PLAT-1"}`))
+	},
+	"GET /rest/api/2/issue/PLAT-1/transitions": jiraReply(http.StatusOK, `{"transitions":[{"id":"21","name":"Submit","to":{"name":"In Review"}}]}`),
+	"GET /rest/api/2/issue/TEST-1/transitions": jiraReply(http.StatusOK, `{"transitions":[{"id":"31","name":"Close","to":{"name":"Closed"}}]}`),
+	"GET /rest/api/2/issue/TEST-3/transitions": jiraReply(http.StatusOK, `{"transitions":[{"id":"31","name":"Close","to":{"name":"Closed"}}]}`),
+	"/rest/api/2/issue/*/transitions":          jiraReply(http.StatusNoContent, ""),
+	"/rest/api/2/issue/PLAT-*":                 jiraReply(http.StatusOK, `{"fields":{"status":{"name":"Open"}}}`),
+	"/rest/api/2/issue/PLAT-*/attachments":     jiraReply(http.StatusOK, `[{"id":"60","filename":"log.txt"}]`),
+	"/rest/api/2/issueLinkType":                jiraReply(http.StatusOK, `{"issueLinkTypes":[{"name":"Blocks","inward":"is blocked by","outward":"blocks"},{"name":"Cloners","inward":"is cloned by","outward":"clones"}]}`),
+	"/rest/api/2/issueLink":                    jiraReply(http.StatusCreated, ""),
+	"/rest/api/2/issue/TEST-1/comment":         jiraReply(http.StatusCreated, ""),
+	"/rest/api/2/issue/TEST-3/comment":         jiraReply(http.StatusCreated, ""),
+	"/secure/attachment/50/log.txt":            jiraReply(http.StatusOK, "hello"),
+}
+
+// sentBodies returns the JSON object bodies of the "METHOD /path" requests in order.
+func sentBodies(m *mockJira, route string) []map[string]interface{} {
+	var bodies []map[string]interface{}
+	for _, request := range m.received() {
+		if request.Method+" "+request.URL.Path == route {
+			bodies = append(bodies, request.Body)
+		}
+	}
+	return bodies
+}
+
+// skipReason returns why field was not copied to result, or "". A non-empty name also
+// matches the skipped item's name, such as an attachment's file name.
+func skipReason(result domain.IssueCopy, field, name string) string {
+	for _, skipped := range result.Skipped {
+		if skipped.Field == field && (name == "" || skipped.Name == name) {
+			return skipped.Reason
+		}
+	}
+	return ""
+}
+
+// subtaskKeptInProject checks that the sub-task was not copied to another project.
+func subtaskKeptInProject(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+	t.Helper()
+	if m.calls("POST /rest/api/2/issue") != 0 {
+		t.Errorf("expected nothing to be created, got %v", m.log())
+	}
+}
+
+func TestJiraHandler_CloneIssue(t *testing.T) {
+	runJiraToolCases(t, jiraCloneRoutes, []jiraToolCase{
+		{
+			name: "to another project with links",
+			tool: ToolJiraCloneIssue,
+			args: map[string]interface{}{"issueKey": "TEST-1", "targetProject": "PLAT", "includeLinks": true},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var result domain.IssueCopy
+				decodeResponse(t, resp, &result)
+				if result.Key != "PLAT-1" || result.IssueType != "Bug" || result.Status != "" || len(result.Subtasks) != 0 {
+					t.Errorf("unexpected result: %+v", result)
+				}
+				if len(result.Links) != 2 || result.Links[0] != "blocks TEST-2" || result.Links[1] != "clones TEST-1" {
+					t.Errorf("unexpected links: %v", result.Links)
+				}
+
+				// Fields are copied where the target accepts them, components matched by name
+				fields := sentFields(t, m, "POST /rest/api/2/issue")
+				components, _ := fields["components"].([]interface{})
+				if fields["summary"] != "CLONE - Login fails" || fields["description"] != "Steps" || len(components) != 1 ||
+					components[0].(map[string]interface{})["id"] != "900" || fields["assignee"] != nil {
+					t.Errorf("unexpected create body: %v", fields)
+				}
+				if !contains(skipReason(result, "components", ""), "Legacy") || !contains(skipReason(result, "customfield_10004", ""), "create screen") ||
+					!contains(skipReason(result, "assignee", ""), "jdoe") {
+					t.Errorf("unexpected skipped fields: %+v", result.Skipped)
+				}
+
+				// The clone reads "PLAT-1 clones TEST-1" and "PLAT-1 blocks TEST-2"
+				links := sentBodies(m, "POST /rest/api/2/issueLink")
+				if len(links) != 2 || links[0]["inwardIssue"].(map[string]interface{})["key"] != "PLAT-1" ||
+					links[1]["type"].(map[string]interface{})["name"] != "Cloners" || links[1]["outwardIssue"].(map[string]interface{})["key"] != "TEST-1" {
+					t.Errorf("unexpected links: %v", links)
+				}
+			},
+		},
+		{
+			name: "sub-task under its parent",
+			tool: ToolJiraCloneIssue,
+			args: map[string]interface{}{"issueKey": "TEST-3"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var result domain.IssueCopy
+				decodeResponse(t, resp, &result)
+				if result.IssueType != "Technical task" || result.Project != "TEST" {
+					t.Errorf("unexpected result: %+v", result)
+				}
+				fields := sentFields(t, m, "POST /rest/api/2/issue")
+				if parent, ok := fields["parent"].(map[string]interface{}); !ok || parent["key"] != "TEST-1" {
+					t.Errorf("expected the clone to be created under TEST-1, got %v", fields)
+				}
+			},
+		},
+		{
+			name:     "sub-task to another project",
+			tool:     ToolJiraCloneIssue,
+			args:     map[string]interface{}{"issueKey": "TEST-3", "targetProject": "PLAT"},
+			wantCode: domain.InvalidParams,
+			wantMsg:  "with their parent",
+			check:    subtaskKeptInProject,
+		},
+	})
+}
+
+func TestJiraHandler_MoveIssue(t *testing.T) {
+	runJiraToolCases(t, jiraCloneRoutes, []jiraToolCase{
+		{
+			name: "to another project",
+			tool: ToolJiraMoveIssue,
+			args: map[string]interface{}{
+				"issueKey":         "TEST-1",
+				"targetProject":    "PLAT",
+				"sourceTransition": "Closed",
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var result domain.IssueCopy
+				decodeResponse(t, resp, &result)
+
+				// The summary is kept and the status reached with a transition
+				created := sentBodies(m, "POST /rest/api/2/issue")
+				if result.Key != "PLAT-1" || result.Status != "In Review" || len(created) == 0 || created[0]["fields"].(map[string]interface{})["summary"] != "Login fails" {
+					t.Errorf("unexpected result: %+v", result)
+				}
+				if len(result.Attachments) != 1 || result.Attachments[0] != "log.txt" {
+					t.Errorf("unexpected attachments: %v", result.Attachments)
+				}
+				if len(result.Subtasks) != 1 || result.Subtasks[0].Key != "PLAT-2" || result.Subtasks[0].IssueType != "Technical task" || result.Subtasks[0].Status != "Open" {
+					t.Fatalf("unexpected sub-tasks: %+v", result.Subtasks)
+				}
+				if len(created) != 2 || created[1]["fields"].(map[string]interface{})["parent"].(map[string]interface{})["key"] != "PLAT-1" {
+					t.Errorf("expected the sub-task to be created under PLAT-1, got %v", created)
+				}
+
+				if !contains(skipReason(result, "attachment", "dump.bin"), "exceeds the limit") {
+					t.Errorf("expected the large attachment to be skipped, got %+v", result.Skipped)
+				}
+				if skipReason(result, "comment", "Comment") != "2 comments not copied, left on TEST-1" ||
+					skipReason(result, "worklog", "Log Work") != "1 work log not copied, left on TEST-1" || skipReason(result, "issuelinks", "") != "" {
+					t.Errorf("expected the comments and work log to be reported, got %+v", result.Skipped)
+				}
+				for _, link := range result.Links {
+					if strings.HasPrefix(link, "clones ") {
+						t.Errorf("expected the moved issue not to be linked to its original, got %v", result.Links)
+					}
+				}
+
+				// The originals are commented and closed, the sub-task before its parent
+				var requests []string
+				for _, request := range m.received() {
+					requests = append(requests, request.Method+" "+request.URL.Path)
+				}
+				joined := strings.Join(requests, "\n")
+				if !contains(joined, "POST /rest/api/2/issue/PLAT-1/transitions") || !contains(joined, "POST /rest/api/2/issue/TEST-1/comment") ||
+					!contains(joined, "POST /rest/api/2/issue/TEST-3/comment") ||
+					!contains(joined, "POST /rest/api/2/issue/TEST-3/transitions\nPOST /rest/api/2/issue/TEST-1/comment") ||
+					!contains(joined, "POST /rest/api/2/issue/TEST-1/transitions") {
+					t.Errorf("unexpected requests:\n%s", joined)
+				}
+				if len(result.Subtasks[0].Skipped) != 0 {
+					t.Errorf("unexpected sub-task skips: %+v", result.Subtasks[0].Skipped)
+				}
+			},
+		},
+		{
+			name:     "sub-task on its own",
+			tool:     ToolJiraMoveIssue,
+			args:     map[string]interface{}{"issueKey": "TEST-3", "targetProject": "PLAT"},
+			wantCode: domain.InvalidParams,
+			wantMsg:  "with their parent",
+			check:    subtaskKeptInProject,
+		},
+		{
+			name:     "same project and issue type",
+			tool:     ToolJiraMoveIssue,
+			args:     map[string]interface{}{"issueKey": "TEST-1", "targetProject": "test"},
+			wantCode: domain.InvalidParams,
+		},
+	})
+}
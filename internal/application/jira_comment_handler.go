@@ -0,0 +1,271 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"atlassian-mcp-server/internal/domain"
+	"atlassian-mcp-server/internal/infrastructure"
+)
+
+// jiraCommentTools returns the definitions of the Jira comment tools.
+func jiraCommentTools() []domain.ToolDefinition {
+	return []domain.ToolDefinition{
+		{
+			Name:        ToolJiraGetComments,
+			Description: "List the comments on a Jira issue with their authors, timestamps and visibility",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"startAt": map[string]interface{}{
+						"type":        "integer",
+						"description": "The index of the first comment to return (0-based, optional)",
+					},
+					"maxResults": map[string]interface{}{
+						"type":        "integer",
+						"description": "The maximum number of comments to return (optional)",
+					},
+					"orderBy": map[string]interface{}{
+						"type":        "string",
+						"enum":        []string{"created", "-created"},
+						"description": "Sort order: created (oldest first) or -created (newest first) (optional)",
+					},
+					"format": getOutputFormatSchema(),
+					"auth":   getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+		{
+			Name:        ToolJiraUpdateComment,
+			Description: "Edit the text or visibility of a comment on a Jira issue",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"commentId": map[string]interface{}{
+						"type":        "string",
+						"description": "The comment ID",
+					},
+					"body": map[string]interface{}{
+						"type":        "string",
+						"description": "The new comment text",
+					},
+					"format":     getInputFormatSchema(),
+					"visibility": getCommentVisibilitySchema(),
+					"auth":       getAuthSchema(),
+				},
+				Required: []string{"issueKey", "commentId", "body"},
+			},
+		},
+		{
+			Name:        ToolJiraDeleteComment,
+			Description: "Delete a comment from a Jira issue",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"commentId": map[string]interface{}{
+						"type":        "string",
+						"description": "The comment ID",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey", "commentId"},
+			},
+		},
+	}
+}
+
+// getCommentVisibilitySchema returns the schema for the optional comment visibility restriction.
+func getCommentVisibilitySchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "object",
+		"description": "Restrict the comment to a project role or group (optional)",
+		"properties": map[string]interface{}{
+			"type": map[string]interface{}{
+				"type":        "string",
+				"enum":        []string{domain.CommentVisibilityRole, domain.CommentVisibilityGroup},
+				"description": "Restriction type",
+			},
+			"value": map[string]interface{}{
+				"type":        "string",
+				"description": "Role or group name (e.g., Developers)",
+			},
+		},
+		"required": []string{"type", "value"},
+	}
+}
+
+// getCommentVisibility extracts the optional comment visibility restriction from the arguments.
+func getCommentVisibility(args map[string]interface{}) (*domain.CommentVisibility, error) {
+	visibility, err := getObjectParam(args, "visibility", false)
+	if err != nil || visibility == nil {
+		return nil, err
+	}
+
+	restrictionType, err := getStringParam(visibility, "type", true)
+	if err != nil {
+		return nil, err
+	}
+	if restrictionType != domain.CommentVisibilityRole && restrictionType != domain.CommentVisibilityGroup {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("visibility type must be '%s' or '%s'", domain.CommentVisibilityRole, domain.CommentVisibilityGroup),
+		}
+	}
+	value, err := getStringParam(visibility, "value", true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.CommentVisibility{
+		Type:  restrictionType,
+		Value: value,
+	}, nil
+}
+
+// handleGetComments handles the jira_get_comments tool call.
+func (h *JiraHandler) handleGetComments(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	startAt, err := getIntParam(args, "startAt", false)
+	if err != nil {
+		return nil, err
+	}
+	maxResults, err := getIntParam(args, "maxResults", false)
+	if err != nil {
+		return nil, err
+	}
+	orderBy, err := getStringParam(args, "orderBy", false)
+	if err != nil {
+		return nil, err
+	}
+	if orderBy != "" && orderBy != "created" && orderBy != "-created" {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "orderBy must be 'created' or '-created'",
+		}
+	}
+	format, err := getOutputFormat(args)
+	if err != nil {
+		return nil, err
+	}
+	options := &infrastructure.CommentOptions{
+		StartAt:    startAt,
+		MaxResults: maxResults,
+		OrderBy:    orderBy,
+	}
+	if format == domain.TextFormatRendered {
+		options.Expand = "renderedBody"
+	}
+
+	// Call the Jira client
+	page, err := client.GetComments(issueKey, options)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+	for i := range page.Comments {
+		page.Comments[i].Body = fromWikiMarkup(page.Comments[i].Body, format)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(page)
+}
+
+// handleUpdateComment handles the jira_update_comment tool call.
+func (h *JiraHandler) handleUpdateComment(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+	commentID, err := getStringParam(args, "commentId", true)
+	if err != nil {
+		return nil, err
+	}
+	body, err := getStringParam(args, "body", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	visibility, err := getCommentVisibility(args)
+	if err != nil {
+		return nil, err
+	}
+	format, err := getInputFormat(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	comment, err := client.UpdateComment(issueKey, commentID, &domain.Comment{
+		Body:       toWikiMarkup(body, format),
+		Visibility: visibility,
+	})
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(comment)
+}
+
+// handleDeleteComment handles the jira_delete_comment tool call.
+func (h *JiraHandler) handleDeleteComment(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+	commentID, err := getStringParam(args, "commentId", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	err = client.DeleteComment(issueKey, commentID)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Return success response
+	return h.mapper.MapToToolResponse(map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("Comment %s deleted from issue %s successfully", commentID, issueKey),
+	})
+}
@@ -0,0 +1,117 @@
+package application
+
+import (
+	"net/http"
+	"testing"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraCommentRoutes serves comment 100 on TEST-123; listed comments echo the requested order.
+var jiraCommentRoutes = mockJiraRoutes{
+	"GET /rest/api/2/issue/TEST-123/comment": func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"startAt":0,"maxResults":50,"total":1,"comments":[
+			{"id":"100","body":"` + r.URL.Query().Get("orderBy") + `","author":{"name":"jdoe"},"created":"2024-01-01T10:00:00.000+0000"}
+		]}`))
+	},
+	"POST /rest/api/2/issue/TEST-123/comment":       jiraReply(http.StatusCreated, ""),
+	"PUT /rest/api/2/issue/TEST-123/comment/100":    jiraReply(http.StatusOK, `{"id":"100","body":"Edited","visibility":{"type":"role","value":"Developers"}}`),
+	"DELETE /rest/api/2/issue/TEST-123/comment/100": jiraReply(http.StatusNoContent, ""),
+}
+
+func TestJiraHandler_Comments(t *testing.T) {
+	runJiraToolCases(t, jiraCommentRoutes, []jiraToolCase{
+		{
+			name: "list comments",
+			tool: ToolJiraGetComments,
+			args: map[string]interface{}{"issueKey": "TEST-123", "orderBy": "-created"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var page domain.CommentPage
+				decodeResponse(t, resp, &page)
+				if len(page.Comments) != 1 || page.Comments[0].Body != "-created" || page.Comments[0].Author.Name != "jdoe" {
+					t.Errorf("unexpected comments: %+v", page)
+				}
+			},
+		},
+		{
+			name:     "unsupported order",
+			tool:     ToolJiraGetComments,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "orderBy": "author"},
+			wantCode: domain.InvalidParams,
+		},
+		{
+			name: "add with visibility",
+			tool: ToolJiraAddComment,
+			args: map[string]interface{}{
+				"issueKey":   "TEST-123",
+				"body":       "Internal note",
+				"visibility": map[string]interface{}{"type": "group", "value": "jira-developers"},
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				body := m.body("POST /rest/api/2/issue/TEST-123/comment")
+				visibility, _ := body["visibility"].(map[string]interface{})
+				if visibility["type"] != "group" || visibility["value"] != "jira-developers" {
+					t.Errorf("expected group visibility, got %v", body)
+				}
+			},
+		},
+		{
+			name:     "visibility not an object",
+			tool:     ToolJiraAddComment,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "body": "Internal note", "visibility": "Developers"},
+			wantCode: domain.InvalidParams,
+			check:    noRequests,
+		},
+		{
+			name:     "unknown visibility type",
+			tool:     ToolJiraAddComment,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "body": "Internal note", "visibility": map[string]interface{}{"type": "user", "value": "jdoe"}},
+			wantCode: domain.InvalidParams,
+			check:    noRequests,
+		},
+		{
+			name:     "visibility without value",
+			tool:     ToolJiraAddComment,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "body": "Internal note", "visibility": map[string]interface{}{"type": "role"}},
+			wantCode: domain.InvalidParams,
+			check:    noRequests,
+		},
+		{
+			name: "update",
+			tool: ToolJiraUpdateComment,
+			args: map[string]interface{}{
+				"issueKey":   "TEST-123",
+				"commentId":  "100",
+				"body":       "Edited",
+				"visibility": map[string]interface{}{"type": "role", "value": "Developers"},
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if body := m.body("PUT /rest/api/2/issue/TEST-123/comment/100"); body["body"] != "Edited" {
+					t.Errorf("expected edited body to be sent, got %v", body)
+				}
+				var comment domain.Comment
+				decodeResponse(t, resp, &comment)
+				if comment.ID != "100" || comment.Visibility == nil {
+					t.Errorf("unexpected comment: %+v", comment)
+				}
+			},
+		},
+		{
+			name: "delete",
+			tool: ToolJiraDeleteComment,
+			args: map[string]interface{}{"issueKey": "TEST-123", "commentId": "100"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if !contains(resp.Content[0].Text, "Comment 100 deleted") {
+					t.Errorf("unexpected response: %s", resp.Content[0].Text)
+				}
+			},
+		},
+		{
+			name:     "delete unknown comment",
+			tool:     ToolJiraDeleteComment,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "commentId": "999"},
+			wantCode: domain.APIError,
+			wantMsg:  "404",
+		},
+	})
+}
@@ -0,0 +1,153 @@
+package application
+
+import (
+	"context"
+
+	"atlassian-mcp-server/internal/domain"
+	"atlassian-mcp-server/internal/infrastructure"
+)
+
+// jiraCreateMetaTools returns the definitions of the Jira create metadata tools.
+func jiraCreateMetaTools() []domain.ToolDefinition {
+	return []domain.ToolDefinition{
+		{
+			Name:        ToolJiraGetCreateMetadata,
+			Description: "List the issue types that can be created in a project, or the fields of one issue type with whether they are required and their allowed values",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"projectKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The project key (e.g., TEST)",
+					},
+					"issueType": map[string]interface{}{
+						"type":        "string",
+						"description": "Issue type name or ID; when omitted the available issue types are returned (optional)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"projectKey"},
+			},
+		},
+	}
+}
+
+// validateCreateFields checks a create request against the project's create metadata and
+// reports required fields that were not provided, with the values they accept.
+// Validation is skipped if the metadata cannot be loaded (e.g. Jira versions without the
+// createmeta/{project}/issuetypes endpoints), leaving the final say to CreateIssue.
+func (h *JiraHandler) validateCreateFields(client *infrastructure.JiraClient, createReq *domain.JiraIssueCreate) error {
+	projectKey := createReq.Fields.Project.Key
+
+	issueTypes, err := client.GetCreateMetaIssueTypes(projectKey)
+	if err != nil {
+		return nil
+	}
+	issueType, err := domain.ResolveCreateMetaIssueType(issueTypes, projectKey, createReq.Fields.IssueType.Name)
+	if err != nil {
+		return &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: err.Error(),
+		}
+	}
+
+	fields, err := client.GetCreateMetaFields(projectKey, string(issueType.ID))
+	if err != nil {
+		return nil
+	}
+
+	// Collect the fields the request sets; Jira fills in the reporter itself
+	provided := map[string]bool{
+		"project":   true,
+		"issuetype": true,
+		"summary":   createReq.Fields.Summary != "",
+		"reporter":  true,
+	}
+	if createReq.Fields.Description != "" {
+		provided["description"] = true
+	}
+	if createReq.Fields.Assignee != nil {
+		provided["assignee"] = true
+	}
+	if createReq.Fields.Parent != nil {
+		provided["parent"] = true
+	}
+	if createReq.Fields.Priority != nil {
+		provided["priority"] = true
+	}
+	if len(createReq.Fields.Labels) > 0 {
+		provided["labels"] = true
+	}
+	if createReq.Fields.DueDate != "" {
+		provided["duedate"] = true
+	}
+	if createReq.Fields.Environment != "" {
+		provided["environment"] = true
+	}
+	for id := range createReq.Fields.Extra {
+		provided[id] = true
+	}
+
+	missing := domain.MissingRequiredFields(fields, provided)
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return &domain.Error{
+		Code:    domain.InvalidParams,
+		Message: domain.FormatMissingFields(issueType.Name, projectKey, missing),
+		Data: map[string]interface{}{
+			"missingFields": missing,
+		},
+	}
+}
+
+// handleGetCreateMetadata handles the jira_get_create_metadata tool call.
+func (h *JiraHandler) handleGetCreateMetadata(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	projectKey, err := getStringParam(args, "projectKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	issueTypeName, err := getStringParam(args, "issueType", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	issueTypes, err := client.GetCreateMetaIssueTypes(projectKey)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+	if issueTypeName == "" {
+		return h.mapper.MapToToolResponse(issueTypes)
+	}
+
+	issueType, err := domain.ResolveCreateMetaIssueType(issueTypes, projectKey, issueTypeName)
+	if err != nil {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: err.Error(),
+		}
+	}
+
+	fields, err := client.GetCreateMetaFields(projectKey, string(issueType.ID))
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(map[string]interface{}{
+		"project":   projectKey,
+		"issueType": issueType,
+		"fields":    fields,
+	})
+}
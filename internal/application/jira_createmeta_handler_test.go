@@ -0,0 +1,161 @@
+package application
+
+import (
+	"net/http"
+	"testing"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraCreateMetaRoutes serves create metadata for a TEST project requiring components and a
+// severity for stories, and a priority, labels and a due date for bugs.
+var jiraCreateMetaRoutes = mockJiraRoutes{
+	"/rest/api/2/issue/createmeta/TEST/issuetypes": jiraReply(http.StatusOK, `{"startAt":0,"maxResults":100,"total":2,"isLast":true,"values":[
+		{"id":"1","name":"Bug","subtask":false},
+		{"id":"10001","name":"Story","subtask":false}
+	]}`),
+	"/rest/api/2/issue/createmeta/TEST/issuetypes/1": jiraReply(http.StatusOK, `{"startAt":0,"maxResults":100,"total":4,"isLast":true,"values":[
+		{"fieldId":"summary","name":"Summary","required":true,"hasDefaultValue":false,"schema":{"type":"string","system":"summary"}},
+		{"fieldId":"priority","name":"Priority","required":true,"hasDefaultValue":false,"schema":{"type":"priority","system":"priority"}},
+		{"fieldId":"labels","name":"Labels","required":true,"hasDefaultValue":false,"schema":{"type":"array","items":"string","system":"labels"}},
+		{"fieldId":"duedate","name":"Due","required":true,"hasDefaultValue":false,"schema":{"type":"date","system":"duedate"}}
+	]}`),
+	"/rest/api/2/issue/createmeta/TEST/issuetypes/10001": jiraReply(http.StatusOK, `{"startAt":0,"maxResults":100,"total":5,"isLast":true,"values":[
+		{"fieldId":"summary","name":"Summary","required":true,"hasDefaultValue":false,"schema":{"type":"string","system":"summary"}},
+		{"fieldId":"reporter","name":"Reporter","required":true,"hasDefaultValue":false,"schema":{"type":"user","system":"reporter"}},
+		{"fieldId":"priority","name":"Priority","required":true,"hasDefaultValue":true,"schema":{"type":"priority","system":"priority"}},
+		{"fieldId":"components","name":"Component/s","required":true,"hasDefaultValue":false,"schema":{"type":"array","items":"component","system":"components"},"allowedValues":[{"id":"10000","name":"API"},{"id":"10001","name":"UI"}]},
+		{"fieldId":"customfield_10005","name":"Severity","required":true,"hasDefaultValue":false,"schema":{"type":"option","customId":10005},"allowedValues":[{"id":"1","value":"High"},{"id":"2","value":"Low"}]}
+	]}`),
+	"/rest/api/2/field": jiraReply(http.StatusOK, `[
+		{"id":"components","name":"Component/s","custom":false,"schema":{"type":"array","items":"component","system":"components"}},
+		{"id":"customfield_10005","name":"Severity","custom":true,"schema":{"type":"option","customId":10005}}
+	]`),
+	"POST /rest/api/2/issue": jiraReply(http.StatusCreated, `{"id":"10002","key":"TEST-124","fields":{"summary":"New story"}}`),
+}
+
+// issueCreated checks that the issue was created.
+func issueCreated(t *testing.T, m *mockJira, _ *domain.ToolResponse, _ error) {
+	t.Helper()
+	if m.calls("POST /rest/api/2/issue") != 1 {
+		t.Error("expected CreateIssue to be called")
+	}
+}
+
+// issueNotCreated checks that no issue was created.
+func issueNotCreated(t *testing.T, m *mockJira, _ *domain.ToolResponse, _ error) {
+	t.Helper()
+	if m.calls("POST /rest/api/2/issue") != 0 {
+		t.Error("expected CreateIssue not to be called")
+	}
+}
+
+func TestJiraHandler_CreateMetadata(t *testing.T) {
+	runJiraToolCases(t, jiraCreateMetaRoutes, []jiraToolCase{
+		{
+			name: "list issue types",
+			tool: ToolJiraGetCreateMetadata,
+			args: map[string]interface{}{"projectKey": "TEST"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var issueTypes []domain.CreateMetaIssueType
+				decodeResponse(t, resp, &issueTypes)
+				if len(issueTypes) != 2 {
+					t.Errorf("expected 2 issue types, got %+v", issueTypes)
+				}
+			},
+		},
+		{
+			name: "issue type fields",
+			tool: ToolJiraGetCreateMetadata,
+			args: map[string]interface{}{"projectKey": "TEST", "issueType": "story"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var meta struct {
+					IssueType domain.CreateMetaIssueType `json:"issueType"`
+					Fields    []domain.CreateMetaField   `json:"fields"`
+				}
+				decodeResponse(t, resp, &meta)
+				if meta.IssueType.ID != "10001" || len(meta.Fields) != 5 {
+					t.Errorf("unexpected metadata: %+v", meta)
+				}
+			},
+		},
+		{
+			name:     "unknown issue type metadata",
+			tool:     ToolJiraGetCreateMetadata,
+			args:     map[string]interface{}{"projectKey": "TEST", "issueType": "Epic"},
+			wantCode: domain.InvalidParams,
+		},
+		{
+			name: "missing required fields",
+			tool: ToolJiraCreateIssue,
+			args: map[string]interface{}{
+				"projectKey": "TEST",
+				"summary":    "New story",
+				"issueType":  "Story",
+				"fields":     map[string]interface{}{"Severity": "High"},
+			},
+			wantCode: domain.InvalidParams,
+			wantMsg:  "Component/s (components): one of API, UI",
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if contains(err.Error(), "Severity") || contains(err.Error(), "Reporter") || contains(err.Error(), "Priority") {
+					t.Errorf("expected only components to be reported, got %q", err)
+				}
+				issueNotCreated(t, m, resp, err)
+			},
+		},
+		{
+			name: "required fields provided",
+			tool: ToolJiraCreateIssue,
+			args: map[string]interface{}{
+				"projectKey": "TEST",
+				"summary":    "New story",
+				"issueType":  "Story",
+				"fields":     map[string]interface{}{"Severity": "High", "components": "API"},
+			},
+			check: issueCreated,
+		},
+		{
+			name: "required issue fields provided",
+			tool: ToolJiraCreateIssue,
+			args: map[string]interface{}{
+				"projectKey": "TEST",
+				"summary":    "New bug",
+				"issueType":  "Bug",
+				"priority":   "High",
+				"labels":     []interface{}{"a"},
+				"dueDate":    "2024-01-01",
+			},
+			check: issueCreated,
+		},
+		{
+			name: "missing required issue fields",
+			tool: ToolJiraCreateIssue,
+			args: map[string]interface{}{
+				"projectKey": "TEST",
+				"summary":    "New bug",
+				"issueType":  "Bug",
+			},
+			wantCode: domain.InvalidParams,
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				for _, field := range []string{"Priority (priority)", "Labels (labels)", "Due (duedate)"} {
+					if !contains(err.Error(), field) {
+						t.Errorf("expected %s to be reported, got %q", field, err)
+					}
+				}
+				issueNotCreated(t, m, resp, err)
+			},
+		},
+		{
+			name: "unknown issue type",
+			tool: ToolJiraCreateIssue,
+			args: map[string]interface{}{
+				"projectKey": "TEST",
+				"summary":    "New epic",
+				"issueType":  "Epic",
+			},
+			wantCode: domain.InvalidParams,
+			wantMsg:  "available: Bug, Story",
+			check:    issueNotCreated,
+		},
+	})
+}
@@ -0,0 +1,113 @@
+package application
+
+import (
+	"context"
+	"strings"
+
+	"atlassian-mcp-server/internal/domain"
+	"atlassian-mcp-server/internal/infrastructure"
+)
+
+// jiraFieldTools returns the definitions of the Jira field metadata tools.
+func jiraFieldTools() []domain.ToolDefinition {
+	return []domain.ToolDefinition{
+		{
+			Name:        ToolJiraListFields,
+			Description: "List Jira system and custom fields with their IDs and value types",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"query": map[string]interface{}{
+						"type":        "string",
+						"description": "Only return fields whose name or ID contains this text (optional)",
+					},
+					"customOnly": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Only return custom fields (optional)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{},
+			},
+		},
+	}
+}
+
+// resolveFieldValues converts the optional "fields" argument, keyed by field name or ID,
+// into values keyed by field ID and coerced to the shape each field's schema expects.
+// Returns nil if no fields were provided.
+func (h *JiraHandler) resolveFieldValues(client *infrastructure.JiraClient, args map[string]interface{}) (map[string]interface{}, error) {
+	values, err := getObjectParam(args, "fields", false)
+	if err != nil || len(values) == 0 {
+		return nil, err
+	}
+
+	// Load field metadata
+	fields, err := client.CachedFields()
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	resolved := make(map[string]interface{}, len(values))
+	for nameOrID, value := range values {
+		field, err := domain.ResolveJiraField(fields, nameOrID)
+		if err != nil {
+			return nil, &domain.Error{
+				Code:    domain.InvalidParams,
+				Message: err.Error(),
+			}
+		}
+
+		coerced, err := domain.CoerceFieldValue(field, value)
+		if err != nil {
+			return nil, &domain.Error{
+				Code:    domain.InvalidParams,
+				Message: err.Error(),
+			}
+		}
+		resolved[field.ID] = coerced
+	}
+
+	return resolved, nil
+}
+
+// handleListFields handles the jira_list_fields tool call.
+func (h *JiraHandler) handleListFields(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	query, err := getStringParam(args, "query", false)
+	if err != nil {
+		return nil, err
+	}
+	customOnly, err := getBoolParam(args, "customOnly", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	fields, err := client.GetFields()
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Filter the fields
+	query = strings.ToLower(query)
+	filtered := make([]domain.JiraField, 0, len(fields))
+	for _, field := range fields {
+		if customOnly && !field.Custom {
+			continue
+		}
+		if query != "" && !strings.Contains(strings.ToLower(field.Name), query) && !strings.Contains(strings.ToLower(field.ID), query) {
+			continue
+		}
+		filtered = append(filtered, field)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(filtered)
+}
@@ -0,0 +1,119 @@
+package application
+
+import (
+	"net/http"
+	"testing"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraFieldRoutes serves a TEST-123 issue using the Story Points and Severity custom fields.
+var jiraFieldRoutes = mockJiraRoutes{
+	"GET /rest/api/2/field": jiraReply(http.StatusOK, `[
+		{"id":"summary","name":"Summary","custom":false,"schema":{"type":"string","system":"summary"}},
+		{"id":"components","name":"Component/s","custom":false,"schema":{"type":"array","items":"component","system":"components"}},
+		{"id":"customfield_10002","name":"Story Points","custom":true,"schema":{"type":"number","customId":10002}},
+		{"id":"customfield_10005","name":"Severity","custom":true,"schema":{"type":"option","customId":10005}}
+	]`),
+	"GET /rest/api/2/issue/TEST-123": jiraReply(http.StatusOK, `{"id":"10001","key":"TEST-123","fields":{"summary":"Test issue","customfield_10002":5,"customfield_10005":{"value":"High"}}}`),
+	"POST /rest/api/2/issue":         jiraReply(http.StatusCreated, `{"id":"10002","key":"TEST-124","fields":{"summary":"New test issue"}}`),
+	"PUT /rest/api/2/issue/TEST-123": jiraReply(http.StatusNoContent, ""),
+}
+
+func TestJiraHandler_CustomFields(t *testing.T) {
+	runJiraToolCases(t, jiraFieldRoutes, []jiraToolCase{
+		{
+			name: "list custom fields",
+			tool: ToolJiraListFields,
+			args: map[string]interface{}{"query": "story", "customOnly": true},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var fields []domain.JiraField
+				decodeResponse(t, resp, &fields)
+				if len(fields) != 1 || fields[0].ID != "customfield_10002" {
+					t.Errorf("expected only the Story Points field, got %+v", fields)
+				}
+			},
+		},
+		{
+			name: "get issue with field names",
+			tool: ToolJiraGetIssue,
+			args: map[string]interface{}{"issueKey": "TEST-123"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var issue map[string]interface{}
+				decodeResponse(t, resp, &issue)
+				fields := issue["fields"].(map[string]interface{})
+				if fields["customfield_10002"] != float64(5) {
+					t.Errorf("expected story points in fields, got %v", fields)
+				}
+				names := issue["names"].(map[string]interface{})
+				if names["customfield_10002"] != "Story Points" || names["customfield_10005"] != "Severity" {
+					t.Errorf("unexpected names: %v", names)
+				}
+			},
+		},
+		{
+			name: "create with custom fields",
+			tool: ToolJiraCreateIssue,
+			args: map[string]interface{}{
+				"projectKey": "TEST",
+				"summary":    "New test issue",
+				"issueType":  "Story",
+				"fields": map[string]interface{}{
+					"Story Points": "3",
+					"components":   "API, UI",
+					"severity":     "High",
+				},
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				fields := sentFields(t, m, "POST /rest/api/2/issue")
+				if fields["customfield_10002"] != float64(3) {
+					t.Errorf("expected story points as a number, got %v", fields["customfield_10002"])
+				}
+				components, _ := fields["components"].([]interface{})
+				if len(components) != 2 || components[0].(map[string]interface{})["name"] != "API" {
+					t.Errorf("expected component references, got %v", fields["components"])
+				}
+				if severity, _ := fields["customfield_10005"].(map[string]interface{}); severity["value"] != "High" {
+					t.Errorf("expected option value, got %v", fields["customfield_10005"])
+				}
+				if fields["summary"] != "New test issue" {
+					t.Errorf("expected summary to be kept, got %v", fields["summary"])
+				}
+			},
+		},
+		{
+			name: "update with custom fields",
+			tool: ToolJiraUpdateIssue,
+			args: map[string]interface{}{
+				"issueKey": "TEST-123",
+				"fields":   map[string]interface{}{"customfield_10002": 8.0},
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if fields := sentFields(t, m, "PUT /rest/api/2/issue/TEST-123"); fields["customfield_10002"] != float64(8) {
+					t.Errorf("expected story points 8, got %v", fields)
+				}
+			},
+		},
+		{
+			name:     "unknown field",
+			tool:     ToolJiraUpdateIssue,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "fields": map[string]interface{}{"Velocity": 1.0}},
+			wantCode: domain.InvalidParams,
+			wantMsg:  "unknown field 'Velocity'",
+		},
+		{
+			name:     "invalid value",
+			tool:     ToolJiraUpdateIssue,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "fields": map[string]interface{}{"Story Points": "lots"}},
+			wantCode: domain.InvalidParams,
+			wantMsg:  "expects a number",
+		},
+		{
+			name:     "fields not an object",
+			tool:     ToolJiraUpdateIssue,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "fields": "Story Points=3"},
+			wantCode: domain.InvalidParams,
+			wantMsg:  "fields",
+		},
+	})
+}
@@ -0,0 +1,585 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"atlassian-mcp-server/internal/domain"
+	"atlassian-mcp-server/internal/infrastructure"
+)
+
+// jiraFilterTools returns the definitions of the Jira saved filter and dashboard tools.
+func jiraFilterTools() []domain.ToolDefinition {
+	return []domain.ToolDefinition{
+		{
+			Name:        ToolJiraListFavouriteFilters,
+			Description: "List the saved filters you have marked as favourite, with their JQL",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"auth": getAuthSchema(),
+				},
+				Required: []string{},
+			},
+		},
+		{
+			Name:        ToolJiraGetFilter,
+			Description: "Get a saved filter with its JQL, owner and share permissions",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"filterId": map[string]interface{}{
+						"type":        "string",
+						"description": "The filter ID",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"filterId"},
+			},
+		},
+		{
+			Name:        ToolJiraRunFilter,
+			Description: "Run a saved filter and return the matching issues. Accepts the paging, field selection and compact options of jira_search_jql",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"filterId": map[string]interface{}{
+						"type":        "string",
+						"description": "The filter ID",
+					},
+					"startAt": map[string]interface{}{
+						"type":        "integer",
+						"description": "The index of the first issue to return (0-based, optional)",
+					},
+					"maxResults": map[string]interface{}{
+						"type":        "integer",
+						"description": "The maximum number of issues to return (optional); with all: true, the page size",
+					},
+					"fields": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Fields to return (optional)",
+					},
+					"expand": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Entities to expand (optional)",
+					},
+					"orderBy": map[string]interface{}{
+						"type":        "string",
+						"description": "ORDER BY clause replacing the filter's (optional)",
+					},
+					"all": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Fetch every matching issue, page by page (optional, default: false)",
+					},
+					"compact": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Return only key, summary, status and assignee of each issue (optional, default: false)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"filterId"},
+			},
+		},
+		{
+			Name:        ToolJiraCreateFilter,
+			Description: "Save a JQL query as a filter, optionally sharing it",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"name": map[string]interface{}{
+						"type":        "string",
+						"description": "The filter name",
+					},
+					"jql": map[string]interface{}{
+						"type":        "string",
+						"description": "The JQL query",
+					},
+					"description": map[string]interface{}{
+						"type":        "string",
+						"description": "The filter description (optional)",
+					},
+					"favourite": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Mark the filter as favourite (optional, default: true)",
+					},
+					"sharePermissions": getSharePermissionsSchema(),
+					"auth":             getAuthSchema(),
+				},
+				Required: []string{"name", "jql"},
+			},
+		},
+		{
+			Name:        ToolJiraUpdateFilter,
+			Description: "Change the name, JQL, description, favourite flag or sharing of a saved filter",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"filterId": map[string]interface{}{
+						"type":        "string",
+						"description": "The filter ID",
+					},
+					"name": map[string]interface{}{
+						"type":        "string",
+						"description": "The new name (optional)",
+					},
+					"jql": map[string]interface{}{
+						"type":        "string",
+						"description": "The new JQL query (optional)",
+					},
+					"description": map[string]interface{}{
+						"type":        "string",
+						"description": "The new description (optional)",
+					},
+					"favourite": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Mark or unmark the filter as favourite (optional)",
+					},
+					"sharePermissions": getSharePermissionsSchema(),
+					"auth":             getAuthSchema(),
+				},
+				Required: []string{"filterId"},
+			},
+		},
+		{
+			Name:        ToolJiraListDashboards,
+			Description: "List Jira dashboards",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"filter": map[string]interface{}{
+						"type":        "string",
+						"description": "Only favourite dashboards or those you own (optional, default: all visible)",
+						"enum":        []string{"favourite", "my"},
+					},
+					"startAt": map[string]interface{}{
+						"type":        "integer",
+						"description": "The index of the first dashboard to return (0-based, optional)",
+					},
+					"maxResults": map[string]interface{}{
+						"type":        "integer",
+						"description": "The maximum number of dashboards to return (optional, default: 20)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{},
+			},
+		},
+		{
+			Name:        ToolJiraGetDashboardGadgets,
+			Description: "List the gadgets on a dashboard with the saved filters each one displays",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"dashboardId": map[string]interface{}{
+						"type":        "string",
+						"description": "The dashboard ID",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"dashboardId"},
+			},
+		},
+	}
+}
+
+// handleListFavouriteFilters handles the jira_list_favourite_filters tool call.
+func (h *JiraHandler) handleListFavouriteFilters(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	filters, err := client.GetFavouriteFilters()
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(filters)
+}
+
+// getSharePermissionsSchema returns the schema for filter share permissions.
+func getSharePermissionsSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "array",
+		"description": "Who the filter is shared with (optional). On update, replaces the existing share permissions; pass [] to stop sharing",
+		"items": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"type": map[string]interface{}{
+					"type":        "string",
+					"enum":        []string{domain.ShareGlobal, domain.ShareAuthenticated, domain.ShareGroup, domain.ShareProject, domain.ShareProjectRole},
+					"description": "Share with everyone, logged-in users, a group, a project or a project role",
+				},
+				"group": map[string]interface{}{
+					"type":        "string",
+					"description": "Group name (type group)",
+				},
+				"project": map[string]interface{}{
+					"type":        "string",
+					"description": "Project key or ID (types project and projectRole)",
+				},
+				"roleId": map[string]interface{}{
+					"type":        "string",
+					"description": "Project role ID (type projectRole)",
+				},
+			},
+			"required": []string{"type"},
+		},
+	}
+}
+
+// getSharePermissions reads the optional sharePermissions argument, resolving project
+// keys to IDs. The second result is false if the argument was not given.
+func (h *JiraHandler) getSharePermissions(client *infrastructure.JiraClient, args map[string]interface{}) ([]domain.SharePermissionRequest, bool, error) {
+	value, exists := args["sharePermissions"]
+	if !exists || value == nil {
+		return nil, false, nil
+	}
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, false, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "parameter 'sharePermissions' must be an array",
+		}
+	}
+
+	permissions := make([]domain.SharePermissionRequest, 0, len(items))
+	for i, item := range items {
+		share, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false, &domain.Error{
+				Code:    domain.InvalidParams,
+				Message: fmt.Sprintf("sharePermissions[%d] must be an object", i),
+			}
+		}
+		shareType, err := getStringParam(share, "type", true)
+		if err != nil {
+			return nil, false, err
+		}
+		group, _ := getStringParam(share, "group", false)
+		project, _ := getStringParam(share, "project", false)
+		roleID, _ := getStringParam(share, "roleId", false)
+
+		permission := domain.SharePermissionRequest{Type: shareType}
+		var missing string
+		switch shareType {
+		case domain.ShareGlobal, domain.ShareAuthenticated:
+		case domain.ShareGroup:
+			permission.GroupName = group
+			if group == "" {
+				missing = "group"
+			}
+		case domain.ShareProject, domain.ShareProjectRole:
+			if project == "" {
+				missing = "project"
+				break
+			}
+			if shareType == domain.ShareProjectRole {
+				permission.ProjectRoleID = roleID
+				if roleID == "" {
+					missing = "roleId"
+					break
+				}
+			}
+			permission.ProjectID = project
+			if strings.Trim(project, "0123456789") != "" {
+				resolved, err := client.GetProject(project)
+				if err != nil {
+					return nil, false, h.mapper.MapError(err)
+				}
+				permission.ProjectID = string(resolved.ID)
+			}
+		default:
+			return nil, false, &domain.Error{
+				Code:    domain.InvalidParams,
+				Message: fmt.Sprintf("sharePermissions[%d] has invalid type '%s' (use global, authenticated, group, project or projectRole)", i, shareType),
+			}
+		}
+		if missing != "" {
+			return nil, false, &domain.Error{
+				Code:    domain.InvalidParams,
+				Message: fmt.Sprintf("sharePermissions[%d] of type %s requires %s", i, shareType, missing),
+			}
+		}
+		permissions = append(permissions, permission)
+	}
+	return permissions, true, nil
+}
+
+// replaceFilterPermissions removes the existing share permissions of a filter and
+// adds the given ones.
+func (h *JiraHandler) replaceFilterPermissions(client *infrastructure.JiraClient, filter *domain.Filter, permissions []domain.SharePermissionRequest) error {
+	for _, existing := range filter.SharePermissions {
+		if err := client.DeleteFilterPermission(string(filter.ID), string(existing.ID)); err != nil {
+			return err
+		}
+	}
+	for i := range permissions {
+		if _, err := client.AddFilterPermission(string(filter.ID), &permissions[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handleGetFilter handles the jira_get_filter tool call.
+func (h *JiraHandler) handleGetFilter(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	filterID, err := getStringParam(args, "filterId", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	filter, err := client.GetFilter(filterID)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(filter)
+}
+
+// handleRunFilter handles the jira_run_filter tool call by searching with the
+// filter's JQL and the remaining search arguments.
+func (h *JiraHandler) handleRunFilter(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	filterID, err := getStringParam(args, "filterId", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	filter, err := client.GetFilter(filterID)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Search with the filter's JQL
+	searchArgs := make(map[string]interface{}, len(args))
+	for name, value := range args {
+		searchArgs[name] = value
+	}
+	searchArgs["jql"] = filter.JQL
+	return h.handleSearchJQL(ctx, searchArgs)
+}
+
+// handleCreateFilter handles the jira_create_filter tool call.
+func (h *JiraHandler) handleCreateFilter(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	name, err := getStringParam(args, "name", true)
+	if err != nil {
+		return nil, err
+	}
+	jql, err := getStringParam(args, "jql", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	description, _ := getStringParam(args, "description", false)
+	favourite := true
+	if _, ok := args["favourite"]; ok {
+		if favourite, err = getBoolParam(args, "favourite", false); err != nil {
+			return nil, err
+		}
+	}
+	permissions, _, err := h.getSharePermissions(client, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	filter, err := client.CreateFilter(&domain.FilterRequest{
+		Name:        name,
+		Description: description,
+		JQL:         jql,
+		Favourite:   &favourite,
+	})
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Share the filter
+	if len(permissions) > 0 {
+		if err := h.replaceFilterPermissions(client, filter, permissions); err != nil {
+			return nil, h.mapper.MapError(err)
+		}
+		if filter, err = client.GetFilter(string(filter.ID)); err != nil {
+			return nil, h.mapper.MapError(err)
+		}
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(filter)
+}
+
+// handleUpdateFilter handles the jira_update_filter tool call.
+func (h *JiraHandler) handleUpdateFilter(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	filterID, err := getStringParam(args, "filterId", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	update := &domain.FilterRequest{}
+	update.Name, _ = getStringParam(args, "name", false)
+	update.JQL, _ = getStringParam(args, "jql", false)
+	update.Description, _ = getStringParam(args, "description", false)
+	if _, ok := args["favourite"]; ok {
+		favourite, err := getBoolParam(args, "favourite", false)
+		if err != nil {
+			return nil, err
+		}
+		update.Favourite = &favourite
+	}
+	permissions, sharing, err := h.getSharePermissions(client, args)
+	if err != nil {
+		return nil, err
+	}
+	if *update == (domain.FilterRequest{}) && !sharing {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "nothing to update: provide name, jql, description, favourite or sharePermissions",
+		}
+	}
+
+	// Call the Jira client
+	filter, err := client.GetFilter(filterID)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+	if *update != (domain.FilterRequest{}) {
+		// Jira requires the name and JQL on every update
+		if update.Name == "" {
+			update.Name = filter.Name
+		}
+		if update.JQL == "" {
+			update.JQL = filter.JQL
+		}
+		if _, err := client.UpdateFilter(filterID, update); err != nil {
+			return nil, h.mapper.MapError(err)
+		}
+	}
+	if sharing {
+		if err := h.replaceFilterPermissions(client, filter, permissions); err != nil {
+			return nil, h.mapper.MapError(err)
+		}
+	}
+
+	// Return the updated filter
+	filter, err = client.GetFilter(filterID)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+	return h.mapper.MapToToolResponse(filter)
+}
+
+// handleListDashboards handles the jira_list_dashboards tool call.
+func (h *JiraHandler) handleListDashboards(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	filter, _ := getStringParam(args, "filter", false)
+	if filter != "" && filter != "favourite" && filter != "my" {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("invalid filter '%s' (use favourite or my)", filter),
+		}
+	}
+	startAt, err := getIntParam(args, "startAt", false)
+	if err != nil {
+		return nil, err
+	}
+	maxResults, err := getIntParam(args, "maxResults", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	dashboards, err := client.GetDashboards(filter, startAt, maxResults)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(dashboards)
+}
+
+// handleGetDashboardGadgets handles the jira_get_dashboard_gadgets tool call.
+// Filter references are read from each gadget's configuration properties; gadgets
+// whose properties cannot be read are listed without them.
+func (h *JiraHandler) handleGetDashboardGadgets(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	dashboardID, err := getStringParam(args, "dashboardId", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	gadgets, err := client.GetDashboardGadgets(dashboardID)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Find the filters referenced by each gadget
+	for i := range gadgets {
+		properties, err := client.GetDashboardItemProperties(dashboardID, string(gadgets[i].ID))
+		if err != nil {
+			continue
+		}
+		found := make(map[string]bool)
+		for _, value := range properties {
+			for _, id := range domain.FilterIDsFromProperty(value) {
+				if !found[id] {
+					found[id] = true
+					gadgets[i].FilterIDs = append(gadgets[i].FilterIDs, id)
+				}
+			}
+		}
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(gadgets)
+}
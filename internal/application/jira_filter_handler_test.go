@@ -0,0 +1,142 @@
+package application
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraFilter serves filter 10000 or 10001, both shared globally by permission 5.
+func jiraFilter(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/rest/api/2/filter/")
+	w.Write([]byte(`{"id":"` + id + `","name":"My open bugs","jql":"project = TEST ORDER BY key","sharePermissions":[{"id":5,"type":"global"}]}`))
+}
+
+// jiraFilterRoutes serves the favourite filter 10000, creates filter 10001, and serves
+// dashboard 10200 with a filter gadget and a clock gadget.
+var jiraFilterRoutes = mockJiraRoutes{
+	"/rest/api/2/filter/favourite":                          jiraReply(http.StatusOK, `[{"id":"10000","name":"My open bugs","jql":"type = Bug AND resolution IS EMPTY","favourite":true}]`),
+	"POST /rest/api/2/filter":                               jiraReply(http.StatusOK, `{"id":"10001","name":"New filter","jql":"project = TEST"}`),
+	"POST /rest/api/2/filter/10001/permission":              jiraReply(http.StatusCreated, `[{"id":1,"type":"group","group":{"name":"developers"}}]`),
+	"DELETE /rest/api/2/filter/10000/permission/5":          jiraReply(http.StatusNoContent, ""),
+	"POST /rest/api/2/filter/10000/permission":              jiraReply(http.StatusCreated, `[{"id":6,"type":"project","project":{"id":"10100","key":"TEST"}}]`),
+	"/rest/api/2/filter/10000":                              jiraFilter,
+	"/rest/api/2/filter/10001":                              jiraFilter,
+	"/rest/api/2/project/TEST":                              jiraReply(http.StatusOK, `{"id":"10100","key":"TEST","name":"Test Project"}`),
+	"/rest/api/2/search":                                    jiraReply(http.StatusOK, `{"startAt":0,"maxResults":50,"total":1,"issues":[{"key":"TEST-1","fields":{"summary":"First"}}]}`),
+	"/rest/api/2/dashboard":                                 jiraReply(http.StatusOK, `{"startAt":0,"maxResults":20,"total":1,"dashboards":[{"id":"10200","name":"Team board"}]}`),
+	"/rest/api/2/dashboard/10200/gadget":                    jiraReply(http.StatusOK, `{"gadgets":[{"id":1,"title":"Open bugs"},{"id":2,"title":"Clock"}]}`),
+	"/rest/api/2/dashboard/10200/items/1/properties":        jiraReply(http.StatusOK, `{"keys":[{"key":"config"}]}`),
+	"/rest/api/2/dashboard/10200/items/1/properties/config": jiraReply(http.StatusOK, `{"key":"config","value":{"filterId":"filter-10000","num":"10"}}`),
+}
+
+func TestJiraHandler_Filters(t *testing.T) {
+	runJiraToolCases(t, jiraFilterRoutes, []jiraToolCase{
+		{
+			name: "favourites",
+			tool: ToolJiraListFavouriteFilters,
+			args: map[string]interface{}{},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var filters []domain.Filter
+				decodeResponse(t, resp, &filters)
+				if len(filters) != 1 || filters[0].ID != "10000" || !filters[0].Favourite {
+					t.Errorf("unexpected filters: %+v", filters)
+				}
+			},
+		},
+		{
+			name: "run searches with the filter's JQL",
+			tool: ToolJiraRunFilter,
+			args: map[string]interface{}{"filterId": "10000", "maxResults": float64(10)},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if log := m.log(); len(log) != 2 || !strings.HasPrefix(log[1], "GET /rest/api/2/search?") || !contains(log[1], "jql=project+%3D+TEST") {
+					t.Errorf("unexpected requests: %v", log)
+				}
+			},
+		},
+		{
+			name: "create shared",
+			tool: ToolJiraCreateFilter,
+			args: map[string]interface{}{
+				"name":             "New filter",
+				"jql":              "project = TEST",
+				"sharePermissions": []interface{}{map[string]interface{}{"type": "group", "group": "developers"}},
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if bodies := m.bodies(); len(bodies) != 2 || bodies[0]["favourite"] != true || bodies[1]["groupname"] != "developers" {
+					t.Errorf("unexpected bodies: %v", bodies)
+				}
+			},
+		},
+		{
+			name: "replace sharing",
+			tool: ToolJiraUpdateFilter,
+			args: map[string]interface{}{
+				"filterId":         "10000",
+				"description":      "Bugs still open",
+				"sharePermissions": []interface{}{map[string]interface{}{"type": "project", "project": "TEST"}},
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if bodies := m.bodies(); len(bodies) != 2 || bodies[0]["name"] != "My open bugs" || bodies[0]["description"] != "Bugs still open" || bodies[1]["projectId"] != "10100" {
+					t.Errorf("unexpected bodies: %v", bodies)
+				}
+				if m.calls("DELETE /rest/api/2/filter/10000/permission/5") != 1 {
+					t.Errorf("expected existing permission to be deleted, got %v", m.log())
+				}
+			},
+		},
+		{
+			name:     "empty update",
+			tool:     ToolJiraUpdateFilter,
+			args:     map[string]interface{}{"filterId": "10000"},
+			wantCode: domain.InvalidParams,
+		},
+		{
+			name:     "group permission without group",
+			tool:     ToolJiraUpdateFilter,
+			args:     map[string]interface{}{"filterId": "10000", "sharePermissions": []interface{}{map[string]interface{}{"type": "group"}}},
+			wantCode: domain.InvalidParams,
+		},
+		{
+			name:     "unknown permission type",
+			tool:     ToolJiraUpdateFilter,
+			args:     map[string]interface{}{"filterId": "10000", "sharePermissions": []interface{}{map[string]interface{}{"type": "everyone"}}},
+			wantCode: domain.InvalidParams,
+		},
+	})
+}
+
+func TestJiraHandler_Dashboards(t *testing.T) {
+	runJiraToolCases(t, jiraFilterRoutes, []jiraToolCase{
+		{
+			name: "favourites",
+			tool: ToolJiraListDashboards,
+			args: map[string]interface{}{"filter": "favourite"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if log := m.log(); len(log) != 1 || !contains(log[0], "filter=favourite") {
+					t.Errorf("unexpected requests: %v", log)
+				}
+			},
+		},
+		{
+			name: "gadget filters",
+			tool: ToolJiraGetDashboardGadgets,
+			args: map[string]interface{}{"dashboardId": "10200"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var gadgets []domain.DashboardGadget
+				decodeResponse(t, resp, &gadgets)
+				if len(gadgets) != 2 || len(gadgets[0].FilterIDs) != 1 || gadgets[0].FilterIDs[0] != "10000" || len(gadgets[1].FilterIDs) != 0 {
+					t.Errorf("unexpected gadgets: %+v", gadgets)
+				}
+			},
+		},
+		{
+			name:     "unknown filter",
+			tool:     ToolJiraListDashboards,
+			args:     map[string]interface{}{"filter": "shared"},
+			wantCode: domain.InvalidParams,
+		},
+	})
+}
@@ -3,6 +3,8 @@ package application
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"atlassian-mcp-server/internal/domain"
 	"atlassian-mcp-server/internal/infrastructure"
@@ -16,6 +18,8 @@ type JiraHandler struct {
 	mapper      domain.ResponseMapper
 	authManager *domain.AuthenticationManager
 	baseURL     string
+
+	maxSearchResults int // Ceiling for jira_search_jql with all: true
 }
 
 // NewJiraHandler creates a new JiraHandler instance.
@@ -25,19 +29,106 @@ func NewJiraHandler(client *infrastructure.JiraClient, mapper domain.ResponseMap
 		mapper:      mapper,
 		authManager: authManager,
 		baseURL:     baseURL,
+
+		maxSearchResults: defaultMaxSearchResults,
+	}
+}
+
+// SetMaxSearchResults sets the most issues jira_search_jql fetches with all: true.
+// Values of zero or less keep the default.
+func (h *JiraHandler) SetMaxSearchResults(limit int) {
+	if limit > 0 {
+		h.maxSearchResults = limit
 	}
 }
 
 // Tool name constants for Jira operations
 const (
-	ToolJiraGetIssue     = "jira_get_issue"
-	ToolJiraCreateIssue  = "jira_create_issue"
-	ToolJiraUpdateIssue  = "jira_update_issue"
-	ToolJiraDeleteIssue  = "jira_delete_issue"
-	ToolJiraSearchJQL    = "jira_search_jql"
-	ToolJiraTransition   = "jira_transition_issue"
-	ToolJiraAddComment   = "jira_add_comment"
-	ToolJiraListProjects = "jira_list_projects"
+	ToolJiraGetIssue              = "jira_get_issue"
+	ToolJiraCreateIssue           = "jira_create_issue"
+	ToolJiraUpdateIssue           = "jira_update_issue"
+	ToolJiraDeleteIssue           = "jira_delete_issue"
+	ToolJiraSearchJQL             = "jira_search_jql"
+	ToolJiraTransition            = "jira_transition_issue"
+	ToolJiraAddComment            = "jira_add_comment"
+	ToolJiraListProjects          = "jira_list_projects"
+	ToolJiraWhoAmI                = "jira_whoami"
+	ToolJiraListFields            = "jira_list_fields"
+	ToolJiraGetCreateMetadata     = "jira_get_create_metadata"
+	ToolJiraGetTransitions        = "jira_get_transitions"
+	ToolJiraGetComments           = "jira_get_comments"
+	ToolJiraUpdateComment         = "jira_update_comment"
+	ToolJiraDeleteComment         = "jira_delete_comment"
+	ToolJiraListLinkTypes         = "jira_list_link_types"
+	ToolJiraLinkIssues            = "jira_link_issues"
+	ToolJiraDeleteIssueLink       = "jira_delete_issue_link"
+	ToolJiraCreateSubtask         = "jira_create_subtask"
+	ToolJiraSetEpicLink           = "jira_set_epic_link"
+	ToolJiraGetIssueTree          = "jira_get_issue_tree"
+	ToolJiraListAttachments       = "jira_list_attachments"
+	ToolJiraGetAttachment         = "jira_get_attachment"
+	ToolJiraAddAttachment         = "jira_add_attachment"
+	ToolJiraGetWorklogs           = "jira_get_worklogs"
+	ToolJiraAddWorklog            = "jira_add_worklog"
+	ToolJiraUpdateWorklog         = "jira_update_worklog"
+	ToolJiraDeleteWorklog         = "jira_delete_worklog"
+	ToolJiraGetTimeTracking       = "jira_get_time_tracking"
+	ToolJiraSetEstimates          = "jira_set_estimates"
+	ToolJiraWorklogReport         = "jira_worklog_report"
+	ToolJiraListBoards            = "jira_list_boards"
+	ToolJiraGetSprints            = "jira_get_sprints"
+	ToolJiraGetSprintIssues       = "jira_get_sprint_issues"
+	ToolJiraMoveToSprint          = "jira_move_to_sprint"
+	ToolJiraMoveToBacklog         = "jira_move_to_backlog"
+	ToolJiraRankIssues            = "jira_rank_issues"
+	ToolJiraStartSprint           = "jira_start_sprint"
+	ToolJiraCloseSprint           = "jira_close_sprint"
+	ToolJiraGetProject            = "jira_get_project"
+	ToolJiraListVersions          = "jira_list_versions"
+	ToolJiraCreateVersion         = "jira_create_version"
+	ToolJiraUpdateVersion         = "jira_update_version"
+	ToolJiraReleaseVersion        = "jira_release_version"
+	ToolJiraArchiveVersion        = "jira_archive_version"
+	ToolJiraGetVersionIssueCounts = "jira_get_version_issue_counts"
+	ToolJiraListComponents        = "jira_list_components"
+	ToolJiraCreateComponent       = "jira_create_component"
+	ToolJiraSetFixVersions        = "jira_set_fix_versions"
+	ToolJiraGetChangelog          = "jira_get_changelog"
+	ToolJiraGetTimeInStatus       = "jira_get_time_in_status"
+	ToolJiraGetWatchers           = "jira_get_watchers"
+	ToolJiraAddWatcher            = "jira_add_watcher"
+	ToolJiraRemoveWatcher         = "jira_remove_watcher"
+	ToolJiraGetVotes              = "jira_get_votes"
+	ToolJiraVote                  = "jira_vote"
+	ToolJiraUnvote                = "jira_unvote"
+	ToolJiraNotify                = "jira_notify"
+	ToolJiraSearchUsers           = "jira_search_users"
+	ToolJiraGetAssignableUsers    = "jira_get_assignable_users"
+	ToolJiraGetGroupMembers       = "jira_get_group_members"
+	ToolJiraBulkUpdate            = "jira_bulk_update"
+	ToolJiraBulkTransition        = "jira_bulk_transition"
+	ToolJiraBulkComment           = "jira_bulk_comment"
+	ToolJiraValidateJQL           = "jira_validate_jql"
+	ToolJiraGetJQLAutocomplete    = "jira_get_jql_autocomplete"
+	ToolJiraBuildJQL              = "jira_build_jql"
+	ToolJiraListFavouriteFilters  = "jira_list_favourite_filters"
+	ToolJiraGetFilter             = "jira_get_filter"
+	ToolJiraRunFilter             = "jira_run_filter"
+	ToolJiraCreateFilter          = "jira_create_filter"
+	ToolJiraUpdateFilter          = "jira_update_filter"
+	ToolJiraListDashboards        = "jira_list_dashboards"
+	ToolJiraGetDashboardGadgets   = "jira_get_dashboard_gadgets"
+	ToolJiraListServiceDesks      = "jira_list_service_desks"
+	ToolJiraListRequestTypes      = "jira_list_request_types"
+	ToolJiraGetRequestTypeFields  = "jira_get_request_type_fields"
+	ToolJiraCreateCustomerRequest = "jira_create_customer_request"
+	ToolJiraAddRequestComment     = "jira_add_request_comment"
+	ToolJiraGetRequestComments    = "jira_get_request_comments"
+	ToolJiraListQueues            = "jira_list_queues"
+	ToolJiraGetQueueIssues        = "jira_get_queue_issues"
+	ToolJiraGetRequestSLA         = "jira_get_request_sla"
+	ToolJiraCloneIssue            = "jira_clone_issue"
+	ToolJiraMoveIssue             = "jira_move_issue"
 )
 
 // ToolName returns the identifier for this handler.
@@ -75,10 +166,10 @@ func getAuthSchema() map[string]interface{} {
 
 // ListTools returns available tools for Jira operations.
 func (h *JiraHandler) ListTools() []domain.ToolDefinition {
-	return []domain.ToolDefinition{
+	tools := []domain.ToolDefinition{
 		{
 			Name:        ToolJiraGetIssue,
-			Description: "Retrieve a Jira issue by its key (e.g., TEST-123)",
+			Description: "Retrieve a Jira issue by its key (e.g., TEST-123), including custom fields with their display names",
 			InputSchema: domain.JSONSchema{
 				Type: "object",
 				Properties: map[string]interface{}{
@@ -86,7 +177,8 @@ func (h *JiraHandler) ListTools() []domain.ToolDefinition {
 						"type":        "string",
 						"description": "The issue key (e.g., TEST-123)",
 					},
-					"auth": getAuthSchema(),
+					"format": getOutputFormatSchema(),
+					"auth":   getAuthSchema(),
 				},
 				Required: []string{"issueKey"},
 			},
@@ -109,13 +201,39 @@ func (h *JiraHandler) ListTools() []domain.ToolDefinition {
 						"type":        "string",
 						"description": "The issue description (optional)",
 					},
+					"format": getInputFormatSchema(),
 					"issueType": map[string]interface{}{
 						"type":        "string",
 						"description": "The issue type name (e.g., Bug, Story, Task)",
 					},
 					"assignee": map[string]interface{}{
 						"type":        "string",
-						"description": "The assignee username (optional)",
+						"description": "The assignee username, email address or display name (optional)",
+					},
+					"reporter": map[string]interface{}{
+						"type":        "string",
+						"description": "The reporter username, email address or display name (optional, default: you)",
+					},
+					"priority": map[string]interface{}{
+						"type":        "string",
+						"description": "The priority name (e.g., High) (optional)",
+					},
+					"labels": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Labels, without spaces (optional)",
+					},
+					"dueDate": map[string]interface{}{
+						"type":        "string",
+						"description": "The due date as YYYY-MM-DD (optional)",
+					},
+					"environment": map[string]interface{}{
+						"type":        "string",
+						"description": "The environment, in the same format as the description (optional)",
+					},
+					"fields": map[string]interface{}{
+						"type":        "object",
+						"description": "Additional fields keyed by field name or ID, e.g. {\"Story Points\": 5, \"components\": [\"API\"], \"customfield_10100\": \"value\"} (optional). Values are converted to the shape each field expects",
 					},
 				},
 				Required: []string{"projectKey", "summary", "issueType"},
@@ -139,9 +257,45 @@ func (h *JiraHandler) ListTools() []domain.ToolDefinition {
 						"type":        "string",
 						"description": "The new description (optional)",
 					},
+					"format": getInputFormatSchema(),
 					"assignee": map[string]interface{}{
 						"type":        "string",
-						"description": "The new assignee username (optional)",
+						"description": "The new assignee username, email address or display name (optional)",
+					},
+					"reporter": map[string]interface{}{
+						"type":        "string",
+						"description": "The new reporter username, email address or display name (optional)",
+					},
+					"priority": map[string]interface{}{
+						"type":        "string",
+						"description": "The new priority name (e.g., High) (optional)",
+					},
+					"labels": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Replace all labels; [] removes them (optional). Cannot be combined with addLabels or removeLabels",
+					},
+					"addLabels": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Labels to add, keeping the existing ones (optional)",
+					},
+					"removeLabels": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Labels to remove (optional)",
+					},
+					"dueDate": map[string]interface{}{
+						"type":        "string",
+						"description": "The new due date as YYYY-MM-DD; an empty string clears it (optional)",
+					},
+					"environment": map[string]interface{}{
+						"type":        "string",
+						"description": "The new environment, in the same format as the description; an empty string clears it (optional)",
+					},
+					"fields": map[string]interface{}{
+						"type":        "object",
+						"description": "Additional fields keyed by field name or ID, e.g. {\"Story Points\": 5, \"components\": [\"API\"], \"customfield_10100\": \"value\"} (optional). Values are converted to the shape each field expects",
 					},
 				},
 				Required: []string{"issueKey"},
@@ -177,7 +331,29 @@ func (h *JiraHandler) ListTools() []domain.ToolDefinition {
 					},
 					"maxResults": map[string]interface{}{
 						"type":        "integer",
-						"description": "The maximum number of issues to return (optional)",
+						"description": "The maximum number of issues to return (optional); with all: true, the page size",
+					},
+					"fields": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Fields to return, e.g. [\"summary\", \"status\"] or [\"*all\"] (optional, default: Jira's navigable fields)",
+					},
+					"expand": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Entities to expand, e.g. [\"names\", \"changelog\"] (optional)",
+					},
+					"orderBy": map[string]interface{}{
+						"type":        "string",
+						"description": "ORDER BY clause replacing the one in jql, e.g. \"updated DESC\" (optional)",
+					},
+					"all": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Fetch every matching issue, page by page, up to the server's ceiling (optional, default: false)",
+					},
+					"compact": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Return only key, summary, status and assignee of each issue (optional, default: false)",
 					},
 				},
 				Required: []string{"jql"},
@@ -185,7 +361,7 @@ func (h *JiraHandler) ListTools() []domain.ToolDefinition {
 		},
 		{
 			Name:        ToolJiraTransition,
-			Description: "Transition a Jira issue to a new status",
+			Description: "Transition a Jira issue to a new status, optionally setting fields on the transition screen",
 			InputSchema: domain.JSONSchema{
 				Type: "object",
 				Properties: map[string]interface{}{
@@ -195,12 +371,34 @@ func (h *JiraHandler) ListTools() []domain.ToolDefinition {
 					},
 					"transitionId": map[string]interface{}{
 						"type":        "string",
-						"description": "The transition ID (optional if transitionName is provided)",
+						"description": "The transition ID (optional if transitionName or toStatus is provided)",
 					},
 					"transitionName": map[string]interface{}{
 						"type":        "string",
-						"description": "The transition name (optional if transitionId is provided)",
+						"description": "The transition name (optional if transitionId or toStatus is provided)",
+					},
+					"toStatus": map[string]interface{}{
+						"type":        "string",
+						"description": "The target status name, e.g. Done (optional if transitionId or transitionName is provided)",
+					},
+					"resolution": map[string]interface{}{
+						"type":        "string",
+						"description": "Resolution name to set, e.g. Fixed (optional)",
+					},
+					"fixVersions": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Fix version names to set (optional)",
+					},
+					"comment": map[string]interface{}{
+						"type":        "string",
+						"description": "Comment to add with the transition (optional)",
 					},
+					"fields": map[string]interface{}{
+						"type":        "object",
+						"description": "Other screen fields keyed by field name or ID (optional)",
+					},
+					"auth": getAuthSchema(),
 				},
 				Required: []string{"issueKey"},
 			},
@@ -219,6 +417,9 @@ func (h *JiraHandler) ListTools() []domain.ToolDefinition {
 						"type":        "string",
 						"description": "The comment text",
 					},
+					"format":     getInputFormatSchema(),
+					"visibility": getCommentVisibilitySchema(),
+					"auth":       getAuthSchema(),
 				},
 				Required: []string{"issueKey", "body"},
 			},
@@ -232,7 +433,37 @@ func (h *JiraHandler) ListTools() []domain.ToolDefinition {
 				Required:   []string{},
 			},
 		},
+		{
+			Name:        ToolJiraWhoAmI,
+			Description: "Show the Jira user and permissions the server is authenticated as",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"auth": getAuthSchema(),
+				},
+				Required: []string{},
+			},
+		},
 	}
+	tools = append(tools, jiraFieldTools()...)
+	tools = append(tools, jiraCreateMetaTools()...)
+	tools = append(tools, jiraTransitionTools()...)
+	tools = append(tools, jiraCommentTools()...)
+	tools = append(tools, jiraLinkTools()...)
+	tools = append(tools, jiraAttachmentTools()...)
+	tools = append(tools, jiraWorklogTools()...)
+	tools = append(tools, jiraAgileTools()...)
+	tools = append(tools, jiraVersionTools()...)
+	tools = append(tools, jiraChangelogTools()...)
+	tools = append(tools, jiraWatcherTools()...)
+	tools = append(tools, jiraUserTools()...)
+	tools = append(tools, jiraBulkTools()...)
+	tools = append(tools, jiraJQLTools()...)
+	tools = append(tools, jiraFilterTools()...)
+	tools = append(tools, jiraServiceDeskTools()...)
+	tools = append(tools, jiraCloneTools()...)
+
+	return tools
 }
 
 // getClientForRequest returns the appropriate Jira client based on provided credentials.
@@ -251,7 +482,7 @@ func (h *JiraHandler) getClientForRequest(args map[string]interface{}) (*infrast
 
 	// If credentials provided, create a new client with those credentials
 	if creds != nil {
-		httpClient, err := h.authManager.GetAuthenticatedClientWithCredentials(creds)
+		httpClient, err := h.authManager.GetAuthenticatedClientForTool("jira", creds)
 		if err != nil {
 			return nil, &domain.Error{
 				Code:    domain.AuthenticationError,
@@ -298,6 +529,160 @@ func (h *JiraHandler) Handle(ctx context.Context, req *domain.ToolRequest) (*dom
 		return h.handleAddComment(ctx, req.Arguments)
 	case ToolJiraListProjects:
 		return h.handleListProjects(ctx, req.Arguments)
+	case ToolJiraWhoAmI:
+		return h.handleWhoAmI(ctx, req.Arguments)
+	case ToolJiraListFields:
+		return h.handleListFields(ctx, req.Arguments)
+	case ToolJiraGetCreateMetadata:
+		return h.handleGetCreateMetadata(ctx, req.Arguments)
+	case ToolJiraGetTransitions:
+		return h.handleGetTransitions(ctx, req.Arguments)
+	case ToolJiraGetComments:
+		return h.handleGetComments(ctx, req.Arguments)
+	case ToolJiraUpdateComment:
+		return h.handleUpdateComment(ctx, req.Arguments)
+	case ToolJiraDeleteComment:
+		return h.handleDeleteComment(ctx, req.Arguments)
+	case ToolJiraListLinkTypes:
+		return h.handleListLinkTypes(ctx, req.Arguments)
+	case ToolJiraLinkIssues:
+		return h.handleLinkIssues(ctx, req.Arguments)
+	case ToolJiraDeleteIssueLink:
+		return h.handleDeleteIssueLink(ctx, req.Arguments)
+	case ToolJiraCreateSubtask:
+		return h.handleCreateSubtask(ctx, req.Arguments)
+	case ToolJiraSetEpicLink:
+		return h.handleSetEpicLink(ctx, req.Arguments)
+	case ToolJiraGetIssueTree:
+		return h.handleGetIssueTree(ctx, req.Arguments)
+	case ToolJiraListAttachments:
+		return h.handleListAttachments(ctx, req.Arguments)
+	case ToolJiraGetAttachment:
+		return h.handleGetAttachment(ctx, req.Arguments)
+	case ToolJiraAddAttachment:
+		return h.handleAddAttachment(ctx, req.Arguments)
+	case ToolJiraGetWorklogs:
+		return h.handleGetWorklogs(ctx, req.Arguments)
+	case ToolJiraAddWorklog:
+		return h.handleAddWorklog(ctx, req.Arguments)
+	case ToolJiraUpdateWorklog:
+		return h.handleUpdateWorklog(ctx, req.Arguments)
+	case ToolJiraDeleteWorklog:
+		return h.handleDeleteWorklog(ctx, req.Arguments)
+	case ToolJiraGetTimeTracking:
+		return h.handleGetTimeTracking(ctx, req.Arguments)
+	case ToolJiraSetEstimates:
+		return h.handleSetEstimates(ctx, req.Arguments)
+	case ToolJiraWorklogReport:
+		return h.handleWorklogReport(ctx, req.Arguments)
+	case ToolJiraListBoards:
+		return h.handleListBoards(ctx, req.Arguments)
+	case ToolJiraGetSprints:
+		return h.handleGetSprints(ctx, req.Arguments)
+	case ToolJiraGetSprintIssues:
+		return h.handleGetSprintIssues(ctx, req.Arguments)
+	case ToolJiraMoveToSprint:
+		return h.handleMoveToSprint(ctx, req.Arguments)
+	case ToolJiraMoveToBacklog:
+		return h.handleMoveToBacklog(ctx, req.Arguments)
+	case ToolJiraRankIssues:
+		return h.handleRankIssues(ctx, req.Arguments)
+	case ToolJiraStartSprint:
+		return h.handleStartSprint(ctx, req.Arguments)
+	case ToolJiraCloseSprint:
+		return h.handleCloseSprint(ctx, req.Arguments)
+	case ToolJiraGetProject:
+		return h.handleGetProject(ctx, req.Arguments)
+	case ToolJiraListVersions:
+		return h.handleListVersions(ctx, req.Arguments)
+	case ToolJiraCreateVersion:
+		return h.handleCreateVersion(ctx, req.Arguments)
+	case ToolJiraUpdateVersion:
+		return h.handleUpdateVersion(ctx, req.Arguments)
+	case ToolJiraReleaseVersion:
+		return h.handleReleaseVersion(ctx, req.Arguments)
+	case ToolJiraArchiveVersion:
+		return h.handleArchiveVersion(ctx, req.Arguments)
+	case ToolJiraGetVersionIssueCounts:
+		return h.handleGetVersionIssueCounts(ctx, req.Arguments)
+	case ToolJiraListComponents:
+		return h.handleListComponents(ctx, req.Arguments)
+	case ToolJiraCreateComponent:
+		return h.handleCreateComponent(ctx, req.Arguments)
+	case ToolJiraSetFixVersions:
+		return h.handleSetFixVersions(ctx, req.Arguments)
+	case ToolJiraGetChangelog:
+		return h.handleGetChangelog(ctx, req.Arguments)
+	case ToolJiraGetTimeInStatus:
+		return h.handleGetTimeInStatus(ctx, req.Arguments)
+	case ToolJiraGetWatchers:
+		return h.handleGetWatchers(ctx, req.Arguments)
+	case ToolJiraAddWatcher:
+		return h.handleAddWatcher(ctx, req.Arguments)
+	case ToolJiraRemoveWatcher:
+		return h.handleRemoveWatcher(ctx, req.Arguments)
+	case ToolJiraGetVotes:
+		return h.handleGetVotes(ctx, req.Arguments)
+	case ToolJiraVote:
+		return h.handleVote(ctx, req.Arguments)
+	case ToolJiraUnvote:
+		return h.handleUnvote(ctx, req.Arguments)
+	case ToolJiraNotify:
+		return h.handleNotify(ctx, req.Arguments)
+	case ToolJiraSearchUsers:
+		return h.handleSearchUsers(ctx, req.Arguments)
+	case ToolJiraGetAssignableUsers:
+		return h.handleGetAssignableUsers(ctx, req.Arguments)
+	case ToolJiraGetGroupMembers:
+		return h.handleGetGroupMembers(ctx, req.Arguments)
+	case ToolJiraBulkUpdate:
+		return h.handleBulkUpdate(ctx, req.Arguments)
+	case ToolJiraBulkTransition:
+		return h.handleBulkTransition(ctx, req.Arguments)
+	case ToolJiraBulkComment:
+		return h.handleBulkComment(ctx, req.Arguments)
+	case ToolJiraValidateJQL:
+		return h.handleValidateJQL(ctx, req.Arguments)
+	case ToolJiraGetJQLAutocomplete:
+		return h.handleGetJQLAutocomplete(ctx, req.Arguments)
+	case ToolJiraBuildJQL:
+		return h.handleBuildJQL(ctx, req.Arguments)
+	case ToolJiraListFavouriteFilters:
+		return h.handleListFavouriteFilters(ctx, req.Arguments)
+	case ToolJiraGetFilter:
+		return h.handleGetFilter(ctx, req.Arguments)
+	case ToolJiraRunFilter:
+		return h.handleRunFilter(ctx, req.Arguments)
+	case ToolJiraCreateFilter:
+		return h.handleCreateFilter(ctx, req.Arguments)
+	case ToolJiraUpdateFilter:
+		return h.handleUpdateFilter(ctx, req.Arguments)
+	case ToolJiraListDashboards:
+		return h.handleListDashboards(ctx, req.Arguments)
+	case ToolJiraGetDashboardGadgets:
+		return h.handleGetDashboardGadgets(ctx, req.Arguments)
+	case ToolJiraListServiceDesks:
+		return h.handleListServiceDesks(ctx, req.Arguments)
+	case ToolJiraListRequestTypes:
+		return h.handleListRequestTypes(ctx, req.Arguments)
+	case ToolJiraGetRequestTypeFields:
+		return h.handleGetRequestTypeFields(ctx, req.Arguments)
+	case ToolJiraCreateCustomerRequest:
+		return h.handleCreateCustomerRequest(ctx, req.Arguments)
+	case ToolJiraAddRequestComment:
+		return h.handleAddRequestComment(ctx, req.Arguments)
+	case ToolJiraGetRequestComments:
+		return h.handleGetRequestComments(ctx, req.Arguments)
+	case ToolJiraListQueues:
+		return h.handleListQueues(ctx, req.Arguments)
+	case ToolJiraGetQueueIssues:
+		return h.handleGetQueueIssues(ctx, req.Arguments)
+	case ToolJiraGetRequestSLA:
+		return h.handleGetRequestSLA(ctx, req.Arguments)
+	case ToolJiraCloneIssue:
+		return h.handleCloneIssue(ctx, req.Arguments)
+	case ToolJiraMoveIssue:
+		return h.handleMoveIssue(ctx, req.Arguments)
 	default:
 		return nil, &domain.Error{
 			Code:    domain.MethodNotFound,
@@ -320,12 +705,43 @@ func (h *JiraHandler) handleGetIssue(ctx context.Context, args map[string]interf
 		return nil, err
 	}
 
+	// Optional parameters
+	format, err := getOutputFormat(args)
+	if err != nil {
+		return nil, err
+	}
+	var expand []string
+	if format == domain.TextFormatRendered {
+		expand = append(expand, "renderedFields")
+	}
+
 	// Call the Jira client
-	issue, err := client.GetIssue(issueKey)
+	issue, err := client.GetIssue(issueKey, expand...)
 	if err != nil {
 		return nil, h.mapper.MapError(err)
 	}
 
+	// Convert text fields, keeping only the rendered fields that have content
+	convertIssueText(issue, format)
+	for id, value := range issue.RenderedFields {
+		if string(value) == "null" || string(value) == `""` {
+			delete(issue.RenderedFields, id)
+		}
+	}
+
+	// Label custom fields with their display names (best effort)
+	if len(issue.Fields.Extra) > 0 {
+		if fields, err := client.CachedFields(); err == nil {
+			names := domain.FieldNames(fields)
+			issue.Names = make(map[string]string)
+			for id := range issue.Fields.Extra {
+				if name, ok := names[id]; ok {
+					issue.Names[id] = name
+				}
+			}
+		}
+	}
+
 	// Transform the response
 	return h.mapper.MapToToolResponse(issue)
 }
@@ -355,6 +771,22 @@ func (h *JiraHandler) handleCreateIssue(ctx context.Context, args map[string]int
 	// Optional parameters
 	description, _ := getStringParam(args, "description", false)
 	assignee, _ := getStringParam(args, "assignee", false)
+	format, err := getInputFormat(args)
+	if err != nil {
+		return nil, err
+	}
+	description = toWikiMarkup(description, format)
+	reporter, _ := getStringParam(args, "reporter", false)
+	priority, _ := getStringParam(args, "priority", false)
+	environment, _ := getStringParam(args, "environment", false)
+	labels, err := getLabelsParam(args, "labels")
+	if err != nil {
+		return nil, err
+	}
+	dueDate, _, err := getDueDateParam(args)
+	if err != nil {
+		return nil, err
+	}
 
 	// Build the create request
 	createReq := &domain.JiraIssueCreate{
@@ -367,16 +799,51 @@ func (h *JiraHandler) handleCreateIssue(ctx context.Context, args map[string]int
 			Project: domain.ProjectRef{
 				Key: projectKey,
 			},
+			Labels:      labels,
+			DueDate:     dueDate,
+			Environment: toWikiMarkup(environment, format),
 		},
 	}
+	if priority != "" {
+		createReq.Fields.Priority = &domain.PriorityRef{
+			Name: priority,
+		}
+	}
 
-	// Add assignee if provided
+	// Add reporter if provided, resolving emails and display names
+	if reporter != "" {
+		name, err := h.resolveReporter(client, reporter)
+		if err != nil {
+			return nil, err
+		}
+		createReq.Fields.Reporter = &domain.UserRef{
+			Name: name,
+		}
+	}
+
+	// Add assignee if provided, resolving emails and display names
 	if assignee != "" {
+		name, err := h.resolveAssignee(client, assignee, projectKey, "")
+		if err != nil {
+			return nil, err
+		}
 		createReq.Fields.Assignee = &domain.UserRef{
-			Name: assignee,
+			Name: name,
 		}
 	}
 
+	// Add arbitrary fields by name or ID
+	extraFields, err := h.resolveFieldValues(client, args)
+	if err != nil {
+		return nil, err
+	}
+	createReq.Fields.Extra = extraFields
+
+	// Report missing required fields before attempting the create
+	if err := h.validateCreateFields(client, createReq); err != nil {
+		return nil, err
+	}
+
 	// Call the Jira client
 	issue, err := client.CreateIssue(createReq)
 	if err != nil {
@@ -405,22 +872,72 @@ func (h *JiraHandler) handleUpdateIssue(ctx context.Context, args map[string]int
 	summary, _ := getStringParam(args, "summary", false)
 	description, _ := getStringParam(args, "description", false)
 	assignee, _ := getStringParam(args, "assignee", false)
+	format, err := getInputFormat(args)
+	if err != nil {
+		return nil, err
+	}
+	description = toWikiMarkup(description, format)
+	reporter, _ := getStringParam(args, "reporter", false)
+	priority, _ := getStringParam(args, "priority", false)
+	environment, _ := getStringParam(args, "environment", false)
+	dueDate, clearDueDate, err := getDueDateParam(args)
+	if err != nil {
+		return nil, err
+	}
 
 	// Build the update request
 	updateReq := &domain.JiraIssueUpdate{
 		Fields: domain.JiraFieldsUpdate{
 			Summary:     summary,
 			Description: description,
+			DueDate:     dueDate,
+			Environment: toWikiMarkup(environment, format),
 		},
 	}
+	if priority != "" {
+		updateReq.Fields.Priority = &domain.PriorityRef{
+			Name: priority,
+		}
+	}
+	if clearDueDate {
+		updateReq.Update.Set("duedate", nil)
+	}
+	if value, ok := args["environment"]; ok && value == "" {
+		updateReq.Update.Set("environment", nil)
+	}
+	if err := setLabelOperations(args, &updateReq.Update); err != nil {
+		return nil, err
+	}
+
+	// Add reporter if provided, resolving emails and display names
+	if reporter != "" {
+		name, err := h.resolveReporter(client, reporter)
+		if err != nil {
+			return nil, err
+		}
+		updateReq.Fields.Reporter = &domain.UserRef{
+			Name: name,
+		}
+	}
 
-	// Add assignee if provided
+	// Add assignee if provided, resolving emails and display names
 	if assignee != "" {
+		name, err := h.resolveAssignee(client, assignee, "", issueKey)
+		if err != nil {
+			return nil, err
+		}
 		updateReq.Fields.Assignee = &domain.UserRef{
-			Name: assignee,
+			Name: name,
 		}
 	}
 
+	// Add arbitrary fields by name or ID
+	extraFields, err := h.resolveFieldValues(client, args)
+	if err != nil {
+		return nil, err
+	}
+	updateReq.Fields.Extra = extraFields
+
 	// Call the Jira client
 	err = client.UpdateIssue(issueKey, updateReq)
 	if err != nil {
@@ -434,6 +951,80 @@ func (h *JiraHandler) handleUpdateIssue(ctx context.Context, args map[string]int
 	})
 }
 
+// getLabelsParam extracts a list of labels, which Jira does not allow to contain spaces.
+func getLabelsParam(args map[string]interface{}, name string) ([]string, error) {
+	labels, err := getStringArrayParam(args, name, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, label := range labels {
+		if strings.ContainsAny(label, " \t\n") {
+			return nil, &domain.Error{
+				Code:    domain.InvalidParams,
+				Message: fmt.Sprintf("label '%s' cannot contain spaces", label),
+			}
+		}
+	}
+	return labels, nil
+}
+
+// setLabelOperations adds the label changes of jira_update_issue to ops: labels replaces
+// all labels, addLabels and removeLabels change individual ones.
+func setLabelOperations(args map[string]interface{}, ops *domain.JiraUpdateOps) error {
+	labels, err := getLabelsParam(args, "labels")
+	if err != nil {
+		return err
+	}
+	addLabels, err := getLabelsParam(args, "addLabels")
+	if err != nil {
+		return err
+	}
+	removeLabels, err := getLabelsParam(args, "removeLabels")
+	if err != nil {
+		return err
+	}
+
+	if _, ok := args["labels"]; ok {
+		if len(addLabels) > 0 || len(removeLabels) > 0 {
+			return &domain.Error{
+				Code:    domain.InvalidParams,
+				Message: "use either labels to replace all labels, or addLabels and removeLabels, not both",
+			}
+		}
+		if labels == nil {
+			labels = []string{}
+		}
+		ops.Set("labels", labels)
+	}
+	for _, label := range addLabels {
+		ops.Add("labels", label)
+	}
+	for _, label := range removeLabels {
+		ops.Remove("labels", label)
+	}
+	return nil
+}
+
+// getDueDateParam extracts the optional dueDate argument as YYYY-MM-DD. The second
+// result is true if an empty string was given to clear the due date.
+func getDueDateParam(args map[string]interface{}) (string, bool, error) {
+	dueDate, err := getStringParam(args, "dueDate", false)
+	if err != nil {
+		return "", false, err
+	}
+	if dueDate == "" {
+		_, clear := args["dueDate"]
+		return "", clear, nil
+	}
+	if _, err := time.Parse("2006-01-02", dueDate); err != nil {
+		return "", false, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("invalid dueDate '%s' (use YYYY-MM-DD)", dueDate),
+		}
+	}
+	return dueDate, false, nil
+}
+
 // handleDeleteIssue handles the jira_delete_issue tool call.
 func (h *JiraHandler) handleDeleteIssue(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
 	// Get the appropriate client (with custom credentials if provided)
@@ -484,25 +1075,100 @@ func (h *JiraHandler) handleSearchJQL(ctx context.Context, args map[string]inter
 	if err != nil {
 		return nil, err
 	}
+	fields, err := getStringArrayParam(args, "fields", false)
+	if err != nil {
+		return nil, err
+	}
+	expand, err := getStringArrayParam(args, "expand", false)
+	if err != nil {
+		return nil, err
+	}
+	orderBy, _ := getStringParam(args, "orderBy", false)
+	all, err := getBoolParam(args, "all", false)
+	if err != nil {
+		return nil, err
+	}
+	compact, err := getBoolParam(args, "compact", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// The compact projection only needs its own fields
+	jql = domain.SetJQLOrderBy(jql, orderBy)
+	if compact && len(fields) == 0 {
+		fields = domain.CompactIssueFields
+	}
 
 	// Build search options
 	options := &infrastructure.SearchOptions{
 		JQL:        jql,
 		StartAt:    startAt,
 		MaxResults: maxResults,
+		Fields:     fields,
+		Expand:     expand,
 	}
 
 	// Call the Jira client
-	results, err := client.SearchJQL(jql, options)
+	var results *domain.SearchResults
+	if all {
+		results, err = h.searchAllPages(client, options)
+	} else {
+		results, err = client.SearchJQL(jql, options)
+	}
 	if err != nil {
 		return nil, h.mapper.MapError(err)
 	}
 
 	// Transform the response
+	if compact {
+		return h.mapper.MapToToolResponse(domain.CompactSearch(results))
+	}
 	return h.mapper.MapToToolResponse(results)
 }
 
+// Limits for jira_search_jql with all: true.
+const (
+	defaultMaxSearchResults = 1000
+	searchPageSize          = 100
+)
+
+// searchAllPages fetches the matching issues page by page from options.StartAt until
+// all are fetched or h.maxSearchResults is reached, which marks the results truncated.
+// options.MaxResults, if set, is the page size.
+func (h *JiraHandler) searchAllPages(client *infrastructure.JiraClient, options *infrastructure.SearchOptions) (*domain.SearchResults, error) {
+	pageSize := options.MaxResults
+	if pageSize <= 0 {
+		pageSize = searchPageSize
+	}
+
+	all := &domain.SearchResults{Issues: []domain.JiraIssue{}, StartAt: options.StartAt}
+	for len(all.Issues) < h.maxSearchResults {
+		page := *options
+		page.StartAt = options.StartAt + len(all.Issues)
+		page.MaxResults = pageSize
+		if remaining := h.maxSearchResults - len(all.Issues); remaining < pageSize {
+			page.MaxResults = remaining
+		}
+
+		results, err := client.SearchJQL(options.JQL, &page)
+		if err != nil {
+			return nil, err
+		}
+		all.Issues = append(all.Issues, results.Issues...)
+		all.Total = results.Total
+		if len(results.Issues) == 0 || options.StartAt+len(all.Issues) >= results.Total {
+			break
+		}
+	}
+
+	all.MaxResults = len(all.Issues)
+	all.Truncated = options.StartAt+len(all.Issues) < all.Total
+	return all, nil
+}
+
 // handleTransition handles the jira_transition_issue tool call.
+// The transition is resolved by ID, transition name or target status against the issue's
+// available transitions, and required screen fields are checked before it is performed.
 func (h *JiraHandler) handleTransition(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
 	// Get the appropriate client (with custom credentials if provided)
 	client, err := h.getClientForRequest(args)
@@ -516,14 +1182,15 @@ func (h *JiraHandler) handleTransition(ctx context.Context, args map[string]inte
 		return nil, err
 	}
 
-	// Get transition ID or name (at least one is required)
+	// Get transition ID, name or target status (at least one is required)
 	transitionID, _ := getStringParam(args, "transitionId", false)
 	transitionName, _ := getStringParam(args, "transitionName", false)
+	toStatus, _ := getStringParam(args, "toStatus", false)
 
-	if transitionID == "" && transitionName == "" {
+	if transitionID == "" && transitionName == "" && toStatus == "" {
 		return nil, &domain.Error{
 			Code:    domain.InvalidParams,
-			Message: "either transitionId or transitionName must be provided",
+			Message: "either transitionId, transitionName or toStatus must be provided",
 		}
 	}
 
@@ -534,6 +1201,27 @@ func (h *JiraHandler) handleTransition(ctx context.Context, args map[string]inte
 			Name: transitionName,
 		},
 	}
+	if err := h.buildTransitionFields(client, args, transition); err != nil {
+		return nil, err
+	}
+
+	// Resolve the transition against those available on the issue
+	available, err := client.GetTransitions(issueKey)
+	if err != nil && toStatus != "" {
+		return nil, h.mapper.MapError(err)
+	}
+	if err == nil {
+		target := transitionID
+		if target == "" {
+			target = transitionName
+		}
+		if target == "" {
+			target = toStatus
+		}
+		if err := resolveTransition(available, issueKey, target, transition); err != nil {
+			return nil, err
+		}
+	}
 
 	// Call the Jira client
 	err = client.TransitionIssue(issueKey, transition)
@@ -566,9 +1254,20 @@ func (h *JiraHandler) handleAddComment(ctx context.Context, args map[string]inte
 		return nil, err
 	}
 
+	// Optional parameters
+	visibility, err := getCommentVisibility(args)
+	if err != nil {
+		return nil, err
+	}
+	format, err := getInputFormat(args)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build the comment
 	comment := &domain.Comment{
-		Body: body,
+		Body:       toWikiMarkup(body, format),
+		Visibility: visibility,
 	}
 
 	// Call the Jira client
@@ -601,3 +1300,21 @@ func (h *JiraHandler) handleListProjects(ctx context.Context, args map[string]in
 	// Transform the response
 	return h.mapper.MapToToolResponse(projects)
 }
+
+// handleWhoAmI handles the jira_whoami tool call.
+func (h *JiraHandler) handleWhoAmI(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	identity, err := client.WhoAmI()
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(identity)
+}
@@ -238,6 +238,83 @@ func TestJiraHandler_AllToolsCovered(t *testing.T) {
 		ToolJiraTransition,
 		ToolJiraAddComment,
 		ToolJiraListProjects,
+		ToolJiraWhoAmI,
+		ToolJiraListFields,
+		ToolJiraGetCreateMetadata,
+		ToolJiraGetTransitions,
+		ToolJiraGetComments,
+		ToolJiraUpdateComment,
+		ToolJiraDeleteComment,
+		ToolJiraListLinkTypes,
+		ToolJiraLinkIssues,
+		ToolJiraDeleteIssueLink,
+		ToolJiraCreateSubtask,
+		ToolJiraSetEpicLink,
+		ToolJiraGetIssueTree,
+		ToolJiraListAttachments,
+		ToolJiraGetAttachment,
+		ToolJiraAddAttachment,
+		ToolJiraGetWorklogs,
+		ToolJiraAddWorklog,
+		ToolJiraUpdateWorklog,
+		ToolJiraDeleteWorklog,
+		ToolJiraGetTimeTracking,
+		ToolJiraSetEstimates,
+		ToolJiraWorklogReport,
+		ToolJiraListBoards,
+		ToolJiraGetSprints,
+		ToolJiraGetSprintIssues,
+		ToolJiraMoveToSprint,
+		ToolJiraMoveToBacklog,
+		ToolJiraRankIssues,
+		ToolJiraStartSprint,
+		ToolJiraCloseSprint,
+		ToolJiraGetProject,
+		ToolJiraListVersions,
+		ToolJiraCreateVersion,
+		ToolJiraUpdateVersion,
+		ToolJiraReleaseVersion,
+		ToolJiraArchiveVersion,
+		ToolJiraGetVersionIssueCounts,
+		ToolJiraListComponents,
+		ToolJiraCreateComponent,
+		ToolJiraSetFixVersions,
+		ToolJiraGetChangelog,
+		ToolJiraGetTimeInStatus,
+		ToolJiraGetWatchers,
+		ToolJiraAddWatcher,
+		ToolJiraRemoveWatcher,
+		ToolJiraGetVotes,
+		ToolJiraVote,
+		ToolJiraUnvote,
+		ToolJiraNotify,
+		ToolJiraSearchUsers,
+		ToolJiraGetAssignableUsers,
+		ToolJiraGetGroupMembers,
+		ToolJiraBulkUpdate,
+		ToolJiraBulkTransition,
+		ToolJiraBulkComment,
+		ToolJiraValidateJQL,
+		ToolJiraGetJQLAutocomplete,
+		ToolJiraBuildJQL,
+		ToolJiraListFavouriteFilters,
+		ToolJiraGetFilter,
+		ToolJiraRunFilter,
+		ToolJiraCreateFilter,
+		ToolJiraUpdateFilter,
+		ToolJiraListDashboards,
+		ToolJiraGetDashboardGadgets,
+		ToolJiraListServiceDesks,
+		ToolJiraListRequestTypes,
+		ToolJiraGetRequestTypeFields,
+		ToolJiraCreateCustomerRequest,
+		ToolJiraAddRequestComment,
+		ToolJiraGetRequestComments,
+		ToolJiraListQueues,
+		ToolJiraGetQueueIssues,
+		ToolJiraGetRequestSLA,
+		ToolJiraCloneIssue,
+		ToolJiraMoveIssue,
 	}
 
 	toolMap := make(map[string]bool)
@@ -1,10 +1,18 @@
 package application
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
 	"atlassian-mcp-server/internal/domain"
@@ -155,6 +163,83 @@ func TestJiraHandler_ListTools(t *testing.T) {
 		ToolJiraTransition,
 		ToolJiraAddComment,
 		ToolJiraListProjects,
+		ToolJiraWhoAmI,
+		ToolJiraListFields,
+		ToolJiraGetCreateMetadata,
+		ToolJiraGetTransitions,
+		ToolJiraGetComments,
+		ToolJiraUpdateComment,
+		ToolJiraDeleteComment,
+		ToolJiraListLinkTypes,
+		ToolJiraLinkIssues,
+		ToolJiraDeleteIssueLink,
+		ToolJiraCreateSubtask,
+		ToolJiraSetEpicLink,
+		ToolJiraGetIssueTree,
+		ToolJiraListAttachments,
+		ToolJiraGetAttachment,
+		ToolJiraAddAttachment,
+		ToolJiraGetWorklogs,
+		ToolJiraAddWorklog,
+		ToolJiraUpdateWorklog,
+		ToolJiraDeleteWorklog,
+		ToolJiraGetTimeTracking,
+		ToolJiraSetEstimates,
+		ToolJiraWorklogReport,
+		ToolJiraListBoards,
+		ToolJiraGetSprints,
+		ToolJiraGetSprintIssues,
+		ToolJiraMoveToSprint,
+		ToolJiraMoveToBacklog,
+		ToolJiraRankIssues,
+		ToolJiraStartSprint,
+		ToolJiraCloseSprint,
+		ToolJiraGetProject,
+		ToolJiraListVersions,
+		ToolJiraCreateVersion,
+		ToolJiraUpdateVersion,
+		ToolJiraReleaseVersion,
+		ToolJiraArchiveVersion,
+		ToolJiraGetVersionIssueCounts,
+		ToolJiraListComponents,
+		ToolJiraCreateComponent,
+		ToolJiraSetFixVersions,
+		ToolJiraGetChangelog,
+		ToolJiraGetTimeInStatus,
+		ToolJiraGetWatchers,
+		ToolJiraAddWatcher,
+		ToolJiraRemoveWatcher,
+		ToolJiraGetVotes,
+		ToolJiraVote,
+		ToolJiraUnvote,
+		ToolJiraNotify,
+		ToolJiraSearchUsers,
+		ToolJiraGetAssignableUsers,
+		ToolJiraGetGroupMembers,
+		ToolJiraBulkUpdate,
+		ToolJiraBulkTransition,
+		ToolJiraBulkComment,
+		ToolJiraValidateJQL,
+		ToolJiraGetJQLAutocomplete,
+		ToolJiraBuildJQL,
+		ToolJiraListFavouriteFilters,
+		ToolJiraGetFilter,
+		ToolJiraRunFilter,
+		ToolJiraCreateFilter,
+		ToolJiraUpdateFilter,
+		ToolJiraListDashboards,
+		ToolJiraGetDashboardGadgets,
+		ToolJiraListServiceDesks,
+		ToolJiraListRequestTypes,
+		ToolJiraGetRequestTypeFields,
+		ToolJiraCreateCustomerRequest,
+		ToolJiraAddRequestComment,
+		ToolJiraGetRequestComments,
+		ToolJiraListQueues,
+		ToolJiraGetQueueIssues,
+		ToolJiraGetRequestSLA,
+		ToolJiraCloneIssue,
+		ToolJiraMoveIssue,
 	}
 
 	if len(tools) != len(expectedTools) {
@@ -1327,3 +1412,455 @@ func contains(s, substr string) bool {
 	}
 	return false
 }
+
+// mockJiraRoutes maps "METHOD /path" or "/path" (any method) to the handler serving it.
+// Paths may contain path.Match patterns; exact routes win over patterns, and longer
+// patterns over shorter ones.
+type mockJiraRoutes map[string]http.HandlerFunc
+
+// handler returns the handler routed for r, or nil.
+func (routes mockJiraRoutes) handler(r *http.Request) http.HandlerFunc {
+	for _, key := range []string{r.Method + " " + r.URL.Path, r.URL.Path} {
+		if handler, ok := routes[key]; ok {
+			return handler
+		}
+	}
+	var best string
+	var handler http.HandlerFunc
+	for key, h := range routes {
+		pattern := key
+		if method, p, ok := strings.Cut(key, " "); ok {
+			if method != r.Method {
+				continue
+			}
+			pattern = p
+		}
+		if matched, _ := path.Match(pattern, r.URL.Path); matched && len(key) > len(best) {
+			best, handler = key, h
+		}
+	}
+	return handler
+}
+
+// jiraReply returns a handler answering with status and body.
+func jiraReply(status int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}
+}
+
+// mockJiraRequest is a request received by a mock Jira server.
+type mockJiraRequest struct {
+	Method string
+	URL    *url.URL
+	Header http.Header
+	Raw    []byte
+	Body   map[string]interface{} // Raw decoded as a JSON object, nil otherwise
+}
+
+// mockJira is a mock Jira server serving routes and recording the requests it receives.
+type mockJira struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []mockJiraRequest
+}
+
+// newMockJira starts a mock Jira server for routes that is closed when the test ends.
+// Unrouted requests are answered with 404.
+func newMockJira(t *testing.T, routes mockJiraRoutes) *mockJira {
+	t.Helper()
+	m := &mockJira{}
+	m.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		r.Body = io.NopCloser(bytes.NewReader(raw))
+		request := mockJiraRequest{Method: r.Method, URL: r.URL, Header: r.Header, Raw: raw}
+		json.Unmarshal(raw, &request.Body)
+		m.mu.Lock()
+		m.requests = append(m.requests, request)
+		m.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if handler := routes.handler(r); handler != nil {
+			handler(w, r)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(m.Close)
+	return m
+}
+
+// handler returns a Jira handler using the mock server.
+func (m *mockJira) handler() *JiraHandler {
+	client := infrastructure.NewJiraClient(m.URL, m.Client())
+	return NewJiraHandler(client, &mockResponseMapper{}, nil, "")
+}
+
+// received returns the requests received so far.
+func (m *mockJira) received() []mockJiraRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]mockJiraRequest(nil), m.requests...)
+}
+
+// reset forgets the requests received so far.
+func (m *mockJira) reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = nil
+}
+
+// log returns the received requests as "METHOD /path?query".
+func (m *mockJira) log() []string {
+	var log []string
+	for _, request := range m.received() {
+		log = append(log, request.Method+" "+request.URL.RequestURI())
+	}
+	return log
+}
+
+// bodies returns the JSON object bodies of the received requests in order.
+func (m *mockJira) bodies() []map[string]interface{} {
+	var bodies []map[string]interface{}
+	for _, request := range m.received() {
+		if request.Body != nil {
+			bodies = append(bodies, request.Body)
+		}
+	}
+	return bodies
+}
+
+// body returns the JSON object body of the last "METHOD /path" request, or nil.
+func (m *mockJira) body(route string) map[string]interface{} {
+	var body map[string]interface{}
+	for _, request := range m.received() {
+		if request.Method+" "+request.URL.Path == route {
+			body = request.Body
+		}
+	}
+	return body
+}
+
+// calls counts the received "METHOD /path" requests.
+func (m *mockJira) calls(route string) int {
+	calls := 0
+	for _, request := range m.received() {
+		if request.Method+" "+request.URL.Path == route {
+			calls++
+		}
+	}
+	return calls
+}
+
+// jiraToolCase is a tool call against a mock Jira server and its expected outcome.
+type jiraToolCase struct {
+	name     string
+	routes   mockJiraRoutes // added to the test's routes
+	tool     string
+	args     map[string]interface{}
+	wantCode int    // domain error code of an expected error
+	wantMsg  string // text the expected error message contains
+	check    func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error)
+}
+
+// runJiraToolCases runs each case against a fresh mock Jira server for routes,
+// checking the expected error before calling the case's check, if any.
+func runJiraToolCases(t *testing.T, routes mockJiraRoutes, tests []jiraToolCase) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caseRoutes := make(mockJiraRoutes, len(routes)+len(tt.routes))
+			for key, handler := range routes {
+				caseRoutes[key] = handler
+			}
+			for key, handler := range tt.routes {
+				caseRoutes[key] = handler
+			}
+			m := newMockJira(t, caseRoutes)
+
+			resp, err := m.handler().Handle(context.Background(), &domain.ToolRequest{Name: tt.tool, Arguments: tt.args})
+			if tt.wantCode != 0 {
+				domainErr, ok := err.(*domain.Error)
+				if !ok {
+					t.Fatalf("expected domain.Error, got %T (%v)", err, err)
+				}
+				if domainErr.Code != tt.wantCode || !contains(domainErr.Message, tt.wantMsg) {
+					t.Errorf("expected error %d containing %q, got %d %q", tt.wantCode, tt.wantMsg, domainErr.Code, domainErr.Message)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.check != nil {
+				tt.check(t, m, resp, err)
+			}
+		})
+	}
+}
+
+// decodeResponse decodes the JSON text of resp into v.
+func decodeResponse(t *testing.T, resp *domain.ToolResponse, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal([]byte(resp.Content[0].Text), v); err != nil {
+		t.Fatalf("failed to parse response: %v\n%s", err, resp.Content[0].Text)
+	}
+}
+
+// noRequests checks that a tool call did not reach the server.
+func noRequests(t *testing.T, m *mockJira, _ *domain.ToolResponse, _ error) {
+	t.Helper()
+	if log := m.log(); len(log) != 0 {
+		t.Errorf("expected no requests, got %v", log)
+	}
+}
+
+// requested checks that a tool call sent exactly the requests in log, as returned by
+// mockJira.log.
+func requested(log ...string) func(*testing.T, *mockJira, *domain.ToolResponse, error) {
+	return func(t *testing.T, m *mockJira, _ *domain.ToolResponse, _ error) {
+		t.Helper()
+		if got := m.log(); strings.Join(got, "\n") != strings.Join(log, "\n") {
+			t.Errorf("expected requests %v, got %v", log, got)
+		}
+	}
+}
+
+// sentFields returns the fields of the last "METHOD /path" request body.
+func sentFields(t *testing.T, m *mockJira, route string) map[string]interface{} {
+	t.Helper()
+	fields, ok := m.body(route)["fields"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected fields in %s, got %v", route, m.body(route))
+	}
+	return fields
+}
+
+// noWrites checks that a tool call did not change anything on the server.
+func noWrites(t *testing.T, m *mockJira, _ *domain.ToolResponse, _ error) {
+	t.Helper()
+	for _, request := range m.received() {
+		if request.Method != http.MethodGet {
+			t.Errorf("expected no writes, got %s %s", request.Method, request.URL.RequestURI())
+		}
+	}
+}
+
+func TestJiraHandler_HandleWhoAmI(t *testing.T) {
+	runJiraToolCases(t, mockJiraRoutes{
+		"/rest/api/2/myself":        jiraReply(http.StatusOK, `{"name":"jdoe","displayName":"Jane Doe","emailAddress":"jdoe@example.com"}`),
+		"/rest/api/2/mypermissions": jiraReply(http.StatusOK, `{"permissions":{"BROWSE_PROJECTS":{"key":"BROWSE_PROJECTS","havePermission":true},"ADMINISTER":{"key":"ADMINISTER","havePermission":false}}}`),
+	}, []jiraToolCase{
+		{
+			name: "identity and granted permissions",
+			tool: ToolJiraWhoAmI,
+			args: map[string]interface{}{},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var identity domain.Identity
+				decodeResponse(t, resp, &identity)
+				if identity.Username != "jdoe" || identity.Tool != "jira" {
+					t.Errorf("unexpected identity: %+v", identity)
+				}
+				if len(identity.Permissions) != 1 || identity.Permissions[0] != "BROWSE_PROJECTS" {
+					t.Errorf("expected only granted permissions, got %v", identity.Permissions)
+				}
+			},
+		},
+	})
+}
+
+// jiraSearchPages serves total issues TEST-1..TEST-<total> page by page.
+func jiraSearchPages(total int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		startAt, _ := strconv.Atoi(query.Get("startAt"))
+		maxResults, _ := strconv.Atoi(query.Get("maxResults"))
+		if maxResults == 0 {
+			maxResults = 50
+		}
+		issues := []map[string]interface{}{}
+		for i := startAt; i < total && i < startAt+maxResults; i++ {
+			issues = append(issues, map[string]interface{}{
+				"key": fmt.Sprintf("TEST-%d", i+1),
+				"fields": map[string]interface{}{
+					"summary":  fmt.Sprintf("Issue %d", i+1),
+					"status":   map[string]interface{}{"name": "Open"},
+					"assignee": map[string]interface{}{"name": "jdoe"},
+				},
+			})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"startAt": startAt, "maxResults": maxResults, "total": total, "issues": issues,
+		})
+	}
+}
+
+// searchQueries returns the query of each search request received by m.
+func searchQueries(m *mockJira) []url.Values {
+	var queries []url.Values
+	for _, request := range m.received() {
+		if request.URL.Path == "/rest/api/2/search" {
+			queries = append(queries, request.URL.Query())
+		}
+	}
+	return queries
+}
+
+func TestJiraHandler_HandleSearchJQL_Pages(t *testing.T) {
+	runJiraToolCases(t, nil, []jiraToolCase{
+		{
+			name:   "all pages",
+			routes: mockJiraRoutes{"GET /rest/api/2/search": jiraSearchPages(5)},
+			tool:   ToolJiraSearchJQL,
+			args: map[string]interface{}{
+				"jql":        "project = TEST ORDER BY key",
+				"orderBy":    "updated DESC",
+				"maxResults": float64(2),
+				"all":        true,
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var results domain.SearchResults
+				decodeResponse(t, resp, &results)
+				if len(results.Issues) != 5 || results.Total != 5 || results.Truncated || results.Issues[4].Key != "TEST-5" {
+					t.Fatalf("unexpected results: %d issues, total %d, truncated %v", len(results.Issues), results.Total, results.Truncated)
+				}
+				queries := searchQueries(m)
+				if len(queries) != 3 {
+					t.Fatalf("expected 3 pages, got %d", len(queries))
+				}
+				if jql := queries[0].Get("jql"); jql != "project = TEST ORDER BY updated DESC" {
+					t.Errorf("unexpected jql: %s", jql)
+				}
+				if queries[2].Get("startAt") != "4" {
+					t.Errorf("unexpected last page: %v", queries[2])
+				}
+			},
+		},
+		{
+			name:   "compact",
+			routes: mockJiraRoutes{"GET /rest/api/2/search": jiraSearchPages(2)},
+			tool:   ToolJiraSearchJQL,
+			args:   map[string]interface{}{"jql": "project = TEST", "compact": true, "expand": []interface{}{"names"}},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var results domain.CompactSearchResults
+				decodeResponse(t, resp, &results)
+				if len(results.Issues) != 2 || results.Issues[0] != (domain.CompactIssue{Key: "TEST-1", Summary: "Issue 1", Status: "Open", Assignee: "jdoe"}) {
+					t.Errorf("unexpected compact results: %+v", results)
+				}
+				if contains(resp.Content[0].Text, "fields") {
+					t.Errorf("expected no raw fields in compact output: %s", resp.Content[0].Text)
+				}
+				queries := searchQueries(m)
+				if fields := strings.Join(queries[0]["fields"], ","); fields != "summary,status,assignee" || queries[0].Get("expand") != "names" {
+					t.Errorf("unexpected query: %v", queries[0])
+				}
+			},
+		},
+	})
+}
+
+func TestJiraHandler_HandleSearchJQL_AllCeiling(t *testing.T) {
+	m := newMockJira(t, mockJiraRoutes{"GET /rest/api/2/search": jiraSearchPages(250)})
+	handler := m.handler()
+	handler.SetMaxSearchResults(120)
+
+	resp, err := handler.Handle(context.Background(), &domain.ToolRequest{
+		Name:      ToolJiraSearchJQL,
+		Arguments: map[string]interface{}{"jql": "project = TEST", "all": true},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var results domain.SearchResults
+	decodeResponse(t, resp, &results)
+	if len(results.Issues) != 120 || results.Total != 250 || !results.Truncated {
+		t.Fatalf("expected 120 of 250 issues, truncated; got %d of %d (%v)", len(results.Issues), results.Total, results.Truncated)
+	}
+	if queries := searchQueries(m); len(queries) != 2 || queries[1].Get("maxResults") != "20" {
+		t.Errorf("expected a short second page, got %v", queries)
+	}
+}
+
+// jiraIssueRoutes accepts new issues and updates to TEST-123.
+var jiraIssueRoutes = mockJiraRoutes{
+	"POST /rest/api/2/issue":         jiraReply(http.StatusCreated, `{"id":"10002","key":"TEST-124","fields":{"summary":"New test issue"}}`),
+	"PUT /rest/api/2/issue/TEST-123": jiraReply(http.StatusNoContent, ""),
+}
+
+func TestJiraHandler_IssueFields(t *testing.T) {
+	runJiraToolCases(t, jiraIssueRoutes, []jiraToolCase{
+		{
+			name: "create",
+			tool: ToolJiraCreateIssue,
+			args: map[string]interface{}{
+				"projectKey":  "TEST",
+				"summary":     "New test issue",
+				"issueType":   "Bug",
+				"reporter":    "jdoe",
+				"priority":    "High",
+				"labels":      []interface{}{"backend", "urgent"},
+				"dueDate":     "2024-06-30",
+				"environment": "**Linux**",
+				"format":      "markdown",
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				fields := sentFields(t, m, "POST /rest/api/2/issue")
+				if fields["priority"].(map[string]interface{})["name"] != "High" || fields["reporter"].(map[string]interface{})["name"] != "jdoe" ||
+					fields["duedate"] != "2024-06-30" || fields["environment"] != "*Linux*" || len(fields["labels"].([]interface{})) != 2 {
+					t.Errorf("unexpected create fields: %v", fields)
+				}
+			},
+		},
+		{
+			name: "update",
+			tool: ToolJiraUpdateIssue,
+			args: map[string]interface{}{
+				"issueKey":     "TEST-123",
+				"priority":     "Low",
+				"addLabels":    []interface{}{"triaged"},
+				"removeLabels": []interface{}{"new"},
+				"dueDate":      "",
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				body := m.body("PUT /rest/api/2/issue/TEST-123")
+				update, _ := json.Marshal(body["update"])
+				if string(update) != `{"duedate":[{"set":null}],"labels":[{"add":"triaged"},{"remove":"new"}]}` {
+					t.Errorf("unexpected update operations: %s", update)
+				}
+				if body["fields"].(map[string]interface{})["priority"].(map[string]interface{})["name"] != "Low" {
+					t.Errorf("unexpected update fields: %v", body["fields"])
+				}
+			},
+		},
+		{
+			name: "clear labels",
+			tool: ToolJiraUpdateIssue,
+			args: map[string]interface{}{"issueKey": "TEST-123", "labels": []interface{}{}},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				update, _ := json.Marshal(m.body("PUT /rest/api/2/issue/TEST-123")["update"])
+				if string(update) != `{"labels":[{"set":[]}]}` {
+					t.Errorf("expected labels to be cleared, got %s", update)
+				}
+			},
+		},
+		{
+			name:     "labels with label operations",
+			tool:     ToolJiraUpdateIssue,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "labels": []interface{}{"a"}, "addLabels": []interface{}{"b"}},
+			wantCode: domain.InvalidParams,
+		},
+		{
+			name:     "label with spaces",
+			tool:     ToolJiraUpdateIssue,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "addLabels": []interface{}{"two words"}},
+			wantCode: domain.InvalidParams,
+		},
+		{
+			name:     "invalid due date",
+			tool:     ToolJiraUpdateIssue,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "dueDate": "30/06/2024"},
+			wantCode: domain.InvalidParams,
+		},
+	})
+}
@@ -0,0 +1,283 @@
+package application
+
+import (
+	"context"
+	"strings"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraJQLTools returns the definitions of the JQL validation and builder tools.
+func jiraJQLTools() []domain.ToolDefinition {
+	return []domain.ToolDefinition{
+		{
+			Name:        ToolJiraValidateJQL,
+			Description: "Check JQL queries for syntax errors, unknown fields and invalid values without running them",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"jql": map[string]interface{}{
+						"type":        "string",
+						"description": "The JQL query to check (required unless queries is given)",
+					},
+					"queries": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Several JQL queries to check at once (optional)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{},
+			},
+		},
+		{
+			Name:        ToolJiraGetJQLAutocomplete,
+			Description: "List the fields and functions usable in JQL, or suggest values for a field",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"fieldName": map[string]interface{}{
+						"type":        "string",
+						"description": "Suggest values for this JQL field, e.g. status or project (optional)",
+					},
+					"fieldValue": map[string]interface{}{
+						"type":        "string",
+						"description": "Beginning of the value to suggest (optional, used with fieldName)",
+					},
+					"query": map[string]interface{}{
+						"type":        "string",
+						"description": "Only fields and functions whose name contains this text (optional, without fieldName)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{},
+			},
+		},
+		{
+			Name:        ToolJiraBuildJQL,
+			Description: "Build a correctly quoted JQL query from structured conditions, optionally checking it against the server",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"projects": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Project keys (optional)",
+					},
+					"issueTypes": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Issue type names (optional)",
+					},
+					"statuses": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Status names (optional)",
+					},
+					"assignee": map[string]interface{}{
+						"type":        "string",
+						"description": "Assignee username, currentUser() or unassigned (optional)",
+					},
+					"reporter": map[string]interface{}{
+						"type":        "string",
+						"description": "Reporter username or currentUser() (optional)",
+					},
+					"labels": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Labels, matching any of them (optional)",
+					},
+					"text": map[string]interface{}{
+						"type":        "string",
+						"description": "Full-text search of summary, description and comments (optional)",
+					},
+					"updatedSince": map[string]interface{}{
+						"type":        "string",
+						"description": "Updated on or after this date: YYYY-MM-DD or relative, e.g. -7d (optional)",
+					},
+					"createdSince": map[string]interface{}{
+						"type":        "string",
+						"description": "Created on or after this date: YYYY-MM-DD or relative, e.g. -7d (optional)",
+					},
+					"resolved": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Only resolved (true) or unresolved (false) issues (optional)",
+					},
+					"jql": map[string]interface{}{
+						"type":        "string",
+						"description": "Additional raw JQL condition (optional)",
+					},
+					"orderBy": map[string]interface{}{
+						"type":        "string",
+						"description": "ORDER BY clause, e.g. updated DESC (optional)",
+					},
+					"validate": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Also check the query against the server (optional, default: false)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{},
+			},
+		},
+	}
+}
+
+// handleValidateJQL handles the jira_validate_jql tool call.
+func (h *JiraHandler) handleValidateJQL(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	jql, _ := getStringParam(args, "jql", false)
+	queries, err := getStringArrayParam(args, "queries", false)
+	if err != nil {
+		return nil, err
+	}
+	if jql != "" {
+		queries = append([]string{jql}, queries...)
+	}
+	if len(queries) == 0 {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "either jql or queries is required",
+		}
+	}
+
+	// Call the Jira client
+	results, err := client.ValidateJQL(queries)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	valid := true
+	for _, result := range results {
+		valid = valid && result.Valid
+	}
+	return h.mapper.MapToToolResponse(map[string]interface{}{
+		"valid":   valid,
+		"queries": results,
+	})
+}
+
+// handleGetJQLAutocomplete handles the jira_get_jql_autocomplete tool call.
+func (h *JiraHandler) handleGetJQLAutocomplete(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	fieldName, _ := getStringParam(args, "fieldName", false)
+	fieldValue, _ := getStringParam(args, "fieldValue", false)
+	query, _ := getStringParam(args, "query", false)
+
+	// Suggest values for a field
+	if fieldName != "" {
+		suggestions, err := client.GetJQLSuggestions(fieldName, fieldValue)
+		if err != nil {
+			return nil, h.mapper.MapError(err)
+		}
+		return h.mapper.MapToToolResponse(suggestions)
+	}
+
+	// Call the Jira client
+	data, err := client.GetJQLAutocompleteData()
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Filter the fields and functions
+	if query != "" {
+		query = strings.ToLower(query)
+		matches := func(value, displayName string) bool {
+			return strings.Contains(strings.ToLower(value), query) || strings.Contains(strings.ToLower(displayName), query)
+		}
+		fields := make([]domain.JQLFieldReference, 0, len(data.VisibleFieldNames))
+		for _, field := range data.VisibleFieldNames {
+			if matches(field.Value, field.DisplayName) {
+				fields = append(fields, field)
+			}
+		}
+		functions := make([]domain.JQLFunctionReference, 0, len(data.VisibleFunctionNames))
+		for _, function := range data.VisibleFunctionNames {
+			if matches(function.Value, function.DisplayName) {
+				functions = append(functions, function)
+			}
+		}
+		data.VisibleFieldNames, data.VisibleFunctionNames = fields, functions
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(data)
+}
+
+// handleBuildJQL handles the jira_build_jql tool call.
+// Building is local; the server is only contacted when validate is set.
+func (h *JiraHandler) handleBuildJQL(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Optional parameters
+	filter := &domain.JQLFilter{}
+	for name, target := range map[string]*[]string{
+		"projects":   &filter.Projects,
+		"issueTypes": &filter.IssueTypes,
+		"statuses":   &filter.Statuses,
+		"labels":     &filter.Labels,
+	} {
+		values, err := getStringArrayParam(args, name, false)
+		if err != nil {
+			return nil, err
+		}
+		*target = values
+	}
+	filter.Assignee, _ = getStringParam(args, "assignee", false)
+	filter.Reporter, _ = getStringParam(args, "reporter", false)
+	filter.Text, _ = getStringParam(args, "text", false)
+	filter.UpdatedSince, _ = getStringParam(args, "updatedSince", false)
+	filter.CreatedSince, _ = getStringParam(args, "createdSince", false)
+	filter.JQL, _ = getStringParam(args, "jql", false)
+	filter.OrderBy, _ = getStringParam(args, "orderBy", false)
+	if _, ok := args["resolved"]; ok {
+		resolved, err := getBoolParam(args, "resolved", false)
+		if err != nil {
+			return nil, err
+		}
+		filter.Resolved = &resolved
+	}
+	validate, err := getBoolParam(args, "validate", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the query
+	jql, err := domain.BuildJQL(filter)
+	if err != nil {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: err.Error(),
+		}
+	}
+	result := map[string]interface{}{"jql": jql}
+
+	// Check the query against the server
+	if validate {
+		client, err := h.getClientForRequest(args)
+		if err != nil {
+			return nil, err
+		}
+		validation, err := client.ValidateJQL([]string{jql})
+		if err != nil {
+			return nil, h.mapper.MapError(err)
+		}
+		if len(validation) > 0 {
+			result["validation"] = validation[0]
+		}
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(result)
+}
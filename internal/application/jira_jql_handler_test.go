@@ -0,0 +1,133 @@
+package application
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraJQLRoutes parses queries, reporting the misspelt field "stauts", and serves
+// autocomplete data for the status and Story Points fields.
+var jiraJQLRoutes = mockJiraRoutes{
+	"POST /rest/api/2/jql/parse": func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Queries []string `json:"queries"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		results := make([]map[string]interface{}, len(body.Queries))
+		for i, query := range body.Queries {
+			results[i] = map[string]interface{}{"query": query}
+			if strings.Contains(query, "stauts") {
+				results[i]["errors"] = []string{"Field 'stauts' does not exist"}
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"queries": results})
+	},
+	"/rest/api/2/jql/autocompletedata": jiraReply(http.StatusOK, `{"visibleFieldNames":[{"value":"status","displayName":"status"},{"value":"cf[10002]","displayName":"Story Points - cf[10002]"}],
+		"visibleFunctionNames":[{"value":"currentUser()","displayName":"currentUser()"},{"value":"startOfDay()","displayName":"startOfDay()"}]}`),
+	"/rest/api/2/jql/autocompletedata/suggestions": jiraReply(http.StatusOK, `{"results":[{"value":"Done","displayName":"<b>Do</b>ne"}]}`),
+}
+
+func TestJiraHandler_ValidateJQL(t *testing.T) {
+	runJiraToolCases(t, jiraJQLRoutes, []jiraToolCase{
+		{
+			name: "invalid and valid queries",
+			tool: ToolJiraValidateJQL,
+			args: map[string]interface{}{"jql": "stauts = Open", "queries": []interface{}{"project = TEST"}},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var result struct {
+					Valid   bool                        `json:"valid"`
+					Queries []domain.JQLQueryValidation `json:"queries"`
+				}
+				decodeResponse(t, resp, &result)
+				if result.Valid || len(result.Queries) != 2 || result.Queries[0].Valid || !result.Queries[1].Valid {
+					t.Errorf("unexpected result: %+v", result)
+				}
+			},
+		},
+		{
+			name:     "no queries",
+			tool:     ToolJiraValidateJQL,
+			args:     map[string]interface{}{},
+			wantCode: domain.InvalidParams,
+		},
+	})
+}
+
+func TestJiraHandler_JQLAutocomplete(t *testing.T) {
+	runJiraToolCases(t, jiraJQLRoutes, []jiraToolCase{
+		{
+			name: "filtered reference data",
+			tool: ToolJiraGetJQLAutocomplete,
+			args: map[string]interface{}{"query": "story"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var data domain.JQLAutocompleteData
+				decodeResponse(t, resp, &data)
+				if len(data.VisibleFieldNames) != 1 || data.VisibleFieldNames[0].Value != "cf[10002]" || len(data.VisibleFunctionNames) != 0 {
+					t.Errorf("unexpected filtered data: %+v", data)
+				}
+			},
+		},
+		{
+			name: "value suggestions",
+			tool: ToolJiraGetJQLAutocomplete,
+			args: map[string]interface{}{"fieldName": "status", "fieldValue": "Do"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var suggestions []domain.JQLSuggestion
+				decodeResponse(t, resp, &suggestions)
+				if len(suggestions) != 1 || suggestions[0].Value != "Done" {
+					t.Errorf("unexpected suggestions: %+v", suggestions)
+				}
+			},
+		},
+	})
+}
+
+func TestJiraHandler_BuildJQL(t *testing.T) {
+	want := `project = "TEST" AND status in ("To Do", "In Progress") AND assignee = currentUser() AND updated >= "-14d" AND resolution is EMPTY ORDER BY updated DESC`
+
+	runJiraToolCases(t, jiraJQLRoutes, []jiraToolCase{
+		{
+			name: "build and validate",
+			tool: ToolJiraBuildJQL,
+			args: map[string]interface{}{
+				"projects":     []interface{}{"TEST"},
+				"statuses":     []interface{}{"To Do", "In Progress"},
+				"assignee":     "currentUser()",
+				"updatedSince": "-14d",
+				"resolved":     false,
+				"orderBy":      "updated DESC",
+				"validate":     true,
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var result struct {
+					JQL        string                     `json:"jql"`
+					Validation *domain.JQLQueryValidation `json:"validation"`
+				}
+				decodeResponse(t, resp, &result)
+				if result.JQL != want {
+					t.Errorf("unexpected jql:\n got %s\nwant %s", result.JQL, want)
+				}
+				parsed, _ := m.body("POST /rest/api/2/jql/parse")["queries"].([]interface{})
+				if result.Validation == nil || !result.Validation.Valid || len(parsed) != 1 || parsed[0] != want {
+					t.Errorf("expected the built query to be validated, got %+v (%v)", result.Validation, parsed)
+				}
+			},
+		},
+		{
+			name:  "build only",
+			tool:  ToolJiraBuildJQL,
+			args:  map[string]interface{}{"text": "crash"},
+			check: noRequests,
+		},
+		{
+			name:     "invalid date",
+			tool:     ToolJiraBuildJQL,
+			args:     map[string]interface{}{"createdSince": "yesterday"},
+			wantCode: domain.InvalidParams,
+		},
+	})
+}
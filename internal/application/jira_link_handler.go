@@ -0,0 +1,518 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"atlassian-mcp-server/internal/domain"
+	"atlassian-mcp-server/internal/infrastructure"
+)
+
+// jiraLinkTools returns the definitions of the Jira issue link and hierarchy tools.
+func jiraLinkTools() []domain.ToolDefinition {
+	return []domain.ToolDefinition{
+		{
+			Name:        ToolJiraListLinkTypes,
+			Description: "List the issue link types (e.g., Blocks: blocks / is blocked by)",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"auth": getAuthSchema(),
+				},
+				Required: []string{},
+			},
+		},
+		{
+			Name:        ToolJiraLinkIssues,
+			Description: "Link two Jira issues so that issueKey <linkType> targetIssueKey, e.g. TEST-1 blocks TEST-2",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-1)",
+					},
+					"linkType": map[string]interface{}{
+						"type":        "string",
+						"description": "Link type name or direction (e.g., Blocks, blocks, is blocked by, relates to)",
+					},
+					"targetIssueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue to link to (e.g., TEST-2)",
+					},
+					"comment": map[string]interface{}{
+						"type":        "string",
+						"description": "Comment to add to issueKey with the link (optional)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey", "linkType", "targetIssueKey"},
+			},
+		},
+		{
+			Name:        ToolJiraDeleteIssueLink,
+			Description: "Delete an issue link by its ID (see the issuelinks field of jira_get_issue)",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"linkId": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue link ID",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"linkId"},
+			},
+		},
+		{
+			Name:        ToolJiraCreateSubtask,
+			Description: "Create a sub-task under a parent issue",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"parentKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The parent issue key (e.g., TEST-123)",
+					},
+					"summary": map[string]interface{}{
+						"type":        "string",
+						"description": "The sub-task summary",
+					},
+					"issueType": map[string]interface{}{
+						"type":        "string",
+						"description": "The sub-task issue type (optional, defaults to the project's sub-task type)",
+					},
+					"description": map[string]interface{}{
+						"type":        "string",
+						"description": "The sub-task description (optional)",
+					},
+					"assignee": map[string]interface{}{
+						"type":        "string",
+						"description": "The assignee username, email address or display name (optional)",
+					},
+					"fields": map[string]interface{}{
+						"type":        "object",
+						"description": "Additional fields keyed by field name or ID (optional)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"parentKey", "summary"},
+			},
+		},
+		{
+			Name:        ToolJiraSetEpicLink,
+			Description: "Add an issue to an epic, or remove it from its epic when epicKey is omitted",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"epicKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The epic issue key; omit to clear the epic (optional)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+		{
+			Name:        ToolJiraGetIssueTree,
+			Description: "Walk an issue hierarchy (epic → stories → sub-tasks) into a nested tree",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The root issue key, usually an epic (e.g., TEST-1)",
+					},
+					"maxDepth": map[string]interface{}{
+						"type":        "integer",
+						"description": "Number of levels below the root to include (optional, default 2)",
+					},
+					"maxIssues": map[string]interface{}{
+						"type":        "integer",
+						"description": "Maximum number of issues in the tree (optional, default 200, max 1000)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+	}
+}
+
+// handleListLinkTypes handles the jira_list_link_types tool call.
+func (h *JiraHandler) handleListLinkTypes(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	linkTypes, err := client.GetIssueLinkTypes()
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(linkTypes)
+}
+
+// handleLinkIssues handles the jira_link_issues tool call.
+func (h *JiraHandler) handleLinkIssues(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+	linkType, err := getStringParam(args, "linkType", true)
+	if err != nil {
+		return nil, err
+	}
+	targetIssueKey, err := getStringParam(args, "targetIssueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	comment, err := getStringParam(args, "comment", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve the link type and direction
+	linkTypes, err := client.GetIssueLinkTypes()
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+	link, err := domain.NewIssueLinkRequest(linkTypes, issueKey, linkType, targetIssueKey)
+	if err != nil {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: err.Error(),
+		}
+	}
+	if comment != "" {
+		link.Comment = &domain.Comment{Body: comment}
+	}
+
+	// Call the Jira client
+	err = client.CreateIssueLink(link)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Return success response
+	return h.mapper.MapToToolResponse(map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("Linked %s to %s (%s)", issueKey, targetIssueKey, linkType),
+	})
+}
+
+// handleDeleteIssueLink handles the jira_delete_issue_link tool call.
+func (h *JiraHandler) handleDeleteIssueLink(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	linkID, err := getStringParam(args, "linkId", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	err = client.DeleteIssueLink(linkID)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Return success response
+	return h.mapper.MapToToolResponse(map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("Issue link %s deleted successfully", linkID),
+	})
+}
+
+// handleCreateSubtask handles the jira_create_subtask tool call.
+func (h *JiraHandler) handleCreateSubtask(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	parentKey, err := getStringParam(args, "parentKey", true)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := getStringParam(args, "summary", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sub-tasks live in the parent's project
+	projectKey, ok := domain.ProjectKeyFromIssueKey(parentKey)
+	if !ok {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("parentKey '%s' is not an issue key", parentKey),
+		}
+	}
+
+	// Optional parameters
+	issueType, _ := getStringParam(args, "issueType", false)
+	description, _ := getStringParam(args, "description", false)
+	assignee, _ := getStringParam(args, "assignee", false)
+
+	// Default to the project's first sub-task issue type
+	if issueType == "" {
+		issueType = defaultSubtaskIssueType
+		if issueTypes, err := client.GetCreateMetaIssueTypes(projectKey); err == nil {
+			for _, candidate := range issueTypes {
+				if candidate.Subtask {
+					issueType = candidate.Name
+					break
+				}
+			}
+		}
+	}
+
+	// Build the create request
+	createReq := &domain.JiraIssueCreate{
+		Fields: domain.JiraFieldsCreate{
+			Summary:     summary,
+			Description: description,
+			IssueType: domain.IssueTypeRef{
+				Name: issueType,
+			},
+			Project: domain.ProjectRef{
+				Key: projectKey,
+			},
+			Parent: &domain.IssueRef{
+				Key: parentKey,
+			},
+		},
+	}
+
+	// Add assignee if provided, resolving emails and display names
+	if assignee != "" {
+		name, err := h.resolveAssignee(client, assignee, projectKey, "")
+		if err != nil {
+			return nil, err
+		}
+		createReq.Fields.Assignee = &domain.UserRef{
+			Name: name,
+		}
+	}
+
+	// Add arbitrary fields by name or ID
+	extraFields, err := h.resolveFieldValues(client, args)
+	if err != nil {
+		return nil, err
+	}
+	createReq.Fields.Extra = extraFields
+
+	// Report missing required fields before attempting the create
+	if err := h.validateCreateFields(client, createReq); err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	issue, err := client.CreateIssue(createReq)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(issue)
+}
+
+// handleSetEpicLink handles the jira_set_epic_link tool call.
+// The Epic Link field of Jira Software is used when present; otherwise the epic is set as the parent.
+func (h *JiraHandler) handleSetEpicLink(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	epicKey, err := getStringParam(args, "epicKey", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the field that holds the epic
+	fields, err := client.CachedFields()
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+	var fieldID string
+	var value interface{}
+	if epicField := domain.EpicLinkField(fields); epicField != nil {
+		fieldID = epicField.ID
+		if epicKey != "" {
+			value = epicKey
+		}
+	} else {
+		fieldID = "parent"
+		if epicKey != "" {
+			value = map[string]interface{}{"key": epicKey}
+		}
+	}
+
+	// Call the Jira client
+	err = client.UpdateIssue(issueKey, &domain.JiraIssueUpdate{
+		Fields: domain.JiraFieldsUpdate{
+			Extra: map[string]interface{}{fieldID: value},
+		},
+	})
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Return success response
+	message := fmt.Sprintf("Issue %s added to epic %s", issueKey, epicKey)
+	if epicKey == "" {
+		message = fmt.Sprintf("Issue %s removed from its epic", issueKey)
+	}
+	return h.mapper.MapToToolResponse(map[string]interface{}{
+		"success": true,
+		"message": message,
+	})
+}
+
+// handleGetIssueTree handles the jira_get_issue_tree tool call.
+func (h *JiraHandler) handleGetIssueTree(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	maxDepth, err := getIntParam(args, "maxDepth", false)
+	if err != nil {
+		return nil, err
+	}
+	if maxDepth <= 0 {
+		maxDepth = defaultIssueTreeDepth
+	}
+	maxIssues, err := getIntParam(args, "maxIssues", false)
+	if err != nil {
+		return nil, err
+	}
+	if maxIssues <= 0 {
+		maxIssues = defaultIssueTreeSize
+	}
+	if maxIssues > maxIssueTreeSize {
+		maxIssues = maxIssueTreeSize
+	}
+
+	// Call the Jira client
+	root, err := client.GetIssue(issueKey)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Epics find their stories through the Epic Link field when Jira Software is installed
+	var epicField *domain.JiraField
+	if fields, err := client.CachedFields(); err == nil {
+		epicField = domain.EpicLinkField(fields)
+	}
+
+	tree := &domain.IssueTree{
+		Root:       domain.NewIssueTreeNode(root),
+		IssueCount: 1,
+	}
+	if err := h.addIssueTreeChildren(client, tree, tree.Root, epicField, maxDepth, maxIssues); err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(tree)
+}
+
+// Issue hierarchy defaults.
+const (
+	defaultSubtaskIssueType = "Sub-task"
+	defaultIssueTreeDepth   = 2
+	defaultIssueTreeSize    = 200
+	maxIssueTreeSize        = 1000
+)
+
+// addIssueTreeChildren searches for the children of node (stories of an epic, sub-tasks
+// of an issue) and recurses until depth levels are filled or maxIssues is reached.
+func (h *JiraHandler) addIssueTreeChildren(client *infrastructure.JiraClient, tree *domain.IssueTree, node *domain.IssueTreeNode, epicField *domain.JiraField, depth, maxIssues int) error {
+	if depth == 0 {
+		return nil
+	}
+	remaining := maxIssues - tree.IssueCount
+	if remaining <= 0 {
+		tree.Truncated = true
+		return nil
+	}
+
+	jql := fmt.Sprintf("parent = %s", node.Key)
+	if epicField != nil && epicField.Schema != nil && strings.EqualFold(node.IssueType, "Epic") {
+		jql = fmt.Sprintf("cf[%d] = %s OR %s", epicField.Schema.CustomID, node.Key, jql)
+	}
+	jql += " ORDER BY key ASC"
+
+	results, err := client.SearchJQL(jql, &infrastructure.SearchOptions{
+		JQL:        jql,
+		MaxResults: remaining,
+		Fields:     []string{"summary", "issuetype", "status"},
+	})
+	if err != nil {
+		return err
+	}
+	if results.Total > len(results.Issues) {
+		tree.Truncated = true
+	}
+
+	for i := range results.Issues {
+		if tree.IssueCount >= maxIssues {
+			tree.Truncated = true
+			break
+		}
+		child := domain.NewIssueTreeNode(&results.Issues[i])
+		node.Children = append(node.Children, child)
+		tree.IssueCount++
+	}
+
+	for _, child := range node.Children {
+		if err := h.addIssueTreeChildren(client, tree, child, epicField, depth-1, maxIssues); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
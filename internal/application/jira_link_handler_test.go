@@ -0,0 +1,195 @@
+package application
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraHierarchyRoutes serves epic TEST-1, its stories TEST-2 and TEST-3, and sub-task
+// TEST-4 under TEST-2, with an Epic Link custom field.
+var jiraHierarchyRoutes = mockJiraRoutes{
+	"/rest/api/2/field":                              jiraReply(http.StatusOK, `[{"id":"customfield_10004","name":"Epic Link","custom":true,"schema":{"type":"any","custom":"com.pyxis.greenhopper.jira:gh-epic-link","customId":10004}}]`),
+	"/rest/api/2/issueLinkType":                      jiraReply(http.StatusOK, `{"issueLinkTypes":[{"id":"10000","name":"Blocks","inward":"is blocked by","outward":"blocks"}]}`),
+	"POST /rest/api/2/issueLink":                     jiraReply(http.StatusCreated, ""),
+	"DELETE /rest/api/2/issueLink/20000":             jiraReply(http.StatusNoContent, ""),
+	"/rest/api/2/issue/createmeta/TEST/issuetypes":   jiraReply(http.StatusOK, `{"isLast":true,"values":[{"id":"1","name":"Story","subtask":false},{"id":"5","name":"Technical task","subtask":true}]}`),
+	"/rest/api/2/issue/createmeta/TEST/issuetypes/5": jiraReply(http.StatusOK, `{"isLast":true,"values":[{"fieldId":"summary","name":"Summary","required":true},{"fieldId":"parent","name":"Parent","required":true}]}`),
+	"POST /rest/api/2/issue":                         jiraReply(http.StatusCreated, `{"id":"10005","key":"TEST-5","fields":{"summary":"New sub-task"}}`),
+	"PUT /rest/api/2/issue/TEST-3":                   jiraReply(http.StatusNoContent, ""),
+	"GET /rest/api/2/issue/TEST-1":                   jiraReply(http.StatusOK, `{"id":"10001","key":"TEST-1","fields":{"summary":"Epic","issuetype":{"id":"6","name":"Epic"},"status":{"id":"1","name":"Open"}}}`),
+	"/rest/api/2/search": func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("jql") {
+		case "cf[10004] = TEST-1 OR parent = TEST-1 ORDER BY key ASC":
+			w.Write([]byte(`{"total":2,"issues":[
+				{"key":"TEST-2","fields":{"summary":"Story A","issuetype":{"name":"Story"},"status":{"name":"Open"}}},
+				{"key":"TEST-3","fields":{"summary":"Story B","issuetype":{"name":"Story"},"status":{"name":"Done"}}}
+			]}`))
+		case "parent = TEST-2 ORDER BY key ASC":
+			w.Write([]byte(`{"total":1,"issues":[
+				{"key":"TEST-4","fields":{"summary":"Sub-task","issuetype":{"name":"Sub-task","subtask":true},"status":{"name":"Open"}}}
+			]}`))
+		default:
+			w.Write([]byte(`{"total":0,"issues":[]}`))
+		}
+	},
+}
+
+// epicLinkSet checks that the epic link update of TEST-3 sets field to value.
+func epicLinkSet(field string, value interface{}) func(*testing.T, *mockJira, *domain.ToolResponse, error) {
+	return func(t *testing.T, m *mockJira, _ *domain.ToolResponse, _ error) {
+		t.Helper()
+		fields := sentFields(t, m, "PUT /rest/api/2/issue/TEST-3")
+		got, exists := fields[field]
+		if !exists {
+			t.Fatalf("expected %s to be sent, got %v", field, fields)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(value) {
+			t.Errorf("expected %v, got %v", value, got)
+		}
+	}
+}
+
+func TestJiraHandler_IssueLinks(t *testing.T) {
+	runJiraToolCases(t, jiraHierarchyRoutes, []jiraToolCase{
+		{
+			name: "link issues",
+			tool: ToolJiraLinkIssues,
+			args: map[string]interface{}{
+				"issueKey":       "TEST-2",
+				"linkType":       "is blocked by",
+				"targetIssueKey": "TEST-3",
+				"comment":        "Waiting on TEST-3",
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				linked := m.body("POST /rest/api/2/issueLink")
+				if linked["inwardIssue"].(map[string]interface{})["key"] != "TEST-3" ||
+					linked["outwardIssue"].(map[string]interface{})["key"] != "TEST-2" {
+					t.Errorf("expected TEST-3 to block TEST-2, got %v", linked)
+				}
+				if linked["comment"].(map[string]interface{})["body"] != "Waiting on TEST-3" {
+					t.Errorf("expected link comment, got %v", linked)
+				}
+			},
+		},
+		{
+			name:     "unknown link type",
+			tool:     ToolJiraLinkIssues,
+			args:     map[string]interface{}{"issueKey": "TEST-2", "linkType": "clones", "targetIssueKey": "TEST-3"},
+			wantCode: domain.InvalidParams,
+			check:    noWrites,
+		},
+		{
+			name: "list link types",
+			tool: ToolJiraListLinkTypes,
+			args: map[string]interface{}{},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if !contains(resp.Content[0].Text, "is blocked by") {
+					t.Errorf("unexpected link types: %s", resp.Content[0].Text)
+				}
+			},
+		},
+		{
+			name: "delete link",
+			tool: ToolJiraDeleteIssueLink,
+			args: map[string]interface{}{"linkId": "20000"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if m.calls("DELETE /rest/api/2/issueLink/20000") != 1 {
+					t.Errorf("expected the link to be deleted, got %v", m.log())
+				}
+			},
+		},
+	})
+}
+
+func TestJiraHandler_Subtasks(t *testing.T) {
+	runJiraToolCases(t, jiraHierarchyRoutes, []jiraToolCase{
+		{
+			name: "create sub-task",
+			tool: ToolJiraCreateSubtask,
+			args: map[string]interface{}{"parentKey": "TEST-2", "summary": "New sub-task"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				fields := sentFields(t, m, "POST /rest/api/2/issue")
+				if fields["parent"].(map[string]interface{})["key"] != "TEST-2" {
+					t.Errorf("expected parent TEST-2, got %v", fields)
+				}
+				if fields["project"].(map[string]interface{})["key"] != "TEST" {
+					t.Errorf("expected project TEST, got %v", fields)
+				}
+				if fields["issuetype"].(map[string]interface{})["name"] != "Technical task" {
+					t.Errorf("expected the project's sub-task type, got %v", fields["issuetype"])
+				}
+			},
+		},
+		{
+			name:     "invalid parent key",
+			tool:     ToolJiraCreateSubtask,
+			args:     map[string]interface{}{"parentKey": "TEST", "summary": "New sub-task"},
+			wantCode: domain.InvalidParams,
+			check:    noRequests,
+		},
+	})
+}
+
+func TestJiraHandler_EpicLinks(t *testing.T) {
+	runJiraToolCases(t, jiraHierarchyRoutes, []jiraToolCase{
+		{
+			name:  "epic link field",
+			tool:  ToolJiraSetEpicLink,
+			args:  map[string]interface{}{"issueKey": "TEST-3", "epicKey": "TEST-1"},
+			check: epicLinkSet("customfield_10004", "TEST-1"),
+		},
+		{
+			name:  "clear epic link",
+			tool:  ToolJiraSetEpicLink,
+			args:  map[string]interface{}{"issueKey": "TEST-3"},
+			check: epicLinkSet("customfield_10004", nil),
+		},
+		{
+			name: "parent field",
+			routes: mockJiraRoutes{
+				"/rest/api/2/field": jiraReply(http.StatusOK, `[{"id":"summary","name":"Summary","custom":false,"schema":{"type":"string","system":"summary"}}]`),
+			},
+			tool:  ToolJiraSetEpicLink,
+			args:  map[string]interface{}{"issueKey": "TEST-3", "epicKey": "TEST-1"},
+			check: epicLinkSet("parent", map[string]interface{}{"key": "TEST-1"}),
+		},
+	})
+}
+
+func TestJiraHandler_IssueTree(t *testing.T) {
+	runJiraToolCases(t, jiraHierarchyRoutes, []jiraToolCase{
+		{
+			name: "full tree",
+			tool: ToolJiraGetIssueTree,
+			args: map[string]interface{}{"issueKey": "TEST-1"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var tree domain.IssueTree
+				decodeResponse(t, resp, &tree)
+				if tree.IssueCount != 4 || tree.Truncated {
+					t.Errorf("expected 4 issues without truncation, got %d (truncated %v)", tree.IssueCount, tree.Truncated)
+				}
+				if len(tree.Root.Children) != 2 || tree.Root.Children[0].Key != "TEST-2" {
+					t.Fatalf("expected stories under the epic, got %+v", tree.Root.Children)
+				}
+				if len(tree.Root.Children[0].Children) != 1 || tree.Root.Children[0].Children[0].Key != "TEST-4" {
+					t.Errorf("expected sub-task under TEST-2, got %+v", tree.Root.Children[0].Children)
+				}
+			},
+		},
+		{
+			name: "issue limit",
+			tool: ToolJiraGetIssueTree,
+			args: map[string]interface{}{"issueKey": "TEST-1", "maxIssues": 2},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var tree domain.IssueTree
+				decodeResponse(t, resp, &tree)
+				if tree.IssueCount != 2 || !tree.Truncated {
+					t.Errorf("expected truncated tree of 2 issues, got %d (truncated %v)", tree.IssueCount, tree.Truncated)
+				}
+			},
+		},
+	})
+}
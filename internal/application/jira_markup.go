@@ -0,0 +1,108 @@
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// getInputFormatSchema returns the schema for the format of text written to Jira.
+func getInputFormatSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "string",
+		"enum":        []string{domain.TextFormatWiki, domain.TextFormatMarkdown},
+		"description": "Format of the text: wiki (Jira wiki markup) or markdown, converted to wiki markup (optional, default: wiki)",
+	}
+}
+
+// getOutputFormatSchema returns the schema for the format of text read from Jira.
+func getOutputFormatSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "string",
+		"enum":        []string{domain.TextFormatWiki, domain.TextFormatMarkdown, domain.TextFormatText, domain.TextFormatRendered},
+		"description": "Format of descriptions and comments: wiki (as stored), markdown, text (markup removed) or rendered (HTML from Jira) (optional, default: wiki)",
+	}
+}
+
+// getInputFormat extracts the optional format of text written to Jira.
+func getInputFormat(args map[string]interface{}) (string, error) {
+	format, err := getStringParam(args, "format", false)
+	if err != nil {
+		return "", err
+	}
+	switch format {
+	case "":
+		return domain.TextFormatWiki, nil
+	case domain.TextFormatWiki, domain.TextFormatMarkdown:
+		return format, nil
+	}
+	return "", &domain.Error{
+		Code:    domain.InvalidParams,
+		Message: fmt.Sprintf("invalid format '%s' (use wiki or markdown)", format),
+	}
+}
+
+// getOutputFormat extracts the optional format of text read from Jira.
+func getOutputFormat(args map[string]interface{}) (string, error) {
+	format, err := getStringParam(args, "format", false)
+	if err != nil {
+		return "", err
+	}
+	switch format {
+	case "":
+		return domain.TextFormatWiki, nil
+	case domain.TextFormatWiki, domain.TextFormatMarkdown, domain.TextFormatText, domain.TextFormatRendered:
+		return format, nil
+	}
+	return "", &domain.Error{
+		Code:    domain.InvalidParams,
+		Message: fmt.Sprintf("invalid format '%s' (use wiki, markdown, text or rendered)", format),
+	}
+}
+
+// toWikiMarkup converts text written in format to Jira wiki markup.
+func toWikiMarkup(text, format string) string {
+	if format == domain.TextFormatMarkdown {
+		return domain.MarkdownToWiki(text)
+	}
+	return text
+}
+
+// fromWikiMarkup converts wiki markup read from Jira to format. Rendered HTML is
+// requested from Jira separately, so the markup is left as is.
+func fromWikiMarkup(text, format string) string {
+	switch format {
+	case domain.TextFormatMarkdown:
+		return domain.WikiToMarkdown(text)
+	case domain.TextFormatText:
+		return domain.WikiToPlainText(text)
+	}
+	return text
+}
+
+// convertIssueText converts the description, environment and comments of an issue
+// from wiki markup to format.
+func convertIssueText(issue *domain.JiraIssue, format string) {
+	if format != domain.TextFormatMarkdown && format != domain.TextFormatText {
+		return
+	}
+	issue.Fields.Description = fromWikiMarkup(issue.Fields.Description, format)
+
+	var environment string
+	if raw, ok := issue.Fields.Extra["environment"]; ok && json.Unmarshal(raw, &environment) == nil {
+		if converted, err := json.Marshal(fromWikiMarkup(environment, format)); err == nil {
+			issue.Fields.Extra["environment"] = converted
+		}
+	}
+
+	var comments domain.CommentPage
+	if raw, ok := issue.Fields.Extra["comment"]; ok && json.Unmarshal(raw, &comments) == nil {
+		for i := range comments.Comments {
+			comments.Comments[i].Body = fromWikiMarkup(comments.Comments[i].Body, format)
+		}
+		if converted, err := json.Marshal(comments); err == nil {
+			issue.Fields.Extra["comment"] = converted
+		}
+	}
+}
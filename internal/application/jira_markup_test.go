@@ -0,0 +1,160 @@
+package application
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraMarkupRoutes serves TEST-123 with wiki markup in its description, environment
+// and comments, and accepts new issues, updates and comments.
+var jiraMarkupRoutes = mockJiraRoutes{
+	"GET /rest/api/2/issue/TEST-123": jiraReply(http.StatusOK, `{"id":"10001","key":"TEST-123","fields":{"summary":"Markup","description":"h2. Steps\n# Open *it*\n# Run {{make}}",
+		"environment":"_Linux_","comment":{"startAt":0,"maxResults":1,"total":1,"comments":[{"id":"100","body":"-done-"}]}},
+		"renderedFields":{"description":"<h2>Steps</h2>","environment":null,"summary":""}}`),
+	"GET /rest/api/2/issue/TEST-123/comment":  jiraReply(http.StatusOK, `{"startAt":0,"maxResults":50,"total":1,"comments":[{"id":"100","body":"See [docs|http://x]","renderedBody":"<p>See docs</p>"}]}`),
+	"POST /rest/api/2/issue":                  jiraReply(http.StatusCreated, `{"id":"10002","key":"TEST-124"}`),
+	"PUT /rest/api/2/issue/TEST-123":          jiraReply(http.StatusNoContent, ""),
+	"POST /rest/api/2/issue/TEST-123/comment": jiraReply(http.StatusCreated, ""),
+}
+
+// sentComment checks that the comment added to TEST-123 has body.
+func sentComment(body string) func(*testing.T, *mockJira, *domain.ToolResponse, error) {
+	return func(t *testing.T, m *mockJira, _ *domain.ToolResponse, _ error) {
+		t.Helper()
+		if got := m.body("POST /rest/api/2/issue/TEST-123/comment")["body"]; got != body {
+			t.Errorf("expected comment %q, got %q", body, got)
+		}
+	}
+}
+
+// firstQuery returns the query of the first request received by m.
+func firstQuery(t *testing.T, m *mockJira) url.Values {
+	t.Helper()
+	requests := m.received()
+	if len(requests) == 0 {
+		t.Fatal("expected a request")
+	}
+	return requests[0].URL.Query()
+}
+
+func TestJiraHandler_MarkdownInput(t *testing.T) {
+	runJiraToolCases(t, jiraMarkupRoutes, []jiraToolCase{
+		{
+			name: "create",
+			tool: ToolJiraCreateIssue,
+			args: map[string]interface{}{
+				"projectKey": "TEST", "summary": "New", "issueType": "Task", "description": "## Steps\n- **one**", "format": "markdown",
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if got := sentFields(t, m, "POST /rest/api/2/issue")["description"]; got != "h2. Steps\n* *one*" {
+					t.Errorf("unexpected created description: %q", got)
+				}
+			},
+		},
+		{
+			name: "update",
+			tool: ToolJiraUpdateIssue,
+			args: map[string]interface{}{"issueKey": "TEST-123", "description": "Use `make`", "format": "markdown"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if got := sentFields(t, m, "PUT /rest/api/2/issue/TEST-123")["description"]; got != "Use {{make}}" {
+					t.Errorf("unexpected updated description: %q", got)
+				}
+			},
+		},
+		{
+			name:  "markdown comment",
+			tool:  ToolJiraAddComment,
+			args:  map[string]interface{}{"issueKey": "TEST-123", "body": "*nice* work", "format": "markdown"},
+			check: sentComment("_nice_ work"),
+		},
+		{
+			name:  "wiki comment",
+			tool:  ToolJiraAddComment,
+			args:  map[string]interface{}{"issueKey": "TEST-123", "body": "*already wiki*"},
+			check: sentComment("*already wiki*"),
+		},
+		{
+			name:     "unknown format",
+			tool:     ToolJiraAddComment,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "body": "x", "format": "html"},
+			wantCode: domain.InvalidParams,
+		},
+	})
+}
+
+func TestJiraHandler_TextOutputFormats(t *testing.T) {
+	runJiraToolCases(t, jiraMarkupRoutes, []jiraToolCase{
+		{
+			name: "markdown issue",
+			tool: ToolJiraGetIssue,
+			args: map[string]interface{}{"issueKey": "TEST-123", "format": "markdown"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var issue domain.JiraIssue
+				decodeResponse(t, resp, &issue)
+				if issue.Fields.Description != "## Steps\n1. Open **it**\n2. Run `make`" {
+					t.Errorf("unexpected markdown description: %q", issue.Fields.Description)
+				}
+				var comments domain.CommentPage
+				json.Unmarshal(issue.Fields.Extra["comment"], &comments)
+				if string(issue.Fields.Extra["environment"]) != `"*Linux*"` || len(comments.Comments) != 1 || comments.Comments[0].Body != "~~done~~" {
+					t.Errorf("unexpected converted fields: %s %s", issue.Fields.Extra["environment"], issue.Fields.Extra["comment"])
+				}
+			},
+		},
+		{
+			name: "text issue",
+			tool: ToolJiraGetIssue,
+			args: map[string]interface{}{"issueKey": "TEST-123", "format": "text"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var issue domain.JiraIssue
+				decodeResponse(t, resp, &issue)
+				if issue.Fields.Description != "Steps\n1. Open it\n2. Run make" {
+					t.Errorf("unexpected text description: %q", issue.Fields.Description)
+				}
+			},
+		},
+		{
+			name: "rendered issue",
+			tool: ToolJiraGetIssue,
+			args: map[string]interface{}{"issueKey": "TEST-123", "format": "rendered"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if query := firstQuery(t, m); query.Get("expand") != "renderedFields" {
+					t.Errorf("expected renderedFields to be expanded, got %v", query)
+				}
+				var issue domain.JiraIssue
+				decodeResponse(t, resp, &issue)
+				var rendered string
+				json.Unmarshal(issue.RenderedFields["description"], &rendered)
+				if len(issue.RenderedFields) != 1 || rendered != "<h2>Steps</h2>" {
+					t.Errorf("unexpected rendered fields: %v", issue.RenderedFields)
+				}
+			},
+		},
+		{
+			name: "rendered comments",
+			tool: ToolJiraGetComments,
+			args: map[string]interface{}{"issueKey": "TEST-123", "format": "rendered"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if query := firstQuery(t, m); query.Get("expand") != "renderedBody" || !contains(resp.Content[0].Text, "See docs") {
+					t.Errorf("unexpected rendered comments: %v %s", query, resp.Content[0].Text)
+				}
+			},
+		},
+		{
+			name: "markdown comments",
+			tool: ToolJiraGetComments,
+			args: map[string]interface{}{"issueKey": "TEST-123", "format": "markdown"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var page domain.CommentPage
+				decodeResponse(t, resp, &page)
+				if len(page.Comments) != 1 || page.Comments[0].Body != "See [docs](http://x)" {
+					t.Errorf("unexpected markdown comments: %+v", page.Comments)
+				}
+			},
+		},
+	})
+}
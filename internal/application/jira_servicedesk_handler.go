@@ -0,0 +1,689 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"atlassian-mcp-server/internal/domain"
+	"atlassian-mcp-server/internal/infrastructure"
+)
+
+// jiraServiceDeskTools returns the definitions of the Jira Service Management tools.
+func jiraServiceDeskTools() []domain.ToolDefinition {
+	return []domain.ToolDefinition{
+		{
+			Name:        ToolJiraListServiceDesks,
+			Description: "List the Jira Service Management service desks you can access",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"start": map[string]interface{}{
+						"type":        "integer",
+						"description": "The index of the first item to return (0-based, optional)",
+					},
+					"limit": map[string]interface{}{
+						"type":        "integer",
+						"description": "The maximum number of items to return (optional, default: 50)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{},
+			},
+		},
+		{
+			Name:        ToolJiraListRequestTypes,
+			Description: "List the customer request types of a service desk",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"serviceDesk": map[string]interface{}{
+						"type":        "string",
+						"description": "The service desk ID or project key (e.g., HELP)",
+					},
+					"query": map[string]interface{}{
+						"type":        "string",
+						"description": "Only request types whose name contains this text (optional)",
+					},
+					"start": map[string]interface{}{
+						"type":        "integer",
+						"description": "The index of the first item to return (0-based, optional)",
+					},
+					"limit": map[string]interface{}{
+						"type":        "integer",
+						"description": "The maximum number of items to return (optional, default: 50)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"serviceDesk"},
+			},
+		},
+		{
+			Name:        ToolJiraGetRequestTypeFields,
+			Description: "List the fields of a request type with required flags and allowed values",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"serviceDesk": map[string]interface{}{
+						"type":        "string",
+						"description": "The service desk ID or project key (e.g., HELP)",
+					},
+					"requestType": map[string]interface{}{
+						"type":        "string",
+						"description": "The request type ID or name (e.g., Get IT help)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"serviceDesk", "requestType"},
+			},
+		},
+		{
+			Name:        ToolJiraCreateCustomerRequest,
+			Description: "Raise a customer request on a service desk. Use jira_get_request_type_fields to see which fields the request type needs",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"serviceDesk": map[string]interface{}{
+						"type":        "string",
+						"description": "The service desk ID or project key (e.g., HELP)",
+					},
+					"requestType": map[string]interface{}{
+						"type":        "string",
+						"description": "The request type ID or name (e.g., Get IT help)",
+					},
+					"summary": map[string]interface{}{
+						"type":        "string",
+						"description": "The request summary (optional if given in fields)",
+					},
+					"description": map[string]interface{}{
+						"type":        "string",
+						"description": "The request description (optional)",
+					},
+					"format": getInputFormatSchema(),
+					"fields": map[string]interface{}{
+						"type":        "object",
+						"description": "Request type field values keyed by field ID, e.g. {\"customfield_10010\": {\"id\": \"1\"}} (optional)",
+					},
+					"raiseOnBehalfOf": map[string]interface{}{
+						"type":        "string",
+						"description": "Raise the request for this customer (username or email, optional)",
+					},
+					"participants": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Usernames of customers to add as request participants (optional)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"serviceDesk", "requestType"},
+			},
+		},
+		{
+			Name:        ToolJiraAddRequestComment,
+			Description: "Comment on a customer request, either publicly (visible to the customer) or internally (agents only)",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The request issue key (e.g., HELP-123)",
+					},
+					"body": map[string]interface{}{
+						"type":        "string",
+						"description": "The comment text",
+					},
+					"public": map[string]interface{}{
+						"type":        "boolean",
+						"description": "true to reply to the customer, false for an internal note",
+					},
+					"format": getInputFormatSchema(),
+					"auth":   getAuthSchema(),
+				},
+				Required: []string{"issueKey", "body", "public"},
+			},
+		},
+		{
+			Name:        ToolJiraGetRequestComments,
+			Description: "List the comments on a customer request, marked public or internal",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The request issue key (e.g., HELP-123)",
+					},
+					"visibility": map[string]interface{}{
+						"type":        "string",
+						"description": "Which comments to return (optional, default: all)",
+						"enum":        []string{"all", "public", "internal"},
+					},
+					"format": getOutputFormatSchema(),
+					"start": map[string]interface{}{
+						"type":        "integer",
+						"description": "The index of the first item to return (0-based, optional)",
+					},
+					"limit": map[string]interface{}{
+						"type":        "integer",
+						"description": "The maximum number of items to return (optional, default: 50)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+		{
+			Name:        ToolJiraListQueues,
+			Description: "List the queues of a service desk with their JQL and issue counts",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"serviceDesk": map[string]interface{}{
+						"type":        "string",
+						"description": "The service desk ID or project key (e.g., HELP)",
+					},
+					"includeCount": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Include the number of issues in each queue (optional, default: true)",
+					},
+					"start": map[string]interface{}{
+						"type":        "integer",
+						"description": "The index of the first item to return (0-based, optional)",
+					},
+					"limit": map[string]interface{}{
+						"type":        "integer",
+						"description": "The maximum number of items to return (optional, default: 50)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"serviceDesk"},
+			},
+		},
+		{
+			Name:        ToolJiraGetQueueIssues,
+			Description: "List the issues in a service desk queue",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"serviceDesk": map[string]interface{}{
+						"type":        "string",
+						"description": "The service desk ID or project key (e.g., HELP)",
+					},
+					"queueId": map[string]interface{}{
+						"type":        "string",
+						"description": "The queue ID (see jira_list_queues)",
+					},
+					"start": map[string]interface{}{
+						"type":        "integer",
+						"description": "The index of the first item to return (0-based, optional)",
+					},
+					"limit": map[string]interface{}{
+						"type":        "integer",
+						"description": "The maximum number of items to return (optional, default: 50)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"serviceDesk", "queueId"},
+			},
+		},
+		{
+			Name:        ToolJiraGetRequestSLA,
+			Description: "Show the SLA metrics of a customer request: goals, elapsed and remaining time, and breaches",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The request issue key (e.g., HELP-123)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+	}
+}
+
+// handleListServiceDesks handles the jira_list_service_desks tool call.
+func (h *JiraHandler) handleListServiceDesks(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	start, limit, err := getServiceDeskPaging(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	desks, err := client.GetServiceDesks(start, limit)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(desks)
+}
+
+// getServiceDeskPaging extracts the optional start and limit arguments of the
+// Jira Service Management tools.
+func getServiceDeskPaging(args map[string]interface{}) (int, int, error) {
+	start, err := getIntParam(args, "start", false)
+	if err != nil {
+		return 0, 0, err
+	}
+	limit, err := getIntParam(args, "limit", false)
+	if err != nil {
+		return 0, 0, err
+	}
+	return start, limit, nil
+}
+
+// resolveServiceDeskID turns a service desk ID or project key into a service desk ID.
+func (h *JiraHandler) resolveServiceDeskID(client *infrastructure.JiraClient, idOrKey string) (string, error) {
+	if strings.Trim(idOrKey, "0123456789") == "" {
+		return idOrKey, nil
+	}
+
+	var desks []domain.ServiceDesk
+	for start := 0; ; {
+		page, err := client.GetServiceDesks(start, 100)
+		if err != nil {
+			return "", h.mapper.MapError(err)
+		}
+		desks = append(desks, page.Values...)
+		if page.IsLastPage || len(page.Values) == 0 {
+			break
+		}
+		start += len(page.Values)
+	}
+
+	desk, found := domain.FindServiceDesk(desks, idOrKey)
+	if !found {
+		return "", &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("no service desk found for project '%s'", idOrKey),
+		}
+	}
+	return string(desk.ID), nil
+}
+
+// resolveRequestTypeID turns a request type ID or name into a request type ID.
+func (h *JiraHandler) resolveRequestTypeID(client *infrastructure.JiraClient, serviceDeskID, idOrName string) (string, error) {
+	if strings.Trim(idOrName, "0123456789") == "" {
+		return idOrName, nil
+	}
+
+	types, err := client.GetRequestTypes(serviceDeskID, idOrName, 0, 100)
+	if err != nil {
+		return "", h.mapper.MapError(err)
+	}
+	requestType, found := domain.FindRequestType(types.Values, idOrName)
+	if !found {
+		names := make([]string, len(types.Values))
+		for i, candidate := range types.Values {
+			names[i] = candidate.Name
+		}
+		message := fmt.Sprintf("no request type named '%s' in service desk %s", idOrName, serviceDeskID)
+		if len(names) > 0 {
+			message += fmt.Sprintf(" (similar: %s)", strings.Join(names, ", "))
+		}
+		return "", &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: message,
+		}
+	}
+	return string(requestType.ID), nil
+}
+
+// handleListRequestTypes handles the jira_list_request_types tool call.
+func (h *JiraHandler) handleListRequestTypes(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	serviceDesk, err := getStringParam(args, "serviceDesk", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	query, _ := getStringParam(args, "query", false)
+	start, limit, err := getServiceDeskPaging(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	serviceDeskID, err := h.resolveServiceDeskID(client, serviceDesk)
+	if err != nil {
+		return nil, err
+	}
+	types, err := client.GetRequestTypes(serviceDeskID, query, start, limit)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(types)
+}
+
+// handleGetRequestTypeFields handles the jira_get_request_type_fields tool call.
+func (h *JiraHandler) handleGetRequestTypeFields(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	serviceDesk, err := getStringParam(args, "serviceDesk", true)
+	if err != nil {
+		return nil, err
+	}
+	requestType, err := getStringParam(args, "requestType", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	serviceDeskID, err := h.resolveServiceDeskID(client, serviceDesk)
+	if err != nil {
+		return nil, err
+	}
+	requestTypeID, err := h.resolveRequestTypeID(client, serviceDeskID, requestType)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := client.GetRequestTypeFields(serviceDeskID, requestTypeID)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(fields)
+}
+
+// handleCreateCustomerRequest handles the jira_create_customer_request tool call.
+func (h *JiraHandler) handleCreateCustomerRequest(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	serviceDesk, err := getStringParam(args, "serviceDesk", true)
+	if err != nil {
+		return nil, err
+	}
+	requestType, err := getStringParam(args, "requestType", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	summary, _ := getStringParam(args, "summary", false)
+	description, _ := getStringParam(args, "description", false)
+	raiseOnBehalfOf, _ := getStringParam(args, "raiseOnBehalfOf", false)
+	format, err := getInputFormat(args)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := getObjectParam(args, "fields", false)
+	if err != nil {
+		return nil, err
+	}
+	participants, err := getStringArrayParam(args, "participants", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect the field values
+	values := make(map[string]interface{}, len(fields)+2)
+	for id, value := range fields {
+		values[id] = value
+	}
+	if summary != "" {
+		values["summary"] = summary
+	}
+	if description != "" {
+		values["description"] = toWikiMarkup(description, format)
+	}
+
+	// Resolve the service desk and request type
+	serviceDeskID, err := h.resolveServiceDeskID(client, serviceDesk)
+	if err != nil {
+		return nil, err
+	}
+	requestTypeID, err := h.resolveRequestTypeID(client, serviceDeskID, requestType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Report missing required fields before attempting the create (best effort)
+	if typeFields, err := client.GetRequestTypeFields(serviceDeskID, requestTypeID); err == nil {
+		if missing := typeFields.MissingRequiredFields(values); len(missing) > 0 {
+			return nil, &domain.Error{
+				Code:    domain.InvalidParams,
+				Message: fmt.Sprintf("missing required fields: %s", strings.Join(missing, ", ")),
+			}
+		}
+	}
+
+	// Call the Jira client
+	request, err := client.CreateCustomerRequest(&domain.CustomerRequestCreate{
+		ServiceDeskID:       serviceDeskID,
+		RequestTypeID:       requestTypeID,
+		RequestFieldValues:  values,
+		RaiseOnBehalfOf:     raiseOnBehalfOf,
+		RequestParticipants: participants,
+	})
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(request)
+}
+
+// handleAddRequestComment handles the jira_add_request_comment tool call.
+func (h *JiraHandler) handleAddRequestComment(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+	body, err := getStringParam(args, "body", true)
+	if err != nil {
+		return nil, err
+	}
+	public, err := getBoolParam(args, "public", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	format, err := getInputFormat(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	comment, err := client.AddRequestComment(issueKey, toWikiMarkup(body, format), public)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(comment)
+}
+
+// handleGetRequestComments handles the jira_get_request_comments tool call.
+func (h *JiraHandler) handleGetRequestComments(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	visibility, _ := getStringParam(args, "visibility", false)
+	options := &infrastructure.RequestCommentOptions{Public: true, Internal: true}
+	switch visibility {
+	case "", "all":
+	case "public":
+		options.Internal = false
+	case "internal":
+		options.Public = false
+	default:
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("invalid visibility '%s' (use all, public or internal)", visibility),
+		}
+	}
+	format, err := getOutputFormat(args)
+	if err != nil {
+		return nil, err
+	}
+	if format == domain.TextFormatRendered {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "format 'rendered' is not supported for request comments (use wiki, markdown or text)",
+		}
+	}
+	if options.Start, options.Limit, err = getServiceDeskPaging(args); err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	comments, err := client.GetRequestComments(issueKey, options)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+	for i := range comments.Values {
+		comments.Values[i].Body = fromWikiMarkup(comments.Values[i].Body, format)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(comments)
+}
+
+// handleListQueues handles the jira_list_queues tool call.
+func (h *JiraHandler) handleListQueues(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	serviceDesk, err := getStringParam(args, "serviceDesk", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	includeCount := true
+	if _, ok := args["includeCount"]; ok {
+		if includeCount, err = getBoolParam(args, "includeCount", false); err != nil {
+			return nil, err
+		}
+	}
+	start, limit, err := getServiceDeskPaging(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	serviceDeskID, err := h.resolveServiceDeskID(client, serviceDesk)
+	if err != nil {
+		return nil, err
+	}
+	queues, err := client.GetQueues(serviceDeskID, includeCount, start, limit)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(queues)
+}
+
+// handleGetQueueIssues handles the jira_get_queue_issues tool call.
+func (h *JiraHandler) handleGetQueueIssues(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	serviceDesk, err := getStringParam(args, "serviceDesk", true)
+	if err != nil {
+		return nil, err
+	}
+	queueID, err := getStringParam(args, "queueId", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	start, limit, err := getServiceDeskPaging(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	serviceDeskID, err := h.resolveServiceDeskID(client, serviceDesk)
+	if err != nil {
+		return nil, err
+	}
+	issues, err := client.GetQueueIssues(serviceDeskID, queueID, start, limit)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(issues)
+}
+
+// handleGetRequestSLA handles the jira_get_request_sla tool call.
+func (h *JiraHandler) handleGetRequestSLA(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	slas, err := client.GetRequestSLA(issueKey)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(slas)
+}
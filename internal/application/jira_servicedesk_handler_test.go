@@ -0,0 +1,157 @@
+package application
+
+import (
+	"net/http"
+	"testing"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraServiceDeskRoutes serves the HELP (1) and IT (2) service desks, the IT request
+// types, queue 5 and request IT-1 with its comments and SLA.
+var jiraServiceDeskRoutes = mockJiraRoutes{
+	"/rest/servicedeskapi/servicedesk":                        jiraReply(http.StatusOK, `{"size":2,"start":0,"limit":100,"isLastPage":true,"values":[{"id":"1","projectKey":"HELP"},{"id":"2","projectKey":"IT"}]}`),
+	"/rest/servicedeskapi/servicedesk/2/requesttype":          jiraReply(http.StatusOK, `{"size":2,"isLastPage":true,"values":[{"id":"10","name":"Get IT help","serviceDeskId":"2"},{"id":"11","name":"Get IT help urgently","serviceDeskId":"2"}]}`),
+	"/rest/servicedeskapi/servicedesk/2/requesttype/10/field": jiraReply(http.StatusOK, `{"requestTypeFields":[{"fieldId":"summary","name":"Summary","required":true},{"fieldId":"customfield_10010","name":"Impact","required":true}]}`),
+	"POST /rest/servicedeskapi/request":                       jiraReply(http.StatusCreated, `{"issueId":"20000","issueKey":"IT-1","requestTypeId":"10","serviceDeskId":"2"}`),
+	"POST /rest/servicedeskapi/request/IT-1/comment":          jiraReply(http.StatusCreated, `{"id":"300","body":"x","public":false}`),
+	"/rest/servicedeskapi/request/IT-1/comment":               jiraReply(http.StatusOK, `{"size":1,"isLastPage":true,"values":[{"id":"300","body":"*Fixed*","public":true}]}`),
+	"/rest/servicedeskapi/servicedesk/2/queue":                jiraReply(http.StatusOK, `{"size":1,"isLastPage":true,"values":[{"id":"5","name":"Open","jql":"resolution = EMPTY","issueCount":3}]}`),
+	"/rest/servicedeskapi/servicedesk/2/queue/5/issue":        jiraReply(http.StatusOK, `{"size":1,"isLastPage":true,"values":[{"key":"IT-1","fields":{"summary":"Broken"}}]}`),
+	"/rest/servicedeskapi/request/IT-1/sla":                   jiraReply(http.StatusOK, `{"values":[{"id":"1","name":"Time to resolution","completedCycles":[{"breached":true}]}]}`),
+}
+
+// lastRequest returns the last request received by m as logged by mockJira.log.
+func lastRequest(m *mockJira) string {
+	log := m.log()
+	if len(log) == 0 {
+		return ""
+	}
+	return log[len(log)-1]
+}
+
+func TestJiraHandler_CreateCustomerRequest(t *testing.T) {
+	runJiraToolCases(t, jiraServiceDeskRoutes, []jiraToolCase{
+		{
+			name: "desk and type by name",
+			tool: ToolJiraCreateCustomerRequest,
+			args: map[string]interface{}{
+				"serviceDesk":  "it",
+				"requestType":  "get it help",
+				"summary":      "Laptop broken",
+				"description":  "**Urgent**",
+				"format":       "markdown",
+				"fields":       map[string]interface{}{"customfield_10010": map[string]interface{}{"id": "1"}},
+				"participants": []interface{}{"jdoe"},
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var request domain.CustomerRequest
+				decodeResponse(t, resp, &request)
+				body := m.body("POST /rest/servicedeskapi/request")
+				if request.IssueKey != "IT-1" || body == nil {
+					t.Fatalf("unexpected request: %+v, body %v", request, body)
+				}
+				values, _ := body["requestFieldValues"].(map[string]interface{})
+				participants, _ := body["requestParticipants"].([]interface{})
+				if body["serviceDeskId"] != "2" || body["requestTypeId"] != "10" || values["summary"] != "Laptop broken" ||
+					values["description"] != "*Urgent*" || values["customfield_10010"] == nil || len(participants) != 1 {
+					t.Errorf("unexpected body: %v", body)
+				}
+			},
+		},
+		{
+			name:     "missing required field",
+			tool:     ToolJiraCreateCustomerRequest,
+			args:     map[string]interface{}{"serviceDesk": "2", "requestType": "10", "summary": "Laptop broken"},
+			wantCode: domain.InvalidParams,
+			wantMsg:  "Impact (customfield_10010)",
+			check:    noWrites,
+		},
+		{
+			name:     "unknown service desk",
+			tool:     ToolJiraCreateCustomerRequest,
+			args:     map[string]interface{}{"serviceDesk": "HR", "requestType": "10"},
+			wantCode: domain.InvalidParams,
+		},
+		{
+			name:     "unknown request type",
+			tool:     ToolJiraCreateCustomerRequest,
+			args:     map[string]interface{}{"serviceDesk": "IT", "requestType": "Order a chair"},
+			wantCode: domain.InvalidParams,
+		},
+	})
+}
+
+func TestJiraHandler_RequestComments(t *testing.T) {
+	runJiraToolCases(t, jiraServiceDeskRoutes, []jiraToolCase{
+		{
+			name: "add internal",
+			tool: ToolJiraAddRequestComment,
+			args: map[string]interface{}{"issueKey": "IT-1", "body": "Checked the logs", "public": false},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if bodies := m.bodies(); len(bodies) != 1 || bodies[0]["public"] != false || bodies[0]["body"] != "Checked the logs" {
+					t.Errorf("unexpected comment body: %v", bodies)
+				}
+			},
+		},
+		{
+			name:     "add without visibility",
+			tool:     ToolJiraAddRequestComment,
+			args:     map[string]interface{}{"issueKey": "IT-1", "body": "Hello"},
+			wantCode: domain.InvalidParams,
+		},
+		{
+			name: "public as markdown",
+			tool: ToolJiraGetRequestComments,
+			args: map[string]interface{}{"issueKey": "IT-1", "visibility": "public", "format": "markdown"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var comments domain.RequestCommentList
+				decodeResponse(t, resp, &comments)
+				if log := m.log(); !contains(log[0], "internal=false&public=true") || len(comments.Values) != 1 || comments.Values[0].Body != "**Fixed**" {
+					t.Errorf("unexpected comments: %v %+v", log, comments)
+				}
+			},
+		},
+	})
+}
+
+func TestJiraHandler_QueuesAndSLA(t *testing.T) {
+	runJiraToolCases(t, jiraServiceDeskRoutes, []jiraToolCase{
+		{
+			name: "queues with counts",
+			tool: ToolJiraListQueues,
+			args: map[string]interface{}{"serviceDesk": "IT"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var queues domain.QueueList
+				decodeResponse(t, resp, &queues)
+				if len(queues.Values) != 1 || *queues.Values[0].IssueCount != 3 || !contains(lastRequest(m), "includeCount=true") {
+					t.Errorf("unexpected queues: %+v %v", queues, m.log())
+				}
+			},
+		},
+		{
+			name: "queue issues",
+			tool: ToolJiraGetQueueIssues,
+			args: map[string]interface{}{"serviceDesk": "2", "queueId": "5", "limit": float64(10)},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var issues domain.QueueIssueList
+				decodeResponse(t, resp, &issues)
+				if len(issues.Values) != 1 || issues.Values[0].Key != "IT-1" || !contains(lastRequest(m), "limit=10") {
+					t.Errorf("unexpected issues: %+v %v", issues, m.log())
+				}
+			},
+		},
+		{
+			name: "SLA",
+			tool: ToolJiraGetRequestSLA,
+			args: map[string]interface{}{"issueKey": "IT-1"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var slas domain.SLAList
+				decodeResponse(t, resp, &slas)
+				if len(slas.Values) != 1 || !slas.Values[0].CompletedCycles[0].Breached {
+					t.Errorf("unexpected SLAs: %+v", slas)
+				}
+			},
+		},
+	})
+}
@@ -0,0 +1,134 @@
+package application
+
+import (
+	"context"
+
+	"atlassian-mcp-server/internal/domain"
+	"atlassian-mcp-server/internal/infrastructure"
+)
+
+// jiraTransitionTools returns the definitions of the Jira transition discovery tools.
+func jiraTransitionTools() []domain.ToolDefinition {
+	return []domain.ToolDefinition{
+		{
+			Name:        ToolJiraGetTransitions,
+			Description: "List the workflow transitions available on a Jira issue with their target status and screen fields",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+	}
+}
+
+// resolveTransition resolves target (a transition ID, name or target status) against
+// the transitions available on an issue, sets the transition ID and reports required
+// screen fields that were not set.
+func resolveTransition(available []domain.Transition, issueKey, target string, transition *domain.IssueTransition) error {
+	resolved, err := domain.ResolveTransition(available, target)
+	if err != nil {
+		return &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: err.Error(),
+		}
+	}
+	transition.Transition = domain.TransitionRef{ID: string(resolved.ID)}
+
+	// Report required screen fields that were not set
+	provided := make(map[string]bool)
+	for id := range transition.Fields {
+		provided[id] = true
+	}
+	for id := range transition.Update {
+		provided[id] = true
+	}
+	if missing := domain.MissingRequiredFields(resolved.ScreenFields(), provided); len(missing) > 0 {
+		return &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: domain.FormatMissingTransitionFields(resolved, issueKey, missing),
+			Data: map[string]interface{}{
+				"missingFields": missing,
+			},
+		}
+	}
+	return nil
+}
+
+// buildTransitionFields adds the resolution, fix versions, comment and other fields
+// given in the arguments to a transition request.
+func (h *JiraHandler) buildTransitionFields(client *infrastructure.JiraClient, args map[string]interface{}, transition *domain.IssueTransition) error {
+	fields, err := h.resolveFieldValues(client, args)
+	if err != nil {
+		return err
+	}
+	if fields == nil {
+		fields = make(map[string]interface{})
+	}
+
+	resolution, err := getStringParam(args, "resolution", false)
+	if err != nil {
+		return err
+	}
+	if resolution != "" {
+		fields["resolution"] = map[string]interface{}{"name": resolution}
+	}
+
+	fixVersions, err := getStringArrayParam(args, "fixVersions", false)
+	if err != nil {
+		return err
+	}
+	if len(fixVersions) > 0 {
+		versions := make([]interface{}, len(fixVersions))
+		for i, name := range fixVersions {
+			versions[i] = map[string]interface{}{"name": name}
+		}
+		fields["fixVersions"] = versions
+	}
+
+	if len(fields) > 0 {
+		transition.Fields = fields
+	}
+
+	comment, err := getStringParam(args, "comment", false)
+	if err != nil {
+		return err
+	}
+	if comment != "" {
+		transition.Update = map[string][]map[string]interface{}{
+			"comment": {{"add": map[string]interface{}{"body": comment}}},
+		}
+	}
+
+	return nil
+}
+
+// handleGetTransitions handles the jira_get_transitions tool call.
+func (h *JiraHandler) handleGetTransitions(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	transitions, err := client.GetTransitions(issueKey)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(transitions)
+}
@@ -0,0 +1,113 @@
+package application
+
+import (
+	"net/http"
+	"testing"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraTransitionRoutes serves a TEST-123 issue that can be started or resolved;
+// resolving requires a resolution.
+var jiraTransitionRoutes = mockJiraRoutes{
+	"GET /rest/api/2/issue/TEST-123/transitions": jiraReply(http.StatusOK, `{"transitions":[
+		{"id":"11","name":"Start Progress","to":{"id":"3","name":"In Progress"},"hasScreen":false},
+		{"id":"21","name":"Resolve","to":{"id":"5","name":"Done"},"hasScreen":true,"fields":{
+			"resolution":{"required":true,"hasDefaultValue":false,"name":"Resolution","schema":{"type":"resolution","system":"resolution"},
+				"allowedValues":[{"id":"1","name":"Fixed"},{"id":"2","name":"Won't Fix"}]},
+			"fixVersions":{"required":false,"hasDefaultValue":false,"name":"Fix Version/s","schema":{"type":"array","items":"version","system":"fixVersions"}}
+		}}
+	]}`),
+	"POST /rest/api/2/issue/TEST-123/transitions": jiraReply(http.StatusNoContent, ""),
+}
+
+// noTransition checks that no transition was performed.
+func noTransition(t *testing.T, m *mockJira, _ *domain.ToolResponse, _ error) {
+	t.Helper()
+	if m.calls("POST /rest/api/2/issue/TEST-123/transitions") != 0 {
+		t.Error("expected no transition to be performed")
+	}
+}
+
+func TestJiraHandler_Transitions(t *testing.T) {
+	runJiraToolCases(t, jiraTransitionRoutes, []jiraToolCase{
+		{
+			name: "list transitions",
+			tool: ToolJiraGetTransitions,
+			args: map[string]interface{}{"issueKey": "TEST-123"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var transitions []domain.Transition
+				decodeResponse(t, resp, &transitions)
+				if len(transitions) != 2 || transitions[1].To.Name != "Done" || !transitions[1].Fields["resolution"].Required {
+					t.Errorf("unexpected transitions: %+v", transitions)
+				}
+			},
+		},
+		{
+			name: "to status with fields",
+			tool: ToolJiraTransition,
+			args: map[string]interface{}{
+				"issueKey":    "TEST-123",
+				"toStatus":    "done",
+				"resolution":  "Fixed",
+				"fixVersions": []interface{}{"1.0", "1.1"},
+				"comment":     "Released",
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				body := m.body("POST /rest/api/2/issue/TEST-123/transitions")
+				transition := body["transition"].(map[string]interface{})
+				if transition["id"] != "21" || transition["name"] != nil {
+					t.Errorf("expected transition ID 21, got %v", transition)
+				}
+				fields := body["fields"].(map[string]interface{})
+				if fields["resolution"].(map[string]interface{})["name"] != "Fixed" {
+					t.Errorf("expected resolution, got %v", fields)
+				}
+				if versions := fields["fixVersions"].([]interface{}); len(versions) != 2 {
+					t.Errorf("expected two fix versions, got %v", versions)
+				}
+				if _, ok := body["update"].(map[string]interface{})["comment"]; !ok {
+					t.Errorf("expected comment update, got %v", body)
+				}
+			},
+		},
+		{
+			name: "resolves transition name",
+			tool: ToolJiraTransition,
+			args: map[string]interface{}{"issueKey": "TEST-123", "transitionName": "Start Progress"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				body := m.body("POST /rest/api/2/issue/TEST-123/transitions")
+				if body["transition"].(map[string]interface{})["id"] != "11" {
+					t.Errorf("expected transition ID 11, got %v", body["transition"])
+				}
+				if _, ok := body["fields"]; ok {
+					t.Errorf("expected no fields, got %v", body["fields"])
+				}
+			},
+		},
+		{
+			name:     "missing required screen field",
+			tool:     ToolJiraTransition,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "toStatus": "Done"},
+			wantCode: domain.InvalidParams,
+			wantMsg:  "Resolution (resolution): one of Fixed, Won't Fix",
+			check:    noTransition,
+		},
+		{
+			name:     "unavailable status",
+			tool:     ToolJiraTransition,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "toStatus": "Closed"},
+			wantCode: domain.InvalidParams,
+			wantMsg:  "available: Start Progress [11] -> In Progress, Resolve [21] -> Done",
+			check:    noTransition,
+		},
+		{
+			name:     "invalid fix versions",
+			tool:     ToolJiraTransition,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "toStatus": "Done", "fixVersions": 1.0},
+			wantCode: domain.InvalidParams,
+			wantMsg:  "fixVersions must be an array of strings",
+			check:    noTransition,
+		},
+	})
+}
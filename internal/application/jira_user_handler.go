@@ -0,0 +1,246 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"atlassian-mcp-server/internal/domain"
+	"atlassian-mcp-server/internal/infrastructure"
+)
+
+// jiraUserTools returns the definitions of the Jira user and group tools.
+func jiraUserTools() []domain.ToolDefinition {
+	return []domain.ToolDefinition{
+		{
+			Name:        ToolJiraSearchUsers,
+			Description: "Search Jira users by username, display name or email address",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"query": map[string]interface{}{
+						"type":        "string",
+						"description": "Text to match against username, display name or email",
+					},
+					"maxResults": map[string]interface{}{
+						"type":        "number",
+						"description": "Maximum number of users to return (optional, default: 50)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"query"},
+			},
+		},
+		{
+			Name:        ToolJiraGetAssignableUsers,
+			Description: "List the users that can be assigned issues of a project or a specific issue",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"projectKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The project key (required unless issueKey is given)",
+					},
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (optional, takes precedence over projectKey)",
+					},
+					"query": map[string]interface{}{
+						"type":        "string",
+						"description": "Only users whose username, display name or email matches (optional)",
+					},
+					"maxResults": map[string]interface{}{
+						"type":        "number",
+						"description": "Maximum number of users to return (optional, default: 50)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{},
+			},
+		},
+		{
+			Name:        ToolJiraGetGroupMembers,
+			Description: "List the members of a Jira group",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"group": map[string]interface{}{
+						"type":        "string",
+						"description": "The group name (e.g., jira-developers)",
+					},
+					"includeInactive": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Include inactive users (optional, default: false)",
+					},
+					"startAt": map[string]interface{}{
+						"type":        "number",
+						"description": "Index of the first member to return (optional, default: 0)",
+					},
+					"maxResults": map[string]interface{}{
+						"type":        "number",
+						"description": "Maximum number of members to return (optional, default: 50)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"group"},
+			},
+		},
+	}
+}
+
+// handleSearchUsers handles the jira_search_users tool call.
+func (h *JiraHandler) handleSearchUsers(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	query, err := getStringParam(args, "query", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	maxResults, err := getIntParam(args, "maxResults", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	users, err := client.SearchUsers(query, maxResults)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(users)
+}
+
+// handleGetAssignableUsers handles the jira_get_assignable_users tool call.
+func (h *JiraHandler) handleGetAssignableUsers(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	projectKey, _ := getStringParam(args, "projectKey", false)
+	issueKey, _ := getStringParam(args, "issueKey", false)
+	if projectKey == "" && issueKey == "" {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "either projectKey or issueKey is required",
+		}
+	}
+
+	// Optional parameters
+	query, _ := getStringParam(args, "query", false)
+	maxResults, err := getIntParam(args, "maxResults", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	users, err := client.GetAssignableUsers(&infrastructure.AssignableUserOptions{
+		ProjectKey: projectKey,
+		IssueKey:   issueKey,
+		Query:      query,
+		MaxResults: maxResults,
+	})
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(users)
+}
+
+// automaticAssignee asks Jira to pick the assignee (the project or component default).
+const automaticAssignee = "-1"
+
+// resolveAssignee maps a username, email address or display name to the username
+// of a user assignable to the issue (or to issues of the project when issueKey is
+// empty). Resolution is best effort: if the user search itself fails the value is
+// passed through for Jira to validate, but no match or several matches are reported.
+func (h *JiraHandler) resolveAssignee(client *infrastructure.JiraClient, assignee, projectKey, issueKey string) (string, error) {
+	if assignee == automaticAssignee {
+		return assignee, nil
+	}
+
+	candidates, err := client.GetAssignableUsers(&infrastructure.AssignableUserOptions{
+		ProjectKey: projectKey,
+		IssueKey:   issueKey,
+		Query:      assignee,
+		MaxResults: 50,
+	})
+	if err != nil {
+		return assignee, nil
+	}
+
+	user, err := domain.ResolveUser(candidates, assignee)
+	if err != nil {
+		return "", &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("cannot resolve assignee: %v", err),
+		}
+	}
+	return user.Name, nil
+}
+
+// resolveReporter turns a username, email address or display name into the username
+// of a reporter. If the user search fails the value is used unchanged.
+func (h *JiraHandler) resolveReporter(client *infrastructure.JiraClient, reporter string) (string, error) {
+	candidates, err := client.SearchUsers(reporter, 50)
+	if err != nil {
+		return reporter, nil
+	}
+
+	user, err := domain.ResolveUser(candidates, reporter)
+	if err != nil {
+		return "", &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("cannot resolve reporter: %v", err),
+		}
+	}
+	return user.Name, nil
+}
+
+// handleGetGroupMembers handles the jira_get_group_members tool call.
+func (h *JiraHandler) handleGetGroupMembers(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	group, err := getStringParam(args, "group", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	includeInactive, err := getBoolParam(args, "includeInactive", false)
+	if err != nil {
+		return nil, err
+	}
+	startAt, err := getIntParam(args, "startAt", false)
+	if err != nil {
+		return nil, err
+	}
+	maxResults, err := getIntParam(args, "maxResults", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	members, err := client.GetGroupMembers(group, includeInactive, startAt, maxResults)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(members)
+}
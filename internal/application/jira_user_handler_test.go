@@ -0,0 +1,110 @@
+package application
+
+import (
+	"net/http"
+	"testing"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraUserRoutes serves users John Doe (jdoe, john@example.com) and John Smith
+// (jsmith), both assignable, and accepts issue creation and updates without
+// create metadata.
+var jiraUserRoutes = mockJiraRoutes{
+	"GET /rest/api/2/user/search": jiraReply(http.StatusOK, `[{"name":"jdoe","displayName":"John Doe","emailAddress":"john@example.com"}]`),
+	"GET /rest/api/2/user/assignable/search": func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("username") {
+		case "john@example.com":
+			w.Write([]byte(`[{"name":"jdoe","displayName":"John Doe","emailAddress":"john@example.com"}]`))
+		case "John":
+			w.Write([]byte(`[{"name":"jdoe","displayName":"John Doe"},{"name":"jsmith","displayName":"John Smith"}]`))
+		default:
+			w.Write([]byte(`[]`))
+		}
+	},
+	"GET /rest/api/2/group/member":   jiraReply(http.StatusOK, `{"startAt":0,"maxResults":50,"total":1,"isLast":true,"values":[{"name":"jdoe"}]}`),
+	"POST /rest/api/2/issue":         jiraReply(http.StatusCreated, `{"id":"10002","key":"TEST-124"}`),
+	"PUT /rest/api/2/issue/TEST-123": jiraReply(http.StatusNoContent, ""),
+}
+
+func TestJiraHandler_Users(t *testing.T) {
+	runJiraToolCases(t, jiraUserRoutes, []jiraToolCase{
+		{
+			name: "search",
+			tool: ToolJiraSearchUsers,
+			args: map[string]interface{}{"query": "john"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var users []domain.User
+				decodeResponse(t, resp, &users)
+				if len(users) != 1 || users[0].EmailAddress != "john@example.com" {
+					t.Errorf("unexpected users: %+v", users)
+				}
+			},
+		},
+		{
+			name:     "assignable without project or issue",
+			tool:     ToolJiraGetAssignableUsers,
+			args:     map[string]interface{}{"query": "john"},
+			wantCode: domain.InvalidParams,
+		},
+		{
+			name:  "assignable in project",
+			tool:  ToolJiraGetAssignableUsers,
+			args:  map[string]interface{}{"projectKey": "TEST", "query": "John"},
+			check: requested("GET /rest/api/2/user/assignable/search?project=TEST&username=John"),
+		},
+		{
+			name: "group members",
+			tool: ToolJiraGetGroupMembers,
+			args: map[string]interface{}{"group": "jira-developers"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var members domain.GroupMembers
+				decodeResponse(t, resp, &members)
+				if members.Group != "jira-developers" || len(members.Values) != 1 {
+					t.Errorf("unexpected members: %+v", members)
+				}
+			},
+		},
+	})
+}
+
+func TestJiraHandler_ResolveAssignee(t *testing.T) {
+	runJiraToolCases(t, jiraUserRoutes, []jiraToolCase{
+		{
+			name: "create with email address",
+			tool: ToolJiraCreateIssue,
+			args: map[string]interface{}{
+				"projectKey": "TEST",
+				"summary":    "New issue",
+				"issueType":  "Bug",
+				"assignee":   "john@example.com",
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				fields := sentFields(t, m, "POST /rest/api/2/issue")
+				if assignee, _ := fields["assignee"].(map[string]interface{}); assignee["name"] != "jdoe" {
+					t.Errorf("expected assignee jdoe, got %v", fields["assignee"])
+				}
+			},
+		},
+		{
+			name:     "ambiguous display name",
+			tool:     ToolJiraUpdateIssue,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "assignee": "John"},
+			wantCode: domain.InvalidParams,
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if !contains(err.Error(), "John Doe (jdoe)") || !contains(err.Error(), "John Smith (jsmith)") {
+					t.Errorf("expected candidates in message, got %s", err)
+				}
+				noWrites(t, m, resp, err)
+			},
+		},
+		{
+			name:     "unknown user",
+			tool:     ToolJiraUpdateIssue,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "assignee": "nobody"},
+			wantCode: domain.InvalidParams,
+			wantMsg:  "no user matches 'nobody'",
+			check:    noWrites,
+		},
+	})
+}
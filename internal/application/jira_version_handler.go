@@ -0,0 +1,759 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"atlassian-mcp-server/internal/domain"
+	"atlassian-mcp-server/internal/infrastructure"
+)
+
+// jiraVersionTools returns the definitions of the Jira project, version and component tools.
+func jiraVersionTools() []domain.ToolDefinition {
+	return []domain.ToolDefinition{
+		{
+			Name:        ToolJiraGetProject,
+			Description: "Get a Jira project with its versions and components",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"projectKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The project key (e.g., TEST)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"projectKey"},
+			},
+		},
+		{
+			Name:        ToolJiraListVersions,
+			Description: "List the versions of a Jira project",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"projectKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The project key (e.g., TEST)",
+					},
+					"unreleasedOnly": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Only list versions that have not been released (optional)",
+					},
+					"includeArchived": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Include archived versions (optional, default false)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"projectKey"},
+			},
+		},
+		{
+			Name:        ToolJiraCreateVersion,
+			Description: "Create a version (release) in a Jira project",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"projectKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The project key (e.g., TEST)",
+					},
+					"name": map[string]interface{}{
+						"type":        "string",
+						"description": "The version name (e.g., 2.1.0)",
+					},
+					"description": map[string]interface{}{
+						"type":        "string",
+						"description": "The version description (optional)",
+					},
+					"startDate": map[string]interface{}{
+						"type":        "string",
+						"description": "Start date (YYYY-MM-DD, optional)",
+					},
+					"releaseDate": map[string]interface{}{
+						"type":        "string",
+						"description": "Release date (YYYY-MM-DD, optional)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"projectKey", "name"},
+			},
+		},
+		{
+			Name:        ToolJiraUpdateVersion,
+			Description: "Rename a version or change its description or dates",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"versionId": map[string]interface{}{
+						"type":        "string",
+						"description": "The version ID",
+					},
+					"name": map[string]interface{}{
+						"type":        "string",
+						"description": "The new version name (optional)",
+					},
+					"description": map[string]interface{}{
+						"type":        "string",
+						"description": "The new description (optional)",
+					},
+					"startDate": map[string]interface{}{
+						"type":        "string",
+						"description": "Start date (YYYY-MM-DD, optional)",
+					},
+					"releaseDate": map[string]interface{}{
+						"type":        "string",
+						"description": "Release date (YYYY-MM-DD, optional)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"versionId"},
+			},
+		},
+		{
+			Name:        ToolJiraReleaseVersion,
+			Description: "Mark a version as released",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"versionId": map[string]interface{}{
+						"type":        "string",
+						"description": "The version ID",
+					},
+					"releaseDate": map[string]interface{}{
+						"type":        "string",
+						"description": "Release date (YYYY-MM-DD, defaults to today)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"versionId"},
+			},
+		},
+		{
+			Name:        ToolJiraArchiveVersion,
+			Description: "Archive a version, or restore an archived one",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"versionId": map[string]interface{}{
+						"type":        "string",
+						"description": "The version ID",
+					},
+					"archived": map[string]interface{}{
+						"type":        "boolean",
+						"description": "False to restore the version (optional, default true)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"versionId"},
+			},
+		},
+		{
+			Name:        ToolJiraGetVersionIssueCounts,
+			Description: "Count the issues fixed in, affecting and still unresolved for a version",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"versionId": map[string]interface{}{
+						"type":        "string",
+						"description": "The version ID",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"versionId"},
+			},
+		},
+		{
+			Name:        ToolJiraListComponents,
+			Description: "List the components of a Jira project",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"projectKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The project key (e.g., TEST)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"projectKey"},
+			},
+		},
+		{
+			Name:        ToolJiraCreateComponent,
+			Description: "Create a component in a Jira project",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"projectKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The project key (e.g., TEST)",
+					},
+					"name": map[string]interface{}{
+						"type":        "string",
+						"description": "The component name",
+					},
+					"description": map[string]interface{}{
+						"type":        "string",
+						"description": "The component description (optional)",
+					},
+					"lead": map[string]interface{}{
+						"type":        "string",
+						"description": "Username of the component lead (optional)",
+					},
+					"assigneeType": map[string]interface{}{
+						"type":        "string",
+						"description": "Default assignee of new issues with this component (optional)",
+						"enum":        []string{"PROJECT_DEFAULT", "COMPONENT_LEAD", "PROJECT_LEAD", "UNASSIGNED"},
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"projectKey", "name"},
+			},
+		},
+		{
+			Name:        ToolJiraSetFixVersions,
+			Description: "Add, remove or replace the fix versions of all issues matching a JQL query",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"jql": map[string]interface{}{
+						"type":        "string",
+						"description": "JQL query selecting the issues",
+					},
+					"versions": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Version names or IDs; each must exist in the project of every issue",
+					},
+					"mode": map[string]interface{}{
+						"type":        "string",
+						"description": "add (default) keeps existing fix versions, remove takes the versions off, set replaces them",
+						"enum":        []string{"add", "remove", "set"},
+					},
+					"maxIssues": map[string]interface{}{
+						"type":        "integer",
+						"description": "Maximum number of issues to change (default 100, max 1000)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"jql", "versions"},
+			},
+		},
+	}
+}
+
+// handleGetProject handles the jira_get_project tool call.
+func (h *JiraHandler) handleGetProject(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	projectKey, err := getStringParam(args, "projectKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	project, err := client.GetProject(projectKey)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(project)
+}
+
+// handleListVersions handles the jira_list_versions tool call.
+func (h *JiraHandler) handleListVersions(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	projectKey, err := getStringParam(args, "projectKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	unreleasedOnly, err := getBoolParam(args, "unreleasedOnly", false)
+	if err != nil {
+		return nil, err
+	}
+	includeArchived, err := getBoolParam(args, "includeArchived", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	versions, err := client.GetProjectVersions(projectKey)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Filter the versions
+	filtered := make([]domain.JiraVersion, 0, len(versions))
+	for _, version := range versions {
+		if (version.Archived && !includeArchived) || (version.Released && unreleasedOnly) {
+			continue
+		}
+		filtered = append(filtered, version)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(filtered)
+}
+
+// handleCreateVersion handles the jira_create_version tool call.
+func (h *JiraHandler) handleCreateVersion(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	projectKey, err := getStringParam(args, "projectKey", true)
+	if err != nil {
+		return nil, err
+	}
+	name, err := getStringParam(args, "name", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	versionReq, err := getVersionRequest(args)
+	if err != nil {
+		return nil, err
+	}
+	versionReq.Project = projectKey
+	versionReq.Name = name
+
+	// Call the Jira client
+	version, err := client.CreateVersion(versionReq)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(version)
+}
+
+// getVersionRequest reads the optional name, description and date parameters of a version.
+func getVersionRequest(args map[string]interface{}) (*domain.JiraVersionRequest, error) {
+	name, _ := getStringParam(args, "name", false)
+	description, _ := getStringParam(args, "description", false)
+	startDate, err := getDateParam(args, "startDate")
+	if err != nil {
+		return nil, err
+	}
+	releaseDate, err := getDateParam(args, "releaseDate")
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.JiraVersionRequest{
+		Name:        name,
+		Description: description,
+		StartDate:   startDate,
+		ReleaseDate: releaseDate,
+	}, nil
+}
+
+// getDateParam reads an optional date parameter in YYYY-MM-DD format.
+func getDateParam(args map[string]interface{}, name string) (string, error) {
+	value, err := getStringParam(args, name, false)
+	if err != nil || value == "" {
+		return value, err
+	}
+	if _, err := time.Parse("2006-01-02", value); err != nil {
+		return "", &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("invalid %s '%s' (use YYYY-MM-DD)", name, value),
+		}
+	}
+	return value, nil
+}
+
+// handleUpdateVersion handles the jira_update_version tool call.
+func (h *JiraHandler) handleUpdateVersion(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	versionID, err := getStringParam(args, "versionId", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	versionReq, err := getVersionRequest(args)
+	if err != nil {
+		return nil, err
+	}
+	if *versionReq == (domain.JiraVersionRequest{}) {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "at least one of name, description, startDate or releaseDate is required",
+		}
+	}
+
+	// Call the Jira client
+	version, err := client.UpdateVersion(versionID, versionReq)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(version)
+}
+
+// handleReleaseVersion handles the jira_release_version tool call.
+func (h *JiraHandler) handleReleaseVersion(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	versionID, err := getStringParam(args, "versionId", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	releaseDate, err := getDateParam(args, "releaseDate")
+	if err != nil {
+		return nil, err
+	}
+	if releaseDate == "" {
+		releaseDate = time.Now().Format("2006-01-02")
+	}
+
+	// Call the Jira client
+	released := true
+	version, err := client.UpdateVersion(versionID, &domain.JiraVersionRequest{
+		Released:    &released,
+		ReleaseDate: releaseDate,
+	})
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(version)
+}
+
+// handleArchiveVersion handles the jira_archive_version tool call.
+func (h *JiraHandler) handleArchiveVersion(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	versionID, err := getStringParam(args, "versionId", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	archived := true
+	if _, ok := args["archived"]; ok {
+		if archived, err = getBoolParam(args, "archived", false); err != nil {
+			return nil, err
+		}
+	}
+
+	// Call the Jira client
+	version, err := client.UpdateVersion(versionID, &domain.JiraVersionRequest{Archived: &archived})
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(version)
+}
+
+// handleGetVersionIssueCounts handles the jira_get_version_issue_counts tool call.
+func (h *JiraHandler) handleGetVersionIssueCounts(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	versionID, err := getStringParam(args, "versionId", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	counts, err := client.GetVersionIssueCounts(versionID)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(counts)
+}
+
+// handleListComponents handles the jira_list_components tool call.
+func (h *JiraHandler) handleListComponents(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	projectKey, err := getStringParam(args, "projectKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	components, err := client.GetProjectComponents(projectKey)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(components)
+}
+
+// handleCreateComponent handles the jira_create_component tool call.
+func (h *JiraHandler) handleCreateComponent(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	projectKey, err := getStringParam(args, "projectKey", true)
+	if err != nil {
+		return nil, err
+	}
+	name, err := getStringParam(args, "name", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	description, _ := getStringParam(args, "description", false)
+	lead, _ := getStringParam(args, "lead", false)
+	assigneeType, _ := getStringParam(args, "assigneeType", false)
+	switch assigneeType {
+	case "", "PROJECT_DEFAULT", "COMPONENT_LEAD", "PROJECT_LEAD", "UNASSIGNED":
+	default:
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("invalid assigneeType '%s' (use PROJECT_DEFAULT, COMPONENT_LEAD, PROJECT_LEAD or UNASSIGNED)", assigneeType),
+		}
+	}
+
+	// Call the Jira client
+	component, err := client.CreateComponent(&domain.ComponentRequest{
+		Name:         name,
+		Description:  description,
+		Project:      projectKey,
+		LeadUserName: lead,
+		AssigneeType: assigneeType,
+	})
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(component)
+}
+
+// Bulk fix version limits.
+const (
+	defaultBulkVersionSize = 100
+	maxBulkVersionSize     = 1000
+	bulkVersionPageSize    = 100
+)
+
+// handleSetFixVersions handles the jira_set_fix_versions tool call.
+func (h *JiraHandler) handleSetFixVersions(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	jql, err := getStringParam(args, "jql", true)
+	if err != nil {
+		return nil, err
+	}
+	versionNames, err := getStringArrayParam(args, "versions", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	mode, _ := getStringParam(args, "mode", false)
+	switch mode {
+	case "":
+		mode = domain.VersionChangeAdd
+	case domain.VersionChangeAdd, domain.VersionChangeRemove, domain.VersionChangeSet:
+	default:
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("invalid mode '%s' (use add, remove or set)", mode),
+		}
+	}
+	if len(versionNames) == 0 && mode != domain.VersionChangeSet {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "versions must contain at least one version",
+		}
+	}
+	maxIssues, err := getIntParam(args, "maxIssues", false)
+	if err != nil {
+		return nil, err
+	}
+	if maxIssues <= 0 {
+		maxIssues = defaultBulkVersionSize
+	}
+	if maxIssues > maxBulkVersionSize {
+		maxIssues = maxBulkVersionSize
+	}
+
+	// Collect the matching issues with their current fix versions
+	var issues []domain.JiraIssue
+	result := &domain.BulkVersionResult{Issues: []domain.IssueVersionChange{}}
+	for len(issues) < maxIssues {
+		pageSize := maxIssues - len(issues)
+		if pageSize > bulkVersionPageSize {
+			pageSize = bulkVersionPageSize
+		}
+		results, err := client.SearchJQL(jql, &infrastructure.SearchOptions{
+			JQL:        jql,
+			StartAt:    len(issues),
+			MaxResults: pageSize,
+			Fields:     []string{"fixVersions"},
+		})
+		if err != nil {
+			return nil, h.mapper.MapError(err)
+		}
+		issues = append(issues, results.Issues...)
+		result.Matched = results.Total
+		if len(results.Issues) == 0 || len(issues) >= results.Total {
+			break
+		}
+	}
+	result.Truncated = result.Matched > len(issues)
+
+	// Versions belong to projects, so they are resolved per project
+	projectVersions := make(map[string][]domain.JiraVersion)
+	for _, issue := range issues {
+		change := h.changeFixVersions(client, &issue, versionNames, mode, projectVersions)
+		switch change.Status {
+		case "updated":
+			result.Updated++
+		case "unchanged":
+			result.Unchanged++
+		default:
+			result.Failed++
+		}
+		result.Issues = append(result.Issues, change)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(result)
+}
+
+// changeFixVersions applies a fix version change to one issue. projectVersions caches
+// the versions of each project seen so far.
+func (h *JiraHandler) changeFixVersions(client *infrastructure.JiraClient, issue *domain.JiraIssue, versionNames []string, mode string, projectVersions map[string][]domain.JiraVersion) domain.IssueVersionChange {
+	change := domain.IssueVersionChange{IssueKey: issue.Key, Status: "failed"}
+
+	projectKey, ok := domain.ProjectKeyFromIssueKey(issue.Key)
+	if !ok {
+		change.Error = "not an issue key"
+		return change
+	}
+	versions, ok := projectVersions[projectKey]
+	if !ok {
+		var err error
+		if versions, err = client.GetProjectVersions(projectKey); err != nil {
+			change.Error = err.Error()
+			return change
+		}
+		projectVersions[projectKey] = versions
+	}
+
+	versionIDs := make([]string, 0, len(versionNames))
+	for _, name := range versionNames {
+		version, err := domain.ResolveJiraVersion(versions, projectKey, name)
+		if err != nil {
+			change.Error = err.Error()
+			return change
+		}
+		versionIDs = append(versionIDs, string(version.ID))
+	}
+
+	var current []domain.JiraVersion
+	if raw, ok := issue.Fields.Extra["fixVersions"]; ok {
+		if err := json.Unmarshal(raw, &current); err != nil {
+			change.Error = fmt.Sprintf("failed to read fix versions: %v", err)
+			return change
+		}
+	}
+	currentIDs := make([]string, len(current))
+	for i, version := range current {
+		currentIDs[i] = string(version.ID)
+	}
+
+	newIDs, changed := domain.ApplyVersionChange(currentIDs, versionIDs, mode)
+	names := make(map[string]string)
+	for _, version := range current {
+		names[string(version.ID)] = version.Name
+	}
+	for _, version := range versions {
+		names[string(version.ID)] = version.Name
+	}
+	change.Versions = make([]string, len(newIDs))
+	refs := make([]map[string]interface{}, len(newIDs))
+	for i, id := range newIDs {
+		change.Versions[i] = names[id]
+		refs[i] = map[string]interface{}{"id": id}
+	}
+
+	if !changed {
+		change.Status = "unchanged"
+		return change
+	}
+
+	err := client.UpdateIssue(issue.Key, &domain.JiraIssueUpdate{
+		Fields: domain.JiraFieldsUpdate{
+			Extra: map[string]interface{}{"fixVersions": refs},
+		},
+	})
+	if err != nil {
+		change.Error = err.Error()
+		return change
+	}
+
+	change.Status = "updated"
+	return change
+}
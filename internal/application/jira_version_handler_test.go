@@ -0,0 +1,197 @@
+package application
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraVersionRoutes serves the released (1.0), unreleased (1.1) and archived (0.9)
+// versions and the API component of project TEST, version 5.0 of project OTHER, and a
+// search returning TEST-1 (fixed in 1.0), TEST-2 (fixed in 1.1) and OTHER-1.
+var jiraVersionRoutes = mockJiraRoutes{
+	"GET /rest/api/2/project/TEST": jiraReply(http.StatusOK, `{"id":"10000","key":"TEST","name":"Test","versions":[{"id":"10001","name":"1.0"}]}`),
+	"GET /rest/api/2/project/TEST/versions": jiraReply(http.StatusOK, `[
+		{"id":"10001","name":"1.0","released":true},
+		{"id":"10002","name":"1.1"},
+		{"id":"10003","name":"0.9","released":true,"archived":true}
+	]`),
+	"GET /rest/api/2/project/OTHER/versions":             jiraReply(http.StatusOK, `[{"id":"20001","name":"5.0"}]`),
+	"POST /rest/api/2/version":                           jiraReply(http.StatusCreated, `{"id":"10004","name":"2.0"}`),
+	"PUT /rest/api/2/version/*":                          jiraReply(http.StatusOK, `{"id":"10002","name":"1.1"}`),
+	"GET /rest/api/2/version/10002/relatedIssueCounts":   jiraReply(http.StatusOK, `{"issuesFixedCount":5,"issuesAffectedCount":1}`),
+	"GET /rest/api/2/version/10002/unresolvedIssueCount": jiraReply(http.StatusOK, `{"issuesUnresolvedCount":2}`),
+	"GET /rest/api/2/project/TEST/components":            jiraReply(http.StatusOK, `[{"id":"10100","name":"API"}]`),
+	"POST /rest/api/2/component":                         jiraReply(http.StatusCreated, `{"id":"10101","name":"UI"}`),
+	"PUT /rest/api/2/issue/*":                            jiraReply(http.StatusNoContent, ""),
+	"GET /rest/api/2/search": jiraReply(http.StatusOK, `{"startAt":0,"maxResults":100,"total":3,"issues":[
+		{"key":"TEST-1","fields":{"fixVersions":[{"id":"10001","name":"1.0"}]}},
+		{"key":"TEST-2","fields":{"fixVersions":[{"id":"10002","name":"1.1"}]}},
+		{"key":"OTHER-1","fields":{}}
+	]}`),
+}
+
+// listedVersions checks that the listed versions are named names.
+func listedVersions(names ...string) func(*testing.T, *mockJira, *domain.ToolResponse, error) {
+	return func(t *testing.T, _ *mockJira, resp *domain.ToolResponse, _ error) {
+		t.Helper()
+		var versions []domain.JiraVersion
+		decodeResponse(t, resp, &versions)
+		var got []string
+		for _, version := range versions {
+			got = append(got, version.Name)
+		}
+		if strings.Join(got, ",") != strings.Join(names, ",") {
+			t.Errorf("expected versions %v, got %+v", names, versions)
+		}
+	}
+}
+
+func TestJiraHandler_Versions(t *testing.T) {
+	runJiraToolCases(t, jiraVersionRoutes, []jiraToolCase{
+		{
+			name: "project versions",
+			tool: ToolJiraGetProject,
+			args: map[string]interface{}{"projectKey": "TEST"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var project domain.Project
+				decodeResponse(t, resp, &project)
+				if len(project.Versions) != 1 {
+					t.Errorf("unexpected project: %+v", project)
+				}
+			},
+		},
+		{
+			name:  "unreleased only",
+			tool:  ToolJiraListVersions,
+			args:  map[string]interface{}{"projectKey": "TEST", "unreleasedOnly": true},
+			check: listedVersions("1.1"),
+		},
+		{
+			name:  "include archived",
+			tool:  ToolJiraListVersions,
+			args:  map[string]interface{}{"projectKey": "TEST", "includeArchived": true},
+			check: listedVersions("1.0", "1.1", "0.9"),
+		},
+		{
+			name: "create",
+			tool: ToolJiraCreateVersion,
+			args: map[string]interface{}{"projectKey": "TEST", "name": "2.0", "releaseDate": "2024-03-01"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if body := m.body("POST /rest/api/2/version"); body["project"] != "TEST" || body["name"] != "2.0" || body["releaseDate"] != "2024-03-01" {
+					t.Errorf("unexpected create payload: %v", body)
+				}
+			},
+		},
+		{
+			name: "release",
+			tool: ToolJiraReleaseVersion,
+			args: map[string]interface{}{"versionId": "10002", "releaseDate": "2024-02-01"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if body := m.body("PUT /rest/api/2/version/10002"); body["released"] != true || body["releaseDate"] != "2024-02-01" {
+					t.Errorf("unexpected release payload: %v", body)
+				}
+			},
+		},
+		{
+			name: "archive",
+			tool: ToolJiraArchiveVersion,
+			args: map[string]interface{}{"versionId": "10002"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if body := m.body("PUT /rest/api/2/version/10002"); body["archived"] != true || len(body) != 1 {
+					t.Errorf("unexpected archive payload: %v", body)
+				}
+			},
+		},
+		{
+			name: "rename",
+			tool: ToolJiraUpdateVersion,
+			args: map[string]interface{}{"versionId": "10002", "name": "1.1.1"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if body := m.body("PUT /rest/api/2/version/10002"); body["name"] != "1.1.1" || len(body) != 1 {
+					t.Errorf("unexpected update payload: %v", body)
+				}
+			},
+		},
+		{
+			name: "issue counts",
+			tool: ToolJiraGetVersionIssueCounts,
+			args: map[string]interface{}{"versionId": "10002"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var counts domain.VersionIssueCounts
+				decodeResponse(t, resp, &counts)
+				if counts.IssuesFixedCount != 5 || counts.IssuesUnresolvedCount != 2 {
+					t.Errorf("unexpected counts: %+v", counts)
+				}
+			},
+		},
+		{name: "invalid release date", tool: ToolJiraCreateVersion, args: map[string]interface{}{"projectKey": "TEST", "name": "2.0", "releaseDate": "March"}, wantCode: domain.InvalidParams},
+		{name: "empty update", tool: ToolJiraUpdateVersion, args: map[string]interface{}{"versionId": "10002"}, wantCode: domain.InvalidParams},
+	})
+}
+
+func TestJiraHandler_Components(t *testing.T) {
+	runJiraToolCases(t, jiraVersionRoutes, []jiraToolCase{
+		{
+			name: "list",
+			tool: ToolJiraListComponents,
+			args: map[string]interface{}{"projectKey": "TEST"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if !contains(resp.Content[0].Text, `"API"`) {
+					t.Errorf("unexpected components: %s", resp.Content[0].Text)
+				}
+			},
+		},
+		{
+			name: "create",
+			tool: ToolJiraCreateComponent,
+			args: map[string]interface{}{"projectKey": "TEST", "name": "UI", "lead": "jdoe", "assigneeType": "COMPONENT_LEAD"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				body := m.body("POST /rest/api/2/component")
+				if body["project"] != "TEST" || body["leadUserName"] != "jdoe" || body["assigneeType"] != "COMPONENT_LEAD" {
+					t.Errorf("unexpected component payload: %v", body)
+				}
+			},
+		},
+		{name: "unknown assignee type", tool: ToolJiraCreateComponent, args: map[string]interface{}{"projectKey": "TEST", "name": "UI", "assigneeType": "ANYONE"}, wantCode: domain.InvalidParams},
+	})
+}
+
+func TestJiraHandler_SetFixVersions(t *testing.T) {
+	runJiraToolCases(t, jiraVersionRoutes, []jiraToolCase{
+		{
+			name: "add to matching issues",
+			tool: ToolJiraSetFixVersions,
+			args: map[string]interface{}{"jql": "fixVersion is EMPTY OR project = TEST", "versions": []interface{}{"1.1"}},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var result domain.BulkVersionResult
+				decodeResponse(t, resp, &result)
+				if result.Matched != 3 || result.Updated != 1 || result.Unchanged != 1 || result.Failed != 1 || result.Truncated {
+					t.Errorf("unexpected result: %+v", result)
+				}
+				if result.Issues[0].IssueKey != "TEST-1" || strings.Join(result.Issues[0].Versions, ",") != "1.0,1.1" {
+					t.Errorf("expected 1.1 to be added to TEST-1: %+v", result.Issues[0])
+				}
+				if !contains(result.Issues[2].Error, "does not exist in project OTHER") {
+					t.Errorf("expected OTHER-1 to fail: %+v", result.Issues[2])
+				}
+
+				fields := sentFields(t, m, "PUT /rest/api/2/issue/TEST-1")
+				if refs, _ := fields["fixVersions"].([]interface{}); len(refs) != 2 {
+					t.Errorf("unexpected update payload: %v", fields)
+				}
+				if m.calls("PUT /rest/api/2/issue/TEST-2") != 0 {
+					t.Error("expected unchanged issue not to be updated")
+				}
+			},
+		},
+		{
+			name:     "empty versions",
+			tool:     ToolJiraSetFixVersions,
+			args:     map[string]interface{}{"jql": "project = TEST", "versions": []interface{}{}, "mode": "add"},
+			wantCode: domain.InvalidParams,
+		},
+	})
+}
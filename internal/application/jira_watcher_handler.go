@@ -0,0 +1,422 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"atlassian-mcp-server/internal/domain"
+	"atlassian-mcp-server/internal/infrastructure"
+)
+
+// jiraWatcherTools returns the definitions of the Jira watcher, vote and notification tools.
+func jiraWatcherTools() []domain.ToolDefinition {
+	return []domain.ToolDefinition{
+		{
+			Name:        ToolJiraGetWatchers,
+			Description: "List the users watching a Jira issue",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+		{
+			Name:        ToolJiraAddWatcher,
+			Description: "Add a watcher to a Jira issue",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"username": map[string]interface{}{
+						"type":        "string",
+						"description": "Username of the watcher (optional, defaults to you)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+		{
+			Name:        ToolJiraRemoveWatcher,
+			Description: "Remove a watcher from a Jira issue",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"username": map[string]interface{}{
+						"type":        "string",
+						"description": "Username of the watcher (optional, defaults to you)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+		{
+			Name:        ToolJiraGetVotes,
+			Description: "Get the votes on a Jira issue",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+		{
+			Name:        ToolJiraVote,
+			Description: "Vote for a Jira issue as the authenticated user",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+		{
+			Name:        ToolJiraUnvote,
+			Description: "Withdraw the authenticated user's vote for a Jira issue",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+		{
+			Name:        ToolJiraNotify,
+			Description: "Send an email notification about a Jira issue to users, groups or the people involved in it",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"subject": map[string]interface{}{
+						"type":        "string",
+						"description": "Email subject (optional, Jira generates one from the issue)",
+					},
+					"body": map[string]interface{}{
+						"type":        "string",
+						"description": "Plain text message",
+					},
+					"htmlBody": map[string]interface{}{
+						"type":        "string",
+						"description": "HTML message (optional)",
+					},
+					"users": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Usernames to notify (optional)",
+					},
+					"groups": map[string]interface{}{
+						"type":        "array",
+						"items":       map[string]interface{}{"type": "string"},
+						"description": "Groups to notify (optional)",
+					},
+					"toReporter": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Notify the reporter (optional)",
+					},
+					"toAssignee": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Notify the assignee (optional)",
+					},
+					"toWatchers": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Notify the watchers (optional)",
+					},
+					"toVoters": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Notify the voters (optional)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey", "body"},
+			},
+		},
+	}
+}
+
+// handleGetWatchers handles the jira_get_watchers tool call.
+func (h *JiraHandler) handleGetWatchers(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	watchers, err := client.GetWatchers(issueKey)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(watchers)
+}
+
+// handleAddWatcher handles the jira_add_watcher tool call.
+func (h *JiraHandler) handleAddWatcher(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	username, err := h.getUsernameOrSelf(client, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	err = client.AddWatcher(issueKey, username)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Return success response
+	return h.mapper.MapToToolResponse(map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("%s is now watching %s", username, issueKey),
+	})
+}
+
+// getUsernameOrSelf reads the optional username parameter, defaulting to the
+// authenticated user.
+func (h *JiraHandler) getUsernameOrSelf(client *infrastructure.JiraClient, args map[string]interface{}) (string, error) {
+	username, err := getStringParam(args, "username", false)
+	if err != nil || username != "" {
+		return username, err
+	}
+
+	myself, err := client.GetMyself()
+	if err != nil {
+		return "", h.mapper.MapError(err)
+	}
+	return myself.Name, nil
+}
+
+// handleRemoveWatcher handles the jira_remove_watcher tool call.
+func (h *JiraHandler) handleRemoveWatcher(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	username, err := h.getUsernameOrSelf(client, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	err = client.RemoveWatcher(issueKey, username)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Return success response
+	return h.mapper.MapToToolResponse(map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("%s is no longer watching %s", username, issueKey),
+	})
+}
+
+// handleGetVotes handles the jira_get_votes tool call.
+func (h *JiraHandler) handleGetVotes(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	votes, err := client.GetVotes(issueKey)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(votes)
+}
+
+// handleVote handles the jira_vote tool call.
+func (h *JiraHandler) handleVote(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	err = client.AddVote(issueKey)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Return success response
+	return h.mapper.MapToToolResponse(map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("Voted for %s", issueKey),
+	})
+}
+
+// handleUnvote handles the jira_unvote tool call.
+func (h *JiraHandler) handleUnvote(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	err = client.RemoveVote(issueKey)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Return success response
+	return h.mapper.MapToToolResponse(map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("Vote for %s withdrawn", issueKey),
+	})
+}
+
+// handleNotify handles the jira_notify tool call.
+func (h *JiraHandler) handleNotify(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+	body, err := getStringParam(args, "body", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	subject, _ := getStringParam(args, "subject", false)
+	htmlBody, _ := getStringParam(args, "htmlBody", false)
+	users, err := getStringArrayParam(args, "users", false)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := getStringArrayParam(args, "groups", false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the recipients
+	var to domain.NotificationRecipients
+	for name, target := range map[string]*bool{
+		"toReporter": &to.Reporter,
+		"toAssignee": &to.Assignee,
+		"toWatchers": &to.Watchers,
+		"toVoters":   &to.Voters,
+	} {
+		if *target, err = getBoolParam(args, name, false); err != nil {
+			return nil, err
+		}
+	}
+	for _, user := range users {
+		to.Users = append(to.Users, domain.UserRef{Name: user})
+	}
+	for _, group := range groups {
+		to.Groups = append(to.Groups, domain.GroupRef{Name: group})
+	}
+	if to.Empty() {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "at least one recipient is required (users, groups, toReporter, toAssignee, toWatchers or toVoters)",
+		}
+	}
+
+	// Call the Jira client
+	err = client.Notify(issueKey, &domain.Notification{
+		Subject:  subject,
+		TextBody: body,
+		HTMLBody: htmlBody,
+		To:       to,
+	})
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Return success response
+	return h.mapper.MapToToolResponse(map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("Notification about %s sent", issueKey),
+	})
+}
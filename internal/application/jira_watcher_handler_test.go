@@ -0,0 +1,115 @@
+package application
+
+import (
+	"net/http"
+	"testing"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraWatcherRoutes serves the watchers and votes of TEST-123 on behalf of user "me",
+// and accepts changes to them and notifications.
+var jiraWatcherRoutes = mockJiraRoutes{
+	"GET /rest/api/2/myself":                  jiraReply(http.StatusOK, `{"name":"me","displayName":"Me"}`),
+	"GET /rest/api/2/issue/TEST-123/watchers": jiraReply(http.StatusOK, `{"watchCount":1,"isWatching":false,"watchers":[{"name":"jdoe"}]}`),
+	"GET /rest/api/2/issue/TEST-123/votes":    jiraReply(http.StatusOK, `{"votes":3,"hasVoted":true,"voters":[]}`),
+	"/rest/api/2/issue/TEST-123/watchers":     jiraReply(http.StatusNoContent, ""),
+	"/rest/api/2/issue/TEST-123/votes":        jiraReply(http.StatusNoContent, ""),
+	"/rest/api/2/issue/TEST-123/notify":       jiraReply(http.StatusNoContent, ""),
+}
+
+func TestJiraHandler_Watchers(t *testing.T) {
+	runJiraToolCases(t, jiraWatcherRoutes, []jiraToolCase{
+		{
+			name: "list",
+			tool: ToolJiraGetWatchers,
+			args: map[string]interface{}{"issueKey": "TEST-123"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var watchers domain.Watchers
+				decodeResponse(t, resp, &watchers)
+				if watchers.WatchCount != 1 || watchers.Watchers[0].Name != "jdoe" {
+					t.Errorf("unexpected watchers: %+v", watchers)
+				}
+			},
+		},
+		{
+			name: "add the current user",
+			tool: ToolJiraAddWatcher,
+			args: map[string]interface{}{"issueKey": "TEST-123"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				requests := m.received()
+				if last := requests[len(requests)-1]; last.Method != http.MethodPost || string(last.Raw) != `"me"` {
+					t.Errorf("expected the current user to be added, got %s %s", last.Method, last.Raw)
+				}
+			},
+		},
+		{
+			name:  "remove",
+			tool:  ToolJiraRemoveWatcher,
+			args:  map[string]interface{}{"issueKey": "TEST-123", "username": "jdoe"},
+			check: requested("DELETE /rest/api/2/issue/TEST-123/watchers?username=jdoe"),
+		},
+	})
+}
+
+func TestJiraHandler_Votes(t *testing.T) {
+	runJiraToolCases(t, jiraWatcherRoutes, []jiraToolCase{
+		{
+			name: "get",
+			tool: ToolJiraGetVotes,
+			args: map[string]interface{}{"issueKey": "TEST-123"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var votes domain.Votes
+				decodeResponse(t, resp, &votes)
+				if votes.Votes != 3 || !votes.HasVoted {
+					t.Errorf("unexpected votes: %+v", votes)
+				}
+			},
+		},
+		{
+			name:  "vote",
+			tool:  ToolJiraVote,
+			args:  map[string]interface{}{"issueKey": "TEST-123"},
+			check: requested("POST /rest/api/2/issue/TEST-123/votes"),
+		},
+		{
+			name:  "unvote",
+			tool:  ToolJiraUnvote,
+			args:  map[string]interface{}{"issueKey": "TEST-123"},
+			check: requested("DELETE /rest/api/2/issue/TEST-123/votes"),
+		},
+	})
+}
+
+func TestJiraHandler_Notify(t *testing.T) {
+	runJiraToolCases(t, jiraWatcherRoutes, []jiraToolCase{
+		{
+			name:     "no recipients",
+			tool:     ToolJiraNotify,
+			args:     map[string]interface{}{"issueKey": "TEST-123", "body": "Ping"},
+			wantCode: domain.InvalidParams,
+			check:    noRequests,
+		},
+		{
+			name: "assignee and group",
+			tool: ToolJiraNotify,
+			args: map[string]interface{}{
+				"issueKey":   "TEST-123",
+				"subject":    "Release",
+				"body":       "Ping",
+				"groups":     []interface{}{"qa"},
+				"toAssignee": true,
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				notification := m.body("POST /rest/api/2/issue/TEST-123/notify")
+				to, _ := notification["to"].(map[string]interface{})
+				if notification["subject"] != "Release" || notification["textBody"] != "Ping" || to["assignee"] != true || to["reporter"] != nil {
+					t.Errorf("unexpected notification: %v", notification)
+				}
+				if groups, _ := to["groups"].([]interface{}); len(groups) != 1 || groups[0].(map[string]interface{})["name"] != "qa" {
+					t.Errorf("unexpected groups: %v", to["groups"])
+				}
+			},
+		},
+	})
+}
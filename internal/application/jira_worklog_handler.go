@@ -0,0 +1,624 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"atlassian-mcp-server/internal/domain"
+	"atlassian-mcp-server/internal/infrastructure"
+)
+
+// jiraWorklogTools returns the definitions of the Jira worklog and time tracking tools.
+func jiraWorklogTools() []domain.ToolDefinition {
+	return []domain.ToolDefinition{
+		{
+			Name:        ToolJiraGetWorklogs,
+			Description: "Get the time logged on a Jira issue",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+		{
+			Name:        ToolJiraAddWorklog,
+			Description: "Log time on a Jira issue",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"timeSpent": map[string]interface{}{
+						"type":        "string",
+						"description": "Time spent as a Jira duration (e.g., 1h 30m, 2d)",
+					},
+					"started": map[string]interface{}{
+						"type":        "string",
+						"description": "When the work started (RFC 3339 or YYYY-MM-DD, defaults to now)",
+					},
+					"comment": map[string]interface{}{
+						"type":        "string",
+						"description": "Description of the work",
+					},
+					"adjustEstimate": getAdjustEstimateSchema(),
+					"newEstimate": map[string]interface{}{
+						"type":        "string",
+						"description": "Remaining estimate to set when adjustEstimate is 'new' (e.g., 2d)",
+					},
+					"reduceBy": map[string]interface{}{
+						"type":        "string",
+						"description": "Amount to reduce the remaining estimate by when adjustEstimate is 'manual'",
+					},
+					"visibility": getCommentVisibilitySchema(),
+					"auth":       getAuthSchema(),
+				},
+				Required: []string{"issueKey", "timeSpent"},
+			},
+		},
+		{
+			Name:        ToolJiraUpdateWorklog,
+			Description: "Change the time spent, start time or comment of a worklog",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"worklogId": map[string]interface{}{
+						"type":        "string",
+						"description": "The worklog ID",
+					},
+					"timeSpent": map[string]interface{}{
+						"type":        "string",
+						"description": "New time spent as a Jira duration (e.g., 1h 30m)",
+					},
+					"started": map[string]interface{}{
+						"type":        "string",
+						"description": "When the work started (RFC 3339 or YYYY-MM-DD)",
+					},
+					"comment": map[string]interface{}{
+						"type":        "string",
+						"description": "New description of the work",
+					},
+					"adjustEstimate": getAdjustEstimateSchema(),
+					"newEstimate": map[string]interface{}{
+						"type":        "string",
+						"description": "Remaining estimate to set when adjustEstimate is 'new' (e.g., 2d)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey", "worklogId"},
+			},
+		},
+		{
+			Name:        ToolJiraDeleteWorklog,
+			Description: "Delete a worklog from a Jira issue",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"worklogId": map[string]interface{}{
+						"type":        "string",
+						"description": "The worklog ID",
+					},
+					"adjustEstimate": getAdjustEstimateSchema(),
+					"newEstimate": map[string]interface{}{
+						"type":        "string",
+						"description": "Remaining estimate to set when adjustEstimate is 'new' (e.g., 2d)",
+					},
+					"increaseBy": map[string]interface{}{
+						"type":        "string",
+						"description": "Amount to increase the remaining estimate by when adjustEstimate is 'manual'",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey", "worklogId"},
+			},
+		},
+		{
+			Name:        ToolJiraGetTimeTracking,
+			Description: "Get the original estimate, remaining estimate and time spent on a Jira issue",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+		{
+			Name:        ToolJiraSetEstimates,
+			Description: "Set the original and/or remaining estimate of a Jira issue",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"issueKey": map[string]interface{}{
+						"type":        "string",
+						"description": "The issue key (e.g., TEST-123)",
+					},
+					"originalEstimate": map[string]interface{}{
+						"type":        "string",
+						"description": "Original estimate as a Jira duration (e.g., 3d)",
+					},
+					"remainingEstimate": map[string]interface{}{
+						"type":        "string",
+						"description": "Remaining estimate as a Jira duration (e.g., 1d 4h)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"issueKey"},
+			},
+		},
+		{
+			Name:        ToolJiraWorklogReport,
+			Description: "Total the time logged per user and per issue over the issues matching a JQL query and a date range",
+			InputSchema: domain.JSONSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"jql": map[string]interface{}{
+						"type":        "string",
+						"description": "JQL query selecting the issues (e.g., project = CONSULT)",
+					},
+					"from": map[string]interface{}{
+						"type":        "string",
+						"description": "First day of the range (YYYY-MM-DD)",
+					},
+					"to": map[string]interface{}{
+						"type":        "string",
+						"description": "Last day of the range, inclusive (YYYY-MM-DD)",
+					},
+					"maxIssues": map[string]interface{}{
+						"type":        "integer",
+						"description": "Maximum number of issues to include (default 200, max 1000)",
+					},
+					"auth": getAuthSchema(),
+				},
+				Required: []string{"jql", "from", "to"},
+			},
+		},
+	}
+}
+
+// handleGetWorklogs handles the jira_get_worklogs tool call.
+func (h *JiraHandler) handleGetWorklogs(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	worklogs, err := client.GetWorklogs(issueKey)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(worklogs)
+}
+
+// getAdjustEstimateSchema returns the JSON schema for how a worklog change adjusts the remaining estimate.
+func getAdjustEstimateSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "string",
+		"description": "How to adjust the remaining estimate: auto (default), new, leave or manual",
+		"enum":        []string{"auto", "new", "leave", "manual"},
+	}
+}
+
+// getWorklogOptions reads adjustEstimate and its companion parameters from the tool
+// arguments. manualParam names the amount used by "manual" (reduceBy or increaseBy),
+// or is empty if the operation does not support it.
+func getWorklogOptions(args map[string]interface{}, manualParam string) (*infrastructure.WorklogOptions, error) {
+	adjustEstimate, err := getStringParam(args, "adjustEstimate", false)
+	if err != nil {
+		return nil, err
+	}
+	options := &infrastructure.WorklogOptions{AdjustEstimate: adjustEstimate}
+
+	switch adjustEstimate {
+	case "", "auto", "leave":
+	case "new":
+		if options.NewEstimate, err = getJiraDurationParam(args, "newEstimate", true); err != nil {
+			return nil, err
+		}
+	case "manual":
+		if manualParam == "" {
+			return nil, &domain.Error{
+				Code:    domain.InvalidParams,
+				Message: "adjustEstimate 'manual' is not supported for this operation",
+			}
+		}
+		amount, err := getJiraDurationParam(args, manualParam, true)
+		if err != nil {
+			return nil, err
+		}
+		if manualParam == "reduceBy" {
+			options.ReduceBy = amount
+		} else {
+			options.IncreaseBy = amount
+		}
+	default:
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("invalid adjustEstimate '%s' (use auto, new, leave or manual)", adjustEstimate),
+		}
+	}
+
+	return options, nil
+}
+
+// getJiraDurationParam reads a Jira duration such as "1d 4h 30m" from the tool arguments.
+func getJiraDurationParam(args map[string]interface{}, name string, required bool) (string, error) {
+	value, err := getStringParam(args, name, required)
+	if err != nil || value == "" {
+		return value, err
+	}
+	if !domain.ValidJiraDuration(value) {
+		return "", &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("invalid %s '%s' (use a Jira duration such as 1d 4h 30m)", name, value),
+		}
+	}
+	return value, nil
+}
+
+// getWorklogStarted reads the started parameter and converts it to Jira's timestamp format.
+func getWorklogStarted(args map[string]interface{}) (string, error) {
+	started, err := getStringParam(args, "started", false)
+	if err != nil || started == "" {
+		return started, err
+	}
+	t, err := domain.ParseJiraTime(started)
+	if err != nil {
+		return "", &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("invalid started: %v", err),
+		}
+	}
+	return t.Format(domain.JiraTimeLayout), nil
+}
+
+// handleAddWorklog handles the jira_add_worklog tool call.
+func (h *JiraHandler) handleAddWorklog(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+	timeSpent, err := getJiraDurationParam(args, "timeSpent", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	started, err := getWorklogStarted(args)
+	if err != nil {
+		return nil, err
+	}
+	if started == "" {
+		started = time.Now().Format(domain.JiraTimeLayout)
+	}
+	comment, _ := getStringParam(args, "comment", false)
+	visibility, err := getCommentVisibility(args)
+	if err != nil {
+		return nil, err
+	}
+	options, err := getWorklogOptions(args, "reduceBy")
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	worklog, err := client.AddWorklog(issueKey, &domain.Worklog{
+		TimeSpent:  timeSpent,
+		Started:    started,
+		Comment:    comment,
+		Visibility: visibility,
+	}, options)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(worklog)
+}
+
+// handleUpdateWorklog handles the jira_update_worklog tool call.
+func (h *JiraHandler) handleUpdateWorklog(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+	worklogID, err := getStringParam(args, "worklogId", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	timeSpent, err := getJiraDurationParam(args, "timeSpent", false)
+	if err != nil {
+		return nil, err
+	}
+	started, err := getWorklogStarted(args)
+	if err != nil {
+		return nil, err
+	}
+	comment, _ := getStringParam(args, "comment", false)
+	if timeSpent == "" && started == "" && comment == "" {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "at least one of timeSpent, started or comment is required",
+		}
+	}
+	options, err := getWorklogOptions(args, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	worklog, err := client.UpdateWorklog(issueKey, worklogID, &domain.Worklog{
+		TimeSpent: timeSpent,
+		Started:   started,
+		Comment:   comment,
+	}, options)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(worklog)
+}
+
+// handleDeleteWorklog handles the jira_delete_worklog tool call.
+func (h *JiraHandler) handleDeleteWorklog(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+	worklogID, err := getStringParam(args, "worklogId", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional parameters
+	options, err := getWorklogOptions(args, "increaseBy")
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	err = client.DeleteWorklog(issueKey, worklogID, options)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Return success response
+	return h.mapper.MapToToolResponse(map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("Worklog %s deleted from %s", worklogID, issueKey),
+	})
+}
+
+// handleGetTimeTracking handles the jira_get_time_tracking tool call.
+func (h *JiraHandler) handleGetTimeTracking(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the Jira client
+	timeTracking, err := client.GetTimeTracking(issueKey)
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(timeTracking)
+}
+
+// handleSetEstimates handles the jira_set_estimates tool call.
+func (h *JiraHandler) handleSetEstimates(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	issueKey, err := getStringParam(args, "issueKey", true)
+	if err != nil {
+		return nil, err
+	}
+	originalEstimate, err := getJiraDurationParam(args, "originalEstimate", false)
+	if err != nil {
+		return nil, err
+	}
+	remainingEstimate, err := getJiraDurationParam(args, "remainingEstimate", false)
+	if err != nil {
+		return nil, err
+	}
+	if originalEstimate == "" && remainingEstimate == "" {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "at least one of originalEstimate or remainingEstimate is required",
+		}
+	}
+
+	// Estimates are set through the timetracking field
+	timeTracking := map[string]interface{}{}
+	if originalEstimate != "" {
+		timeTracking["originalEstimate"] = originalEstimate
+	}
+	if remainingEstimate != "" {
+		timeTracking["remainingEstimate"] = remainingEstimate
+	}
+
+	// Call the Jira client
+	err = client.UpdateIssue(issueKey, &domain.JiraIssueUpdate{
+		Fields: domain.JiraFieldsUpdate{
+			Extra: map[string]interface{}{"timetracking": timeTracking},
+		},
+	})
+	if err != nil {
+		return nil, h.mapper.MapError(err)
+	}
+
+	// Return success response
+	return h.mapper.MapToToolResponse(map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("Estimates of %s updated successfully", issueKey),
+	})
+}
+
+// Worklog report limits.
+const (
+	defaultWorklogReportSize = 200
+	maxWorklogReportSize     = 1000
+	worklogReportPageSize    = 100
+)
+
+// handleWorklogReport handles the jira_worklog_report tool call.
+func (h *JiraHandler) handleWorklogReport(ctx context.Context, args map[string]interface{}) (*domain.ToolResponse, error) {
+	// Get the appropriate client (with custom credentials if provided)
+	client, err := h.getClientForRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate required parameters
+	jql, err := getStringParam(args, "jql", true)
+	if err != nil {
+		return nil, err
+	}
+	from, err := getStringParam(args, "from", true)
+	if err != nil {
+		return nil, err
+	}
+	to, err := getStringParam(args, "to", true)
+	if err != nil {
+		return nil, err
+	}
+	for _, date := range []string{from, to} {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return nil, &domain.Error{
+				Code:    domain.InvalidParams,
+				Message: fmt.Sprintf("invalid date '%s' (use YYYY-MM-DD)", date),
+			}
+		}
+	}
+	if from > to {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: "from must not be after to",
+		}
+	}
+
+	// Optional parameters
+	maxIssues, err := getIntParam(args, "maxIssues", false)
+	if err != nil {
+		return nil, err
+	}
+	if maxIssues <= 0 {
+		maxIssues = defaultWorklogReportSize
+	}
+	if maxIssues > maxWorklogReportSize {
+		maxIssues = maxWorklogReportSize
+	}
+
+	// Only issues with work logged in the range are of interest
+	jql = domain.AddJQLClause(jql, fmt.Sprintf(`worklogDate >= "%s" AND worklogDate <= "%s"`, from, to))
+
+	// Call the Jira client
+	var issues []domain.IssueWorklogs
+	truncated := false
+	for startAt := 0; len(issues) < maxIssues; {
+		pageSize := maxIssues - len(issues)
+		if pageSize > worklogReportPageSize {
+			pageSize = worklogReportPageSize
+		}
+		results, err := client.SearchJQL(jql, &infrastructure.SearchOptions{
+			JQL:        jql,
+			StartAt:    startAt,
+			MaxResults: pageSize,
+			Fields:     []string{"summary"},
+		})
+		if err != nil {
+			return nil, h.mapper.MapError(err)
+		}
+
+		for _, issue := range results.Issues {
+			worklogs, err := client.GetWorklogs(issue.Key)
+			if err != nil {
+				return nil, h.mapper.MapError(err)
+			}
+			issues = append(issues, domain.IssueWorklogs{
+				IssueKey: issue.Key,
+				Summary:  issue.Fields.Summary,
+				Worklogs: worklogs.Worklogs,
+			})
+		}
+
+		startAt += len(results.Issues)
+		if len(results.Issues) == 0 || startAt >= results.Total {
+			break
+		}
+		truncated = len(issues) >= maxIssues
+	}
+
+	report := domain.NewWorklogReport(issues, from, to)
+	report.Truncated = truncated
+
+	// Transform the response
+	return h.mapper.MapToToolResponse(report)
+}
@@ -0,0 +1,159 @@
+package application
+
+import (
+	"net/http"
+	"testing"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// jiraWorklogRoutes serves worklogs and time tracking of TEST-123, and worklogs of the
+// CONS-1 and CONS-2 issues returned by searches.
+var jiraWorklogRoutes = mockJiraRoutes{
+	"GET /rest/api/2/search": jiraReply(http.StatusOK, `{"startAt":0,"maxResults":100,"total":2,"issues":[
+		{"key":"CONS-1","fields":{"summary":"Workshop"}},
+		{"key":"CONS-2","fields":{"summary":"Report"}}
+	]}`),
+	"GET /rest/api/2/issue/CONS-1/worklog": jiraReply(http.StatusOK, `{"worklogs":[
+		{"id":"1","author":{"name":"jdoe","displayName":"John Doe"},"started":"2024-01-15T09:00:00.000+0000","timeSpentSeconds":7200},
+		{"id":"2","author":{"name":"asmith"},"started":"2024-02-15T09:00:00.000+0000","timeSpentSeconds":3600}
+	]}`),
+	"GET /rest/api/2/issue/CONS-2/worklog": jiraReply(http.StatusOK, `{"worklogs":[
+		{"id":"3","author":{"name":"asmith"},"started":"2024-01-16T09:00:00.000+0000","timeSpentSeconds":1800}
+	]}`),
+	"GET /rest/api/2/issue/TEST-123/worklog":          jiraReply(http.StatusOK, `{"startAt":0,"maxResults":1,"total":1,"worklogs":[{"id":"10100","timeSpent":"1h","timeSpentSeconds":3600}]}`),
+	"POST /rest/api/2/issue/TEST-123/worklog":         jiraReply(http.StatusCreated, `{"id":"10101","timeSpent":"2h","timeSpentSeconds":7200}`),
+	"PUT /rest/api/2/issue/TEST-123/worklog/10101":    jiraReply(http.StatusOK, `{"id":"10101","comment":"Edited"}`),
+	"DELETE /rest/api/2/issue/TEST-123/worklog/10101": jiraReply(http.StatusNoContent, ""),
+	"GET /rest/api/2/issue/TEST-123":                  jiraReply(http.StatusOK, `{"key":"TEST-123","fields":{"timetracking":{"originalEstimate":"3d","remainingEstimate":"2d"}}}`),
+	"PUT /rest/api/2/issue/TEST-123":                  jiraReply(http.StatusNoContent, ""),
+}
+
+func TestJiraHandler_Worklogs(t *testing.T) {
+	runJiraToolCases(t, jiraWorklogRoutes, []jiraToolCase{
+		{
+			name: "list",
+			tool: ToolJiraGetWorklogs,
+			args: map[string]interface{}{"issueKey": "TEST-123"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var page domain.WorklogPage
+				decodeResponse(t, resp, &page)
+				if len(page.Worklogs) != 1 || page.Worklogs[0].ID != "10100" {
+					t.Errorf("unexpected worklogs: %+v", page)
+				}
+			},
+		},
+		{
+			name: "add",
+			tool: ToolJiraAddWorklog,
+			args: map[string]interface{}{
+				"issueKey":       "TEST-123",
+				"timeSpent":      "2h",
+				"started":        "2024-01-16T09:00:00Z",
+				"comment":        "Workshop",
+				"adjustEstimate": "new",
+				"newEstimate":    "1d",
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if log := m.log(); len(log) != 1 || log[0] != "POST /rest/api/2/issue/TEST-123/worklog?adjustEstimate=new&newEstimate=1d" {
+					t.Errorf("unexpected requests: %v", log)
+				}
+				body := m.body("POST /rest/api/2/issue/TEST-123/worklog")
+				if body["timeSpent"] != "2h" || body["started"] != "2024-01-16T09:00:00.000+0000" || body["comment"] != "Workshop" {
+					t.Errorf("unexpected worklog payload: %v", body)
+				}
+			},
+		},
+		{
+			name: "update",
+			tool: ToolJiraUpdateWorklog,
+			args: map[string]interface{}{"issueKey": "TEST-123", "worklogId": "10101", "comment": "Edited"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if body := m.body("PUT /rest/api/2/issue/TEST-123/worklog/10101"); body["comment"] != "Edited" || body["timeSpent"] != nil {
+					t.Errorf("unexpected update payload: %v", body)
+				}
+			},
+		},
+		{
+			name: "delete",
+			tool: ToolJiraDeleteWorklog,
+			args: map[string]interface{}{"issueKey": "TEST-123", "worklogId": "10101", "adjustEstimate": "manual", "increaseBy": "2h"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				if log := m.log(); len(log) != 1 || log[0] != "DELETE /rest/api/2/issue/TEST-123/worklog/10101?adjustEstimate=manual&increaseBy=2h" {
+					t.Errorf("unexpected requests: %v", log)
+				}
+				if !contains(resp.Content[0].Text, "Worklog 10101 deleted") {
+					t.Errorf("unexpected response: %s", resp.Content[0].Text)
+				}
+			},
+		},
+		{name: "missing timeSpent", tool: ToolJiraAddWorklog, args: map[string]interface{}{"issueKey": "TEST-123"}, wantCode: domain.InvalidParams, check: noRequests},
+		{name: "invalid timeSpent", tool: ToolJiraAddWorklog, args: map[string]interface{}{"issueKey": "TEST-123", "timeSpent": "2 hours"}, wantCode: domain.InvalidParams, check: noRequests},
+		{name: "invalid started", tool: ToolJiraAddWorklog, args: map[string]interface{}{"issueKey": "TEST-123", "timeSpent": "2h", "started": "yesterday"}, wantCode: domain.InvalidParams, check: noRequests},
+		{name: "new without estimate", tool: ToolJiraAddWorklog, args: map[string]interface{}{"issueKey": "TEST-123", "timeSpent": "2h", "adjustEstimate": "new"}, wantCode: domain.InvalidParams, check: noRequests},
+		{name: "manual without reduceBy", tool: ToolJiraAddWorklog, args: map[string]interface{}{"issueKey": "TEST-123", "timeSpent": "2h", "adjustEstimate": "manual"}, wantCode: domain.InvalidParams, check: noRequests},
+		{name: "unknown adjustEstimate", tool: ToolJiraAddWorklog, args: map[string]interface{}{"issueKey": "TEST-123", "timeSpent": "2h", "adjustEstimate": "sometimes"}, wantCode: domain.InvalidParams, check: noRequests},
+		{name: "empty update", tool: ToolJiraUpdateWorklog, args: map[string]interface{}{"issueKey": "TEST-123", "worklogId": "10101"}, wantCode: domain.InvalidParams, check: noRequests},
+		{name: "manual update", tool: ToolJiraUpdateWorklog, args: map[string]interface{}{"issueKey": "TEST-123", "worklogId": "10101", "comment": "x", "adjustEstimate": "manual"}, wantCode: domain.InvalidParams, check: noRequests},
+	})
+}
+
+func TestJiraHandler_TimeTracking(t *testing.T) {
+	runJiraToolCases(t, jiraWorklogRoutes, []jiraToolCase{
+		{
+			name: "get",
+			tool: ToolJiraGetTimeTracking,
+			args: map[string]interface{}{"issueKey": "TEST-123"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var timeTracking domain.TimeTracking
+				decodeResponse(t, resp, &timeTracking)
+				if timeTracking.OriginalEstimate != "3d" || timeTracking.RemainingEstimate != "2d" {
+					t.Errorf("unexpected time tracking: %+v", timeTracking)
+				}
+			},
+		},
+		{
+			name: "set remaining estimate",
+			tool: ToolJiraSetEstimates,
+			args: map[string]interface{}{"issueKey": "TEST-123", "remainingEstimate": "1d 4h"},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				fields := sentFields(t, m, "PUT /rest/api/2/issue/TEST-123")
+				tracking, _ := fields["timetracking"].(map[string]interface{})
+				if tracking["remainingEstimate"] != "1d 4h" || tracking["originalEstimate"] != nil {
+					t.Errorf("unexpected update payload: %v", fields)
+				}
+			},
+		},
+		{name: "no estimates", tool: ToolJiraSetEstimates, args: map[string]interface{}{"issueKey": "TEST-123"}, wantCode: domain.InvalidParams, check: noRequests},
+		{name: "invalid estimate", tool: ToolJiraSetEstimates, args: map[string]interface{}{"issueKey": "TEST-123", "originalEstimate": "soon"}, wantCode: domain.InvalidParams, check: noRequests},
+	})
+}
+
+func TestJiraHandler_WorklogReport(t *testing.T) {
+	runJiraToolCases(t, jiraWorklogRoutes, []jiraToolCase{
+		{
+			name: "report",
+			tool: ToolJiraWorklogReport,
+			args: map[string]interface{}{
+				"jql":  "project = CONS ORDER BY key",
+				"from": "2024-01-01",
+				"to":   "2024-01-31",
+			},
+			check: func(t *testing.T, m *mockJira, resp *domain.ToolResponse, err error) {
+				var report domain.WorklogReport
+				decodeResponse(t, resp, &report)
+				if report.TotalSeconds != 9000 || report.IssueCount != 2 || report.Truncated {
+					t.Errorf("unexpected report: %+v", report)
+				}
+				if len(report.ByUser) != 2 || report.ByUser[0].Key != "jdoe" || report.ByUser[1].Seconds != 1800 {
+					t.Errorf("unexpected per-user totals: %+v", report.ByUser)
+				}
+				if log := m.log(); len(log) == 0 || !contains(log[0], "worklogDate") || !contains(log[0], "ORDER+BY+key") {
+					t.Errorf("expected the search to be restricted to the date range, got %v", log)
+				}
+			},
+		},
+		{name: "invalid date", tool: ToolJiraWorklogReport, args: map[string]interface{}{"jql": "project = CONS", "from": "2024-01-01", "to": "31/01/2024"}, wantCode: domain.InvalidParams, check: noRequests},
+		{name: "reversed range", tool: ToolJiraWorklogReport, args: map[string]interface{}{"jql": "project = CONS", "from": "2024-02-01", "to": "2024-01-01"}, wantCode: domain.InvalidParams, check: noRequests},
+	})
+}
@@ -2,6 +2,7 @@ package application
 
 import (
 	"fmt"
+	"strings"
 
 	"atlassian-mcp-server/internal/domain"
 )
@@ -61,3 +62,99 @@ func getIntParam(args map[string]interface{}, name string, required bool) (int,
 		}
 	}
 }
+
+// getBoolParam extracts a boolean parameter from the arguments map.
+// Returns an error if the parameter is required but missing or not a boolean.
+func getBoolParam(args map[string]interface{}, name string, required bool) (bool, error) {
+	value, exists := args[name]
+	if !exists {
+		if required {
+			return false, &domain.Error{
+				Code:    domain.InvalidParams,
+				Message: fmt.Sprintf("missing required parameter: %s", name),
+			}
+		}
+		return false, nil
+	}
+
+	boolValue, ok := value.(bool)
+	if !ok {
+		return false, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("parameter %s must be a boolean", name),
+		}
+	}
+
+	return boolValue, nil
+}
+
+// getObjectParam extracts a JSON object parameter from the arguments map.
+// Returns nil if the parameter is optional and missing.
+func getObjectParam(args map[string]interface{}, name string, required bool) (map[string]interface{}, error) {
+	value, exists := args[name]
+	if !exists {
+		if required {
+			return nil, &domain.Error{
+				Code:    domain.InvalidParams,
+				Message: fmt.Sprintf("missing required parameter: %s", name),
+			}
+		}
+		return nil, nil
+	}
+
+	objValue, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("parameter %s must be an object", name),
+		}
+	}
+
+	return objValue, nil
+}
+
+// getStringArrayParam extracts a list of strings from the arguments map.
+// A single comma-separated string is accepted as well. Returns nil if the
+// parameter is optional and missing.
+func getStringArrayParam(args map[string]interface{}, name string, required bool) ([]string, error) {
+	value, exists := args[name]
+	if !exists {
+		if required {
+			return nil, &domain.Error{
+				Code:    domain.InvalidParams,
+				Message: fmt.Sprintf("missing required parameter: %s", name),
+			}
+		}
+		return nil, nil
+	}
+
+	var values []string
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			str, ok := item.(string)
+			if !ok {
+				return nil, &domain.Error{
+					Code:    domain.InvalidParams,
+					Message: fmt.Sprintf("parameter %s must be an array of strings", name),
+				}
+			}
+			values = append(values, str)
+		}
+	case []string:
+		values = v
+	case string:
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				values = append(values, item)
+			}
+		}
+	default:
+		return nil, &domain.Error{
+			Code:    domain.InvalidParams,
+			Message: fmt.Sprintf("parameter %s must be an array of strings", name),
+		}
+	}
+
+	return values, nil
+}
@@ -2,8 +2,11 @@ package domain
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
+	"sync"
+	"time"
 )
 
 // Credentials stores authentication information for an Atlassian tool.
@@ -17,9 +20,16 @@ type Credentials struct {
 
 // AuthenticationManager handles credentials for Atlassian tools.
 // It stores credentials for each configured tool and provides authenticated
-// HTTP clients for making API calls.
+// HTTP clients for making API calls. Tools without inline credentials are
+// resolved through the optional CredentialProvider and cached.
+// Each tool shares one base transport built from its TLS, proxy and
+// connection settings, so connections are pooled across clients.
 type AuthenticationManager struct {
 	credentials map[string]*Credentials
+	provider    CredentialProvider
+	toolConfigs map[string]*ToolConfig
+	transports  map[string]*http.Transport
+	mu          sync.RWMutex
 }
 
 // NewAuthenticationManager creates a new authentication manager.
@@ -31,29 +41,61 @@ func NewAuthenticationManager(credentials map[string]*Credentials) *Authenticati
 	}
 }
 
+// NewAuthenticationManagerWithProvider creates an authentication manager that falls back
+// to the provider for any tool missing from the credentials map.
+func NewAuthenticationManagerWithProvider(credentials map[string]*Credentials, provider CredentialProvider) *AuthenticationManager {
+	if credentials == nil {
+		credentials = make(map[string]*Credentials)
+	}
+	return &AuthenticationManager{
+		credentials: credentials,
+		provider:    provider,
+	}
+}
+
 // NewAuthenticationManagerFromConfig creates an authentication manager from a configuration.
 // It extracts credentials from the config for each configured tool.
 // If a tool has no auth configured, it will not have default credentials (client must provide them).
 func NewAuthenticationManagerFromConfig(config *Config) *AuthenticationManager {
+	return NewAuthenticationManagerFromConfigWithProvider(config, nil)
+}
+
+// NewAuthenticationManagerFromConfigWithProvider creates an authentication manager from a configuration.
+// Tools whose auth uses `source: store` are resolved through the provider on first use.
+func NewAuthenticationManagerFromConfigWithProvider(config *Config, provider CredentialProvider) *AuthenticationManager {
 	credentials := make(map[string]*Credentials)
+	toolConfigs := make(map[string]*ToolConfig)
 
-	if config.Tools.Jira != nil && config.Tools.Jira.Auth != nil {
+	if config.Tools.Jira != nil && config.Tools.Jira.Auth != nil && !config.Tools.Jira.Auth.UsesStore() {
 		credentials["jira"] = credentialsFromAuthConfig(config.Tools.Jira.Auth)
 	}
 
-	if config.Tools.Confluence != nil && config.Tools.Confluence.Auth != nil {
+	if config.Tools.Confluence != nil && config.Tools.Confluence.Auth != nil && !config.Tools.Confluence.Auth.UsesStore() {
 		credentials["confluence"] = credentialsFromAuthConfig(config.Tools.Confluence.Auth)
 	}
 
-	if config.Tools.Bitbucket != nil && config.Tools.Bitbucket.Auth != nil {
+	if config.Tools.Bitbucket != nil && config.Tools.Bitbucket.Auth != nil && !config.Tools.Bitbucket.Auth.UsesStore() {
 		credentials["bitbucket"] = credentialsFromAuthConfig(config.Tools.Bitbucket.Auth)
 	}
 
-	if config.Tools.Bamboo != nil && config.Tools.Bamboo.Auth != nil {
+	if config.Tools.Bamboo != nil && config.Tools.Bamboo.Auth != nil && !config.Tools.Bamboo.Auth.UsesStore() {
 		credentials["bamboo"] = credentialsFromAuthConfig(config.Tools.Bamboo.Auth)
 	}
 
-	return NewAuthenticationManager(credentials)
+	for tool, tc := range map[string]*ToolConfig{
+		"jira":       config.Tools.Jira,
+		"confluence": config.Tools.Confluence,
+		"bitbucket":  config.Tools.Bitbucket,
+		"bamboo":     config.Tools.Bamboo,
+	} {
+		if tc != nil {
+			toolConfigs[tool] = tc
+		}
+	}
+
+	am := NewAuthenticationManagerWithProvider(credentials, provider)
+	am.toolConfigs = toolConfigs
+	return am
 }
 
 // credentialsFromAuthConfig converts an AuthConfig to Credentials.
@@ -76,20 +118,87 @@ func (am *AuthenticationManager) GetAuthenticatedClient(tool string) (*http.Clie
 	}
 
 	// Get credentials for the tool
-	creds := am.credentials[tool]
+	creds, err := am.lookupCredentials(tool)
+	if err != nil {
+		return nil, err
+	}
+
+	return am.newToolClient(tool, creds)
+}
+
+// GetAuthenticatedClientForTool returns an HTTP client with the provided credentials
+// that uses the tool's TLS, proxy and connection settings.
+// Returns an error if the provided credentials are invalid or the transport cannot be built.
+func (am *AuthenticationManager) GetAuthenticatedClientForTool(tool string, creds *Credentials) (*http.Client, error) {
+	// Validate the provided credentials
+	if err := validateCredentials(creds); err != nil {
+		return nil, err
+	}
+
+	return am.newToolClient(tool, creds)
+}
+
+// newToolClient wraps the tool's shared base transport with authentication headers.
+func (am *AuthenticationManager) newToolClient(tool string, creds *Credentials) (*http.Client, error) {
+	base, timeout, err := am.toolTransport(tool)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create a custom transport that adds authentication headers
 	transport := &authenticatedTransport{
-		base:        http.DefaultTransport,
+		base:        base,
 		credentials: creds,
 	}
 
 	// Return a client with the authenticated transport
 	return &http.Client{
 		Transport: transport,
+		Timeout:   timeout,
 	}, nil
 }
 
+// toolTransport returns the base transport and request timeout for a tool.
+// Tools without configuration use http.DefaultTransport. Transports are built
+// once and cached so all clients for a tool share its connection pool.
+func (am *AuthenticationManager) toolTransport(tool string) (http.RoundTripper, time.Duration, error) {
+	am.mu.RLock()
+	tc := am.toolConfigs[tool]
+	transport, ok := am.transports[tool]
+	am.mu.RUnlock()
+
+	if tc == nil {
+		return http.DefaultTransport, 0, nil
+	}
+
+	var timeout time.Duration
+	if tc.Connection != nil {
+		timeout = tc.Connection.Timeout
+	}
+
+	if ok {
+		return transport, timeout, nil
+	}
+
+	transport, err := NewToolTransport(tc)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to configure HTTP transport for tool %s: %w", tool, err)
+	}
+
+	am.mu.Lock()
+	if am.transports == nil {
+		am.transports = make(map[string]*http.Transport)
+	}
+	if existing, ok := am.transports[tool]; ok {
+		transport = existing
+	} else {
+		am.transports[tool] = transport
+	}
+	am.mu.Unlock()
+
+	return transport, timeout, nil
+}
+
 // GetAuthenticatedClientWithCredentials returns an HTTP client with the provided credentials.
 // This allows clients to provide their own credentials at runtime instead of using config file credentials.
 // Returns an error if the provided credentials are invalid.
@@ -140,9 +249,9 @@ func validateCredentials(creds *Credentials) error {
 // Returns an error if the tool is not configured or if credentials are missing/invalid.
 func (am *AuthenticationManager) ValidateCredentials(tool string) error {
 	// Check if tool is configured
-	creds, ok := am.credentials[tool]
-	if !ok {
-		return fmt.Errorf("no credentials configured for tool: %s", tool)
+	creds, err := am.lookupCredentials(tool)
+	if err != nil {
+		return err
 	}
 
 	// Validate credentials based on auth type
@@ -165,6 +274,35 @@ func (am *AuthenticationManager) ValidateCredentials(tool string) error {
 	return nil
 }
 
+// lookupCredentials returns the credentials for a tool, consulting the provider
+// when none are configured inline. Provider results are cached.
+func (am *AuthenticationManager) lookupCredentials(tool string) (*Credentials, error) {
+	am.mu.RLock()
+	creds, ok := am.credentials[tool]
+	am.mu.RUnlock()
+	if ok {
+		return creds, nil
+	}
+
+	if am.provider == nil {
+		return nil, fmt.Errorf("no credentials configured for tool: %s", tool)
+	}
+
+	creds, err := am.provider.Get(tool)
+	if err != nil {
+		if errors.Is(err, ErrCredentialNotFound) {
+			return nil, fmt.Errorf("no credentials configured for tool: %s (not found in credential store)", tool)
+		}
+		return nil, fmt.Errorf("failed to load credentials for tool %s: %w", tool, err)
+	}
+
+	am.mu.Lock()
+	am.credentials[tool] = creds
+	am.mu.Unlock()
+
+	return creds, nil
+}
+
 // authenticatedTransport is an http.RoundTripper that adds authentication headers.
 type authenticatedTransport struct {
 	base        http.RoundTripper
@@ -411,3 +411,95 @@ func TestAuthenticatedTransport_PreservesOriginalRequest(t *testing.T) {
 		t.Error("original request custom header was modified")
 	}
 }
+
+// mockCredentialProvider is an in-memory CredentialProvider for testing.
+type mockCredentialProvider struct {
+	credentials map[string]*Credentials
+	calls       int
+}
+
+func (p *mockCredentialProvider) Get(tool string) (*Credentials, error) {
+	p.calls++
+	creds, ok := p.credentials[tool]
+	if !ok {
+		return nil, ErrCredentialNotFound
+	}
+	return creds, nil
+}
+
+// TestAuthenticationManager_ProviderFallback tests that tools without inline credentials
+// are resolved through the credential provider and cached.
+func TestAuthenticationManager_ProviderFallback(t *testing.T) {
+	provider := &mockCredentialProvider{
+		credentials: map[string]*Credentials{
+			"jira": {Type: TokenAuth, Token: "stored-token"},
+		},
+	}
+
+	config := &Config{
+		Tools: ToolsConfig{
+			Jira: &ToolConfig{
+				BaseURL: "https://jira.example.com",
+				Auth:    &AuthConfig{Source: CredentialSourceStore},
+			},
+			Confluence: &ToolConfig{
+				BaseURL: "https://confluence.example.com",
+				Auth:    &AuthConfig{Type: "token", Token: "inline-token"},
+			},
+		},
+	}
+
+	am := NewAuthenticationManagerFromConfigWithProvider(config, provider)
+
+	if _, ok := am.credentials["jira"]; ok {
+		t.Fatal("store-backed credentials should not be loaded from config")
+	}
+
+	if err := am.ValidateCredentials("jira"); err != nil {
+		t.Fatalf("ValidateCredentials(jira) error = %v", err)
+	}
+	if _, err := am.GetAuthenticatedClient("jira"); err != nil {
+		t.Fatalf("GetAuthenticatedClient(jira) error = %v", err)
+	}
+	if provider.calls != 1 {
+		t.Errorf("provider called %d times, want 1 (cached)", provider.calls)
+	}
+
+	if err := am.ValidateCredentials("confluence"); err != nil {
+		t.Fatalf("ValidateCredentials(confluence) error = %v", err)
+	}
+	if provider.calls != 1 {
+		t.Errorf("provider consulted for inline credentials")
+	}
+
+	err := am.ValidateCredentials("bamboo")
+	if err == nil {
+		t.Fatal("expected error for tool missing from the store")
+	}
+	if !contains(err.Error(), "not found in credential store") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+// TestCredentialsMarshalRoundTrip tests the stored credential serialization.
+func TestCredentialsMarshalRoundTrip(t *testing.T) {
+	original := &Credentials{Type: BasicAuth, Username: "user", Password: "pass"}
+
+	data, err := MarshalCredentials(original)
+	if err != nil {
+		t.Fatalf("MarshalCredentials() error = %v", err)
+	}
+
+	restored, err := UnmarshalCredentials(data)
+	if err != nil {
+		t.Fatalf("UnmarshalCredentials() error = %v", err)
+	}
+
+	if *restored != *original {
+		t.Errorf("round trip = %+v, want %+v", restored, original)
+	}
+
+	if _, err := MarshalCredentials(&Credentials{Type: TokenAuth}); err == nil {
+		t.Error("expected error marshaling incomplete credentials")
+	}
+}
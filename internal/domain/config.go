@@ -1,10 +1,15 @@
 package domain
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
+	"regexp"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -12,8 +17,32 @@ import (
 // Config represents the server configuration.
 // This is the root configuration structure loaded from YAML files.
 type Config struct {
-	Transport TransportConfig `yaml:"transport"`
-	Tools     ToolsConfig     `yaml:"tools"`
+	Transport   TransportConfig       `yaml:"transport"`
+	Tools       ToolsConfig           `yaml:"tools"`
+	Credentials CredentialStoreConfig `yaml:"credentials,omitempty"`
+	Startup     StartupConfig         `yaml:"startup,omitempty"`
+}
+
+// StartupConfig defines checks performed before the server starts accepting requests.
+type StartupConfig struct {
+	// VerifyCredentials calls each tool's current-user endpoint at startup
+	// and logs the identity the server will act as.
+	VerifyCredentials bool `yaml:"verify_credentials,omitempty"`
+
+	// OnFailure selects what happens when verification fails:
+	// "fail" (default) exits, "degrade" logs the error and disables the tool.
+	OnFailure string `yaml:"on_failure,omitempty"`
+}
+
+// Startup verification failure modes for StartupConfig.OnFailure.
+const (
+	StartupFailFast = "fail"
+	StartupDegrade  = "degrade"
+)
+
+// FailFast reports whether a failed startup credential check should stop the server.
+func (sc StartupConfig) FailFast() bool {
+	return sc.OnFailure != StartupDegrade
 }
 
 // TransportConfig defines transport settings.
@@ -28,8 +57,40 @@ type TransportConfig struct {
 type HTTPConfig struct {
 	Host string `yaml:"host"`
 	Port int    `yaml:"port"`
+
+	TLS  *ListenerTLSConfig  `yaml:"tls,omitempty"`  // Optional - serve HTTPS instead of HTTP
+	Auth *ListenerAuthConfig `yaml:"auth,omitempty"` // Optional - require a bearer token or JWT
+
+	// AllowedOrigins lists the browser origins (e.g. "https://app.example.com") that may
+	// call the server. Requests carrying any other Origin header are rejected.
+	// When empty, only localhost origins are accepted; "*" accepts any origin.
+	AllowedOrigins []string `yaml:"allowed_origins,omitempty"`
+}
+
+// ListenerTLSConfig defines the certificate served by the HTTP transport.
+// The files are re-read automatically when they change on disk.
+type ListenerTLSConfig struct {
+	CertFile   string `yaml:"cert_file"`
+	KeyFile    string `yaml:"key_file"`
+	MinVersion string `yaml:"min_version,omitempty"` // "1.2" (default) or "1.3"
 }
 
+// ListenerAuthConfig defines how MCP clients authenticate to the HTTP transport.
+type ListenerAuthConfig struct {
+	Type     string `yaml:"type"`                // "bearer" or "jwt"
+	Token    string `yaml:"token,omitempty"`     // Static bearer token (bearer)
+	TokenEnv string `yaml:"token_env,omitempty"` // Environment variable holding the bearer token (bearer)
+	JWKSFile string `yaml:"jwks_file,omitempty"` // Local JWKS file with the signing keys (jwt)
+	Issuer   string `yaml:"issuer,omitempty"`    // Required "iss" claim (jwt, optional)
+	Audience string `yaml:"audience,omitempty"`  // Required "aud" claim (jwt, optional)
+}
+
+// Listener authentication types for ListenerAuthConfig.Type.
+const (
+	ListenerAuthBearer = "bearer"
+	ListenerAuthJWT    = "jwt"
+)
+
 // ToolsConfig defines Atlassian tool configurations.
 // Each tool is optional - only configured tools will be available.
 type ToolsConfig struct {
@@ -43,15 +104,86 @@ type ToolsConfig struct {
 type ToolConfig struct {
 	BaseURL string      `yaml:"base_url"`
 	Auth    *AuthConfig `yaml:"auth,omitempty"` // Optional - if not provided, client must provide credentials
+
+	TLS        *TLSConfig        `yaml:"tls,omitempty"`        // Optional custom CA, client certificate and TLS settings
+	Proxy      *ProxyConfig      `yaml:"proxy,omitempty"`      // Optional proxy; environment variables are used otherwise
+	Connection *ConnectionConfig `yaml:"connection,omitempty"` // Optional connection pooling and timeout tuning
+
+	// MaxSearchResults caps the issues fetched by jira_search_jql with all: true (Jira only).
+	MaxSearchResults int `yaml:"max_search_results,omitempty"`
+}
+
+// TLSConfig defines TLS settings for connections to an Atlassian tool.
+// All fields are optional; the system trust store and Go defaults are used otherwise.
+type TLSConfig struct {
+	CAFile     string `yaml:"ca_file,omitempty"`     // PEM bundle trusted in addition to the system roots
+	CertFile   string `yaml:"cert_file,omitempty"`   // PEM client certificate for mutual TLS
+	KeyFile    string `yaml:"key_file,omitempty"`    // PEM private key for the client certificate
+	MinVersion string `yaml:"min_version,omitempty"` // "1.2" (default) or "1.3"
+	ServerName string `yaml:"server_name,omitempty"` // Overrides the name used to verify the server certificate
+
+	// InsecureSkipVerify disables server certificate verification.
+	// Only for testing; the server logs a warning when it is enabled.
+	InsecureSkipVerify bool `yaml:"insecure_skip_verify,omitempty"`
+}
+
+// ProxyConfig defines the HTTP(S) proxy for connections to an Atlassian tool.
+// When omitted, the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables apply.
+type ProxyConfig struct {
+	URL     string `yaml:"url,omitempty"`      // Proxy URL, e.g. http://proxy.example.com:3128
+	NoProxy string `yaml:"no_proxy,omitempty"` // Comma-separated hosts, domains and CIDRs to reach directly
+}
+
+// ConnectionConfig tunes connection pooling and timeouts for an Atlassian tool.
+// Zero values keep the defaults below.
+type ConnectionConfig struct {
+	Timeout               time.Duration `yaml:"timeout,omitempty"`                 // Overall request timeout (default: none)
+	DialTimeout           time.Duration `yaml:"dial_timeout,omitempty"`            // TCP connect timeout (default: 30s)
+	TLSHandshakeTimeout   time.Duration `yaml:"tls_handshake_timeout,omitempty"`   // Default: 10s
+	ResponseHeaderTimeout time.Duration `yaml:"response_header_timeout,omitempty"` // Default: none
+	IdleConnTimeout       time.Duration `yaml:"idle_conn_timeout,omitempty"`       // Default: 90s
+	MaxIdleConns          int           `yaml:"max_idle_conns,omitempty"`          // Default: 100
+	MaxIdleConnsPerHost   int           `yaml:"max_idle_conns_per_host,omitempty"` // Default: 10
+	MaxConnsPerHost       int           `yaml:"max_conns_per_host,omitempty"`      // Default: unlimited
 }
 
 // AuthConfig defines authentication settings.
 // Supports both basic authentication and token-based authentication.
+// When Source is "store", the secrets are read from the configured credential store
+// instead of this file.
 type AuthConfig struct {
 	Type     string `yaml:"type"` // "basic" or "token"
 	Username string `yaml:"username,omitempty"`
 	Password string `yaml:"password,omitempty"`
 	Token    string `yaml:"token,omitempty"`
+	Source   string `yaml:"source,omitempty"` // "config" (default) or "store"
+}
+
+// CredentialStoreConfig defines where credentials referenced with `source: store` are kept.
+// Leave Backend empty to keep all credentials inline in the configuration file.
+type CredentialStoreConfig struct {
+	Backend       string `yaml:"backend,omitempty"`        // "file" or "keyring"
+	Path          string `yaml:"path,omitempty"`           // Encrypted file location (file backend)
+	PassphraseEnv string `yaml:"passphrase_env,omitempty"` // Environment variable holding the file passphrase
+	Service       string `yaml:"service,omitempty"`        // Secret Service collection attribute (keyring backend)
+}
+
+// Default settings for the credential store.
+const (
+	DefaultCredentialsFile          = "credentials.enc"
+	DefaultCredentialsPassphraseEnv = "ATLASSIAN_MCP_PASSPHRASE"
+	DefaultCredentialsService       = "atlassian-mcp-server"
+)
+
+// Credential sources for AuthConfig.Source.
+const (
+	CredentialSourceConfig = "config"
+	CredentialSourceStore  = "store"
+)
+
+// UsesStore reports whether the secrets for this auth configuration live in the credential store.
+func (ac *AuthConfig) UsesStore() bool {
+	return ac != nil && ac.Source == CredentialSourceStore
 }
 
 // AuthType defines supported authentication methods.
@@ -101,9 +233,9 @@ func LoadConfig(path string) (*Config, error) {
 	}
 
 	// Parse YAML
-	var config Config
-	if err := yaml.Unmarshal(data, &config); err != nil {
-		return nil, fmt.Errorf("invalid YAML syntax in configuration file: %w", err)
+	config, err := decodeConfig(data)
+	if err != nil {
+		return nil, err
 	}
 
 	// Validate the configuration
@@ -111,9 +243,57 @@ func LoadConfig(path string) (*Config, error) {
 		return nil, fmt.Errorf("configuration validation failed: %w", err)
 	}
 
+	return config, nil
+}
+
+// unknownFieldPattern matches yaml.v3 errors for keys without a matching struct field.
+var unknownFieldPattern = regexp.MustCompile(`field (\S+) not found in type \S+`)
+
+// decodeConfig strictly decodes a YAML configuration document.
+// Unknown keys (usually typos such as "base_ur1") and values of the wrong type
+// are rejected with the line numbers they appear on.
+func decodeConfig(data []byte) (*Config, error) {
+	var config Config
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&config); err != nil && err != io.EOF {
+		var typeErr *yaml.TypeError
+		if errors.As(err, &typeErr) {
+			problems := make([]string, len(typeErr.Errors))
+			for i, problem := range typeErr.Errors {
+				problems[i] = unknownFieldPattern.ReplaceAllString(problem, "unknown key '$1'")
+			}
+			return nil, fmt.Errorf("invalid configuration file:\n  %s", strings.Join(problems, "\n  "))
+		}
+		return nil, fmt.Errorf("invalid YAML syntax in configuration file: %w", err)
+	}
+
 	return &config, nil
 }
 
+// LoadCredentialStoreConfig reads only the credential store section of a configuration file.
+// It does not validate the rest of the file, so stored credentials can be managed
+// before the tools that use them are fully configured.
+func LoadCredentialStoreConfig(path string) (*CredentialStoreConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("configuration file not found: %s", path)
+		}
+		return nil, fmt.Errorf("failed to read configuration file: %w", err)
+	}
+
+	var partial struct {
+		Credentials CredentialStoreConfig `yaml:"credentials"`
+	}
+	if err := yaml.Unmarshal(data, &partial); err != nil {
+		return nil, fmt.Errorf("invalid YAML syntax in configuration file: %w", err)
+	}
+
+	return &partial.Credentials, nil
+}
+
 // Validate checks the configuration for completeness and correctness.
 // Returns an error describing all validation failures.
 func (c *Config) Validate() error {
@@ -129,6 +309,18 @@ func (c *Config) Validate() error {
 		errors = append(errors, err.Error())
 	}
 
+	// Validate credential store configuration
+	if err := c.validateCredentialStore(); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	// Validate startup checks
+	switch c.Startup.OnFailure {
+	case "", StartupFailFast, StartupDegrade:
+	default:
+		errors = append(errors, fmt.Sprintf("invalid startup on_failure '%s': must be 'fail' or 'degrade'", c.Startup.OnFailure))
+	}
+
 	// Check that at least one tool is configured
 	if c.Tools.Jira == nil && c.Tools.Confluence == nil &&
 		c.Tools.Bitbucket == nil && c.Tools.Bamboo == nil {
@@ -161,6 +353,89 @@ func (c *Config) validateTransport() error {
 		if c.Transport.HTTP.Port <= 0 || c.Transport.HTTP.Port > 65535 {
 			errors = append(errors, fmt.Sprintf("invalid HTTP port %d: must be between 1 and 65535", c.Transport.HTTP.Port))
 		}
+		if err := c.Transport.HTTP.validateSecurity(); err != nil {
+			errors = append(errors, err.Error())
+		}
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("%s", strings.Join(errors, "; "))
+	}
+
+	return nil
+}
+
+// validateSecurity validates the TLS, authentication and origin settings of the HTTP transport.
+func (hc *HTTPConfig) validateSecurity() error {
+	var errors []string
+
+	if hc.TLS != nil {
+		if hc.TLS.CertFile == "" || hc.TLS.KeyFile == "" {
+			errors = append(errors, "HTTP tls cert_file and key_file are required when tls is configured")
+		}
+		switch hc.TLS.MinVersion {
+		case "", "1.2", "1.3":
+		default:
+			errors = append(errors, fmt.Sprintf("HTTP tls min_version '%s' is invalid: must be '1.2' or '1.3'", hc.TLS.MinVersion))
+		}
+	}
+
+	if hc.Auth != nil {
+		switch hc.Auth.Type {
+		case ListenerAuthBearer:
+			if hc.Auth.Token == "" && hc.Auth.TokenEnv == "" {
+				errors = append(errors, "HTTP auth token or token_env is required for bearer auth")
+			}
+		case ListenerAuthJWT:
+			if hc.Auth.JWKSFile == "" {
+				errors = append(errors, "HTTP auth jwks_file is required for jwt auth")
+			}
+		default:
+			errors = append(errors, fmt.Sprintf("HTTP auth type '%s' is invalid: must be 'bearer' or 'jwt'", hc.Auth.Type))
+		}
+	}
+
+	for _, origin := range hc.AllowedOrigins {
+		if origin == "*" {
+			continue
+		}
+		parsedURL, err := url.Parse(origin)
+		if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" ||
+			(parsedURL.Path != "" && parsedURL.Path != "/") {
+			errors = append(errors, fmt.Sprintf("HTTP allowed origin '%s' is invalid: must be scheme://host[:port]", origin))
+		}
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("%s", strings.Join(errors, "; "))
+	}
+
+	return nil
+}
+
+// validateCredentialStore validates the credential store configuration and
+// checks that every tool using `source: store` has a store to read from.
+func (c *Config) validateCredentialStore() error {
+	var errors []string
+
+	switch c.Credentials.Backend {
+	case "", "file", "keyring":
+	default:
+		errors = append(errors, fmt.Sprintf("invalid credentials backend '%s': must be 'file' or 'keyring'", c.Credentials.Backend))
+	}
+
+	if c.Credentials.Backend == "" {
+		tools := map[string]*ToolConfig{
+			"Jira":       c.Tools.Jira,
+			"Confluence": c.Tools.Confluence,
+			"Bitbucket":  c.Tools.Bitbucket,
+			"Bamboo":     c.Tools.Bamboo,
+		}
+		for _, name := range []string{"Jira", "Confluence", "Bitbucket", "Bamboo"} {
+			if tc := tools[name]; tc != nil && tc.Auth.UsesStore() {
+				errors = append(errors, fmt.Sprintf("%s auth source is 'store' but no credentials backend is configured", name))
+			}
+		}
 	}
 
 	if len(errors) > 0 {
@@ -231,6 +506,44 @@ func (tc *ToolConfig) Validate(toolName string) error {
 		}
 	}
 
+	// Validate TLS settings (only if provided)
+	if tc.TLS != nil {
+		switch tc.TLS.MinVersion {
+		case "", "1.2", "1.3":
+		default:
+			errors = append(errors, fmt.Sprintf("%s tls min_version '%s' is invalid: must be '1.2' or '1.3'", toolName, tc.TLS.MinVersion))
+		}
+		if (tc.TLS.CertFile == "") != (tc.TLS.KeyFile == "") {
+			errors = append(errors, fmt.Sprintf("%s tls cert_file and key_file must be set together", toolName))
+		}
+	}
+
+	// Validate proxy settings (only if provided)
+	if tc.Proxy != nil && tc.Proxy.URL != "" {
+		parsedURL, err := url.Parse(tc.Proxy.URL)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("%s proxy url is invalid: %v", toolName, err))
+		} else if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" && parsedURL.Scheme != "socks5" {
+			errors = append(errors, fmt.Sprintf("%s proxy url must use http, https or socks5 scheme", toolName))
+		}
+	}
+
+	// Validate connection tuning (only if provided)
+	if tc.Connection != nil {
+		conn := tc.Connection
+		if conn.Timeout < 0 || conn.DialTimeout < 0 || conn.TLSHandshakeTimeout < 0 ||
+			conn.ResponseHeaderTimeout < 0 || conn.IdleConnTimeout < 0 {
+			errors = append(errors, fmt.Sprintf("%s connection timeouts must not be negative", toolName))
+		}
+		if conn.MaxIdleConns < 0 || conn.MaxIdleConnsPerHost < 0 || conn.MaxConnsPerHost < 0 {
+			errors = append(errors, fmt.Sprintf("%s connection limits must not be negative", toolName))
+		}
+	}
+
+	if tc.MaxSearchResults < 0 {
+		errors = append(errors, fmt.Sprintf("%s max_search_results must not be negative", toolName))
+	}
+
 	if len(errors) > 0 {
 		return fmt.Errorf("%s", strings.Join(errors, "; "))
 	}
@@ -242,6 +555,15 @@ func (tc *ToolConfig) Validate(toolName string) error {
 func (ac *AuthConfig) Validate(toolName string) error {
 	var errors []string
 
+	// Secrets kept in the credential store are validated when they are loaded
+	switch ac.Source {
+	case "", CredentialSourceConfig:
+	case CredentialSourceStore:
+		return nil
+	default:
+		return fmt.Errorf("%s auth source '%s' is invalid: must be 'config' or 'store'", toolName, ac.Source)
+	}
+
 	// Check auth type is specified
 	if ac.Type == "" {
 		errors = append(errors, fmt.Sprintf("%s auth type is required", toolName))
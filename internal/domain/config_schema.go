@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// ConfigSchemaID is the $id of the generated configuration JSON Schema.
+const ConfigSchemaID = "https://github.com/juicemix/atlassian-mcp-server/config.schema.json"
+
+// schemaHint adds documentation and constraints that cannot be derived from
+// the Go types. Hints are keyed by "<TypeName>.<yaml key>".
+type schemaHint struct {
+	Description string
+	Enum        []string
+	Required    bool
+}
+
+// configSchemaHints mirrors the rules enforced by Config.Validate so editors can
+// flag mistakes and offer completions before the server is started.
+var configSchemaHints = map[string]schemaHint{
+	"Config.transport":   {Description: "How MCP clients connect to the server.", Required: true},
+	"Config.tools":       {Description: "Atlassian products to expose. At least one must be configured.", Required: true},
+	"Config.credentials": {Description: "Credential store for tools whose auth uses `source: store`."},
+	"Config.startup":     {Description: "Checks performed before the server accepts requests."},
+
+	"TransportConfig.type": {Description: "Transport used by MCP clients.", Enum: []string{"stdio", "http"}, Required: true},
+	"TransportConfig.http": {Description: "HTTP listener settings (only used when type is \"http\")."},
+
+	"HTTPConfig.host":            {Description: "Interface to listen on, e.g. \"localhost\" or \"0.0.0.0\"."},
+	"HTTPConfig.port":            {Description: "Port to listen on (1-65535)."},
+	"HTTPConfig.tls":             {Description: "Serve HTTPS with the given certificate."},
+	"HTTPConfig.auth":            {Description: "Require MCP clients to authenticate with a bearer token or JWT."},
+	"HTTPConfig.allowed_origins": {Description: "Browser origins allowed to call the server. Empty allows only localhost; \"*\" allows any origin."},
+
+	"ListenerTLSConfig.cert_file":   {Description: "PEM certificate chain; reloaded when the file changes.", Required: true},
+	"ListenerTLSConfig.key_file":    {Description: "PEM private key; reloaded when the file changes.", Required: true},
+	"ListenerTLSConfig.min_version": {Description: "Minimum TLS version.", Enum: []string{"1.2", "1.3"}},
+
+	"ListenerAuthConfig.type":      {Description: "Client authentication method.", Enum: []string{ListenerAuthBearer, ListenerAuthJWT}, Required: true},
+	"ListenerAuthConfig.token":     {Description: "Static bearer token (bearer)."},
+	"ListenerAuthConfig.token_env": {Description: "Environment variable holding the bearer token (bearer)."},
+	"ListenerAuthConfig.jwks_file": {Description: "Local JWKS file with the JWT signing keys (jwt)."},
+	"ListenerAuthConfig.issuer":    {Description: "Required \"iss\" claim (jwt)."},
+	"ListenerAuthConfig.audience":  {Description: "Required \"aud\" claim (jwt)."},
+
+	"ToolsConfig.jira":       {Description: "Jira Server / Data Center."},
+	"ToolsConfig.confluence": {Description: "Confluence Server / Data Center."},
+	"ToolsConfig.bitbucket":  {Description: "Bitbucket Server / Data Center."},
+	"ToolsConfig.bamboo":     {Description: "Bamboo Server / Data Center."},
+
+	"ToolConfig.base_url":           {Description: "Base URL of the product, e.g. https://jira.example.com.", Required: true},
+	"ToolConfig.auth":               {Description: "Default credentials. Without them, clients must pass credentials per request (Jira only)."},
+	"ToolConfig.tls":                {Description: "Custom CA, client certificate and TLS settings for this product."},
+	"ToolConfig.proxy":              {Description: "HTTP(S) proxy for this product. Environment variables are used when omitted."},
+	"ToolConfig.connection":         {Description: "Connection pooling and timeout tuning for this product."},
+	"ToolConfig.max_search_results": {Description: "Maximum issues fetched by jira_search_jql with all: true (Jira only, default: 1000)."},
+
+	"TLSConfig.ca_file":              {Description: "PEM bundle trusted in addition to the system roots."},
+	"TLSConfig.cert_file":            {Description: "PEM client certificate for mutual TLS (requires key_file)."},
+	"TLSConfig.key_file":             {Description: "PEM private key for the client certificate (requires cert_file)."},
+	"TLSConfig.min_version":          {Description: "Minimum TLS version.", Enum: []string{"1.2", "1.3"}},
+	"TLSConfig.server_name":          {Description: "Name used to verify the server certificate when it differs from base_url."},
+	"TLSConfig.insecure_skip_verify": {Description: "Disable certificate verification. For testing only."},
+
+	"ProxyConfig.url":      {Description: "Proxy URL (http, https or socks5)."},
+	"ProxyConfig.no_proxy": {Description: "Comma-separated hosts, domain suffixes and CIDRs to reach directly."},
+
+	"ConnectionConfig.timeout":                 {Description: "Overall request timeout (default: none)."},
+	"ConnectionConfig.dial_timeout":            {Description: "TCP connect timeout (default: 30s)."},
+	"ConnectionConfig.tls_handshake_timeout":   {Description: "TLS handshake timeout (default: 10s)."},
+	"ConnectionConfig.response_header_timeout": {Description: "Time to wait for response headers (default: none)."},
+	"ConnectionConfig.idle_conn_timeout":       {Description: "How long idle connections are kept (default: 90s)."},
+	"ConnectionConfig.max_idle_conns":          {Description: "Maximum idle connections (default: 100)."},
+	"ConnectionConfig.max_idle_conns_per_host": {Description: "Maximum idle connections per host (default: 10)."},
+	"ConnectionConfig.max_conns_per_host":      {Description: "Maximum connections per host (default: unlimited)."},
+
+	"AuthConfig.type":     {Description: "Authentication method.", Enum: []string{"basic", "token"}},
+	"AuthConfig.username": {Description: "Username (basic)."},
+	"AuthConfig.password": {Description: "Password (basic)."},
+	"AuthConfig.token":    {Description: "Personal access token (token)."},
+	"AuthConfig.source":   {Description: "Where the secrets are kept.", Enum: []string{CredentialSourceConfig, CredentialSourceStore}},
+
+	"CredentialStoreConfig.backend":        {Description: "Credential store backend.", Enum: []string{"file", "keyring"}},
+	"CredentialStoreConfig.path":           {Description: "Encrypted credentials file (file backend, default: " + DefaultCredentialsFile + ")."},
+	"CredentialStoreConfig.passphrase_env": {Description: "Environment variable holding the file passphrase (default: " + DefaultCredentialsPassphraseEnv + ")."},
+	"CredentialStoreConfig.service":        {Description: "Secret Service attribute for keyring entries (default: " + DefaultCredentialsService + ")."},
+
+	"StartupConfig.verify_credentials": {Description: "Call each product's current-user endpoint at startup."},
+	"StartupConfig.on_failure":         {Description: "What to do when verification fails.", Enum: []string{StartupFailFast, StartupDegrade}},
+}
+
+// durationPattern matches Go duration strings such as "30s" or "1m30s".
+const durationPattern = `^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+
+// GenerateConfigSchema returns the JSON Schema (draft-07) describing config.yaml.
+// The schema is derived from the Config types, so new settings appear automatically;
+// descriptions and allowed values come from configSchemaHints.
+func GenerateConfigSchema() map[string]interface{} {
+	schema := structSchema(reflect.TypeOf(Config{}))
+	schema["$schema"] = "http://json-schema.org/draft-07/schema#"
+	schema["$id"] = ConfigSchemaID
+	schema["title"] = "Atlassian MCP Server configuration"
+	return schema
+}
+
+// MarshalConfigSchema returns the configuration JSON Schema as indented JSON.
+func MarshalConfigSchema() ([]byte, error) {
+	data, err := json.MarshalIndent(GenerateConfigSchema(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// structSchema builds an object schema from the yaml-tagged fields of a struct type.
+func structSchema(t reflect.Type) map[string]interface{} {
+	properties := make(map[string]interface{})
+	var required []string
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		key := strings.Split(field.Tag.Get("yaml"), ",")[0]
+		if key == "" || key == "-" || !field.IsExported() {
+			continue
+		}
+
+		prop := typeSchema(field.Type)
+		hint := configSchemaHints[t.Name()+"."+key]
+		if hint.Description != "" {
+			prop["description"] = hint.Description
+		}
+		if len(hint.Enum) > 0 {
+			prop["enum"] = hint.Enum
+		}
+		if hint.Required {
+			required = append(required, key)
+		}
+
+		properties[key] = prop
+	}
+
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// typeSchema maps a Go field type to its JSON Schema.
+func typeSchema(t reflect.Type) map[string]interface{} {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == reflect.TypeOf(time.Duration(0)) {
+		return map[string]interface{}{"type": "string", "pattern": durationPattern}
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		return structSchema(t)
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": typeSchema(t.Elem())}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	default:
+		return map[string]interface{}{"type": "string"}
+	}
+}
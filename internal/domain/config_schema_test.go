@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestConfigSchema_MatchesCommittedFile ensures config.schema.json is regenerated
+// whenever the configuration types change.
+func TestConfigSchema_MatchesCommittedFile(t *testing.T) {
+	generated, err := MarshalConfigSchema()
+	if err != nil {
+		t.Fatalf("MarshalConfigSchema() error = %v", err)
+	}
+
+	committed, err := os.ReadFile(filepath.Join("..", "..", "config.schema.json"))
+	if err != nil {
+		t.Fatalf("failed to read config.schema.json: %v", err)
+	}
+
+	if !bytes.Equal(generated, committed) {
+		t.Error("config.schema.json is out of date; regenerate it with: go run . config-schema > config.schema.json")
+	}
+}
+
+func TestConfigSchema_Structure(t *testing.T) {
+	schema := GenerateConfigSchema()
+
+	if schema["additionalProperties"] != false {
+		t.Error("root schema should reject unknown keys")
+	}
+	if !reflect.DeepEqual(schema["required"], []string{"transport", "tools"}) {
+		t.Errorf("root required = %v, want [transport tools]", schema["required"])
+	}
+
+	properties := schema["properties"].(map[string]interface{})
+	transport := properties["transport"].(map[string]interface{})
+	transportType := transport["properties"].(map[string]interface{})["type"].(map[string]interface{})
+	if !reflect.DeepEqual(transportType["enum"], []string{"stdio", "http"}) {
+		t.Errorf("transport.type enum = %v", transportType["enum"])
+	}
+
+	jira := properties["tools"].(map[string]interface{})["properties"].(map[string]interface{})["jira"].(map[string]interface{})
+	jiraProps := jira["properties"].(map[string]interface{})
+	if !reflect.DeepEqual(jira["required"], []string{"base_url"}) {
+		t.Errorf("jira required = %v, want [base_url]", jira["required"])
+	}
+
+	timeout := jiraProps["connection"].(map[string]interface{})["properties"].(map[string]interface{})["timeout"].(map[string]interface{})
+	if timeout["type"] != "string" || timeout["pattern"] != durationPattern {
+		t.Errorf("connection.timeout schema = %v, want duration string", timeout)
+	}
+
+	origins := transport["properties"].(map[string]interface{})["http"].(map[string]interface{})["properties"].(map[string]interface{})["allowed_origins"].(map[string]interface{})
+	if origins["type"] != "array" {
+		t.Errorf("allowed_origins type = %v, want array", origins["type"])
+	}
+}
+
+// TestConfigSchema_HintsReferenceExistingFields guards against hints left behind
+// after a setting is renamed or removed.
+func TestConfigSchema_HintsReferenceExistingFields(t *testing.T) {
+	fields := make(map[string]bool)
+	var collect func(t reflect.Type)
+	collect = func(t reflect.Type) {
+		for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct || t.PkgPath() != reflect.TypeOf(Config{}).PkgPath() {
+			return
+		}
+		for i := 0; i < t.NumField(); i++ {
+			key := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]
+			fields[t.Name()+"."+key] = true
+			collect(t.Field(i).Type)
+		}
+	}
+	collect(reflect.TypeOf(Config{}))
+
+	for key := range configSchemaHints {
+		if !fields[key] {
+			t.Errorf("schema hint %q does not match any configuration field", key)
+		}
+	}
+	for key := range fields {
+		if _, ok := configSchemaHints[key]; !ok {
+			t.Errorf("configuration field %q has no schema description", key)
+		}
+	}
+}
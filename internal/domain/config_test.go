@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 // TestLoadConfig_ValidYAML tests loading a valid YAML configuration file.
@@ -611,3 +612,269 @@ func containsHelper(s, substr string) bool {
 	}
 	return false
 }
+
+// TestValidate_CredentialStoreSource tests validation of auth configs backed by the credential store.
+func TestValidate_CredentialStoreSource(t *testing.T) {
+	newConfig := func(backend string) *Config {
+		return &Config{
+			Transport: TransportConfig{Type: "stdio"},
+			Tools: ToolsConfig{
+				Jira: &ToolConfig{
+					BaseURL: "https://jira.example.com",
+					Auth:    &AuthConfig{Source: "store"},
+				},
+			},
+			Credentials: CredentialStoreConfig{Backend: backend},
+		}
+	}
+
+	if err := newConfig("file").Validate(); err != nil {
+		t.Errorf("Validate() with file backend error = %v, want nil", err)
+	}
+
+	if err := newConfig("keyring").Validate(); err != nil {
+		t.Errorf("Validate() with keyring backend error = %v, want nil", err)
+	}
+
+	err := newConfig("").Validate()
+	if err == nil || !contains(err.Error(), "no credentials backend is configured") {
+		t.Errorf("Validate() without backend error = %v, want missing backend error", err)
+	}
+
+	err = newConfig("vault").Validate()
+	if err == nil || !contains(err.Error(), "invalid credentials backend") {
+		t.Errorf("Validate() with unknown backend error = %v, want invalid backend error", err)
+	}
+
+	config := newConfig("file")
+	config.Tools.Jira.Auth.Source = "env"
+	err = config.Validate()
+	if err == nil || !contains(err.Error(), "auth source 'env' is invalid") {
+		t.Errorf("Validate() with unknown source error = %v, want invalid source error", err)
+	}
+}
+
+// TestLoadCredentialStoreConfig tests reading only the credential store section.
+func TestLoadCredentialStoreConfig(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	content := `
+credentials:
+  backend: file
+  path: /etc/atlassian-mcp/credentials.enc
+  passphrase_env: MY_PASSPHRASE
+tools: {}
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test config file: %v", err)
+	}
+
+	storeConfig, err := LoadCredentialStoreConfig(configPath)
+	if err != nil {
+		t.Fatalf("LoadCredentialStoreConfig() error = %v", err)
+	}
+
+	if storeConfig.Backend != "file" || storeConfig.Path != "/etc/atlassian-mcp/credentials.enc" || storeConfig.PassphraseEnv != "MY_PASSPHRASE" {
+		t.Errorf("LoadCredentialStoreConfig() = %+v", storeConfig)
+	}
+}
+
+// TestValidate_StartupOnFailure tests validation of the startup credential check mode.
+func TestValidate_StartupOnFailure(t *testing.T) {
+	for _, mode := range []string{"", "fail", "degrade"} {
+		config := &Config{
+			Transport: TransportConfig{Type: "stdio"},
+			Tools: ToolsConfig{
+				Jira: &ToolConfig{BaseURL: "https://jira.example.com"},
+			},
+			Startup: StartupConfig{VerifyCredentials: true, OnFailure: mode},
+		}
+		if err := config.Validate(); err != nil {
+			t.Errorf("Validate() with on_failure %q error = %v, want nil", mode, err)
+		}
+		if config.Startup.FailFast() != (mode != "degrade") {
+			t.Errorf("FailFast() for %q = %v", mode, config.Startup.FailFast())
+		}
+	}
+
+	config := &Config{
+		Transport: TransportConfig{Type: "stdio"},
+		Tools: ToolsConfig{
+			Jira: &ToolConfig{BaseURL: "https://jira.example.com"},
+		},
+		Startup: StartupConfig{OnFailure: "ignore"},
+	}
+	err := config.Validate()
+	if err == nil || !contains(err.Error(), "invalid startup on_failure 'ignore'") {
+		t.Errorf("Validate() error = %v, want invalid on_failure error", err)
+	}
+}
+
+func TestLoadConfig_ToolTLSAndConnection(t *testing.T) {
+	yamlContent := `
+transport:
+  type: stdio
+tools:
+  jira:
+    base_url: https://jira.example.com
+    tls:
+      ca_file: /etc/ssl/corp-ca.pem
+      min_version: "1.3"
+      server_name: jira.internal
+    proxy:
+      url: http://proxy.example.com:3128
+      no_proxy: localhost,.corp.example.com
+    connection:
+      timeout: 45s
+      max_idle_conns_per_host: 20
+    max_search_results: 5000
+`
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte(yamlContent), 0644); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+
+	config, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	jira := config.Tools.Jira
+	if jira.TLS == nil || jira.TLS.CAFile != "/etc/ssl/corp-ca.pem" || jira.TLS.MinVersion != "1.3" || jira.TLS.ServerName != "jira.internal" {
+		t.Errorf("TLS = %+v", jira.TLS)
+	}
+	if jira.Proxy == nil || jira.Proxy.URL != "http://proxy.example.com:3128" || jira.Proxy.NoProxy != "localhost,.corp.example.com" {
+		t.Errorf("Proxy = %+v", jira.Proxy)
+	}
+	if jira.Connection == nil || jira.Connection.Timeout != 45*time.Second || jira.Connection.MaxIdleConnsPerHost != 20 {
+		t.Errorf("Connection = %+v", jira.Connection)
+	}
+	if jira.MaxSearchResults != 5000 {
+		t.Errorf("MaxSearchResults = %d, want 5000", jira.MaxSearchResults)
+	}
+}
+
+func TestValidate_ToolTLSAndProxy(t *testing.T) {
+	tests := []struct {
+		name    string
+		tool    *ToolConfig
+		wantErr string
+	}{
+		{"invalid min version", &ToolConfig{BaseURL: "https://jira.example.com", TLS: &TLSConfig{MinVersion: "1.1"}}, "Jira tls min_version '1.1' is invalid"},
+		{"cert without key", &ToolConfig{BaseURL: "https://jira.example.com", TLS: &TLSConfig{CertFile: "client.pem"}}, "Jira tls cert_file and key_file must be set together"},
+		{"invalid proxy scheme", &ToolConfig{BaseURL: "https://jira.example.com", Proxy: &ProxyConfig{URL: "ftp://proxy.example.com"}}, "Jira proxy url must use http, https or socks5 scheme"},
+		{"negative timeout", &ToolConfig{BaseURL: "https://jira.example.com", Connection: &ConnectionConfig{Timeout: -time.Second}}, "Jira connection timeouts must not be negative"},
+		{"negative limit", &ToolConfig{BaseURL: "https://jira.example.com", Connection: &ConnectionConfig{MaxConnsPerHost: -1}}, "Jira connection limits must not be negative"},
+		{"negative search ceiling", &ToolConfig{BaseURL: "https://jira.example.com", MaxSearchResults: -1}, "Jira max_search_results must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &Config{
+				Transport: TransportConfig{Type: "stdio"},
+				Tools:     ToolsConfig{Jira: tt.tool},
+			}
+			err := config.Validate()
+			if err == nil || !contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidate_HTTPListenerSecurity(t *testing.T) {
+	tests := []struct {
+		name    string
+		http    HTTPConfig
+		wantErr string
+	}{
+		{"valid", HTTPConfig{Host: "0.0.0.0", Port: 8443,
+			TLS:            &ListenerTLSConfig{CertFile: "server.pem", KeyFile: "server-key.pem"},
+			Auth:           &ListenerAuthConfig{Type: "jwt", JWKSFile: "jwks.json"},
+			AllowedOrigins: []string{"https://app.example.com", "http://localhost:6274"}}, ""},
+		{"tls without key", HTTPConfig{Host: "localhost", Port: 8443, TLS: &ListenerTLSConfig{CertFile: "server.pem"}},
+			"HTTP tls cert_file and key_file are required"},
+		{"invalid auth type", HTTPConfig{Host: "localhost", Port: 8080, Auth: &ListenerAuthConfig{Type: "basic"}},
+			"HTTP auth type 'basic' is invalid"},
+		{"bearer without token", HTTPConfig{Host: "localhost", Port: 8080, Auth: &ListenerAuthConfig{Type: "bearer"}},
+			"HTTP auth token or token_env is required"},
+		{"jwt without jwks", HTTPConfig{Host: "localhost", Port: 8080, Auth: &ListenerAuthConfig{Type: "jwt"}},
+			"HTTP auth jwks_file is required"},
+		{"origin with path", HTTPConfig{Host: "localhost", Port: 8080, AllowedOrigins: []string{"https://app.example.com/mcp"}},
+			"HTTP allowed origin 'https://app.example.com/mcp' is invalid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &Config{
+				Transport: TransportConfig{Type: "http", HTTP: tt.http},
+				Tools:     ToolsConfig{Jira: &ToolConfig{BaseURL: "https://jira.example.com"}},
+			}
+			err := config.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_RejectsUnknownKeys(t *testing.T) {
+	yamlContent := `transport:
+  type: stdio
+tools:
+  jira:
+    base_ur1: https://jira.example.com
+    auth:
+      type: token
+      tokn: secret
+`
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(configPath, []byte(yamlContent), 0644); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+
+	_, err := LoadConfig(configPath)
+	if err == nil {
+		t.Fatal("LoadConfig() error = nil, want unknown key error")
+	}
+	for _, want := range []string{"line 5: unknown key 'base_ur1'", "line 8: unknown key 'tokn'"} {
+		if !contains(err.Error(), want) {
+			t.Errorf("LoadConfig() error = %v, want %q", err, want)
+		}
+	}
+}
+
+func TestLoadConfig_RejectsWrongTypes(t *testing.T) {
+	yamlContent := `transport:
+  type: http
+  http:
+    host: localhost
+    port: eighty
+tools:
+  jira:
+    base_url: https://jira.example.com
+`
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(configPath, []byte(yamlContent), 0644); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+
+	_, err := LoadConfig(configPath)
+	if err == nil || !contains(err.Error(), "line 5") {
+		t.Errorf("LoadConfig() error = %v, want type error on line 5", err)
+	}
+}
+
+func TestLoadConfig_ExampleFilesAreStrictlyValid(t *testing.T) {
+	for _, name := range []string{"config.example.yaml", "config.no-credentials.example.yaml"} {
+		if _, err := LoadConfig(filepath.Join("..", "..", name)); err != nil {
+			t.Errorf("LoadConfig(%s) error = %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrCredentialNotFound is returned by a CredentialStore when no credentials
+// are stored for the requested tool.
+var ErrCredentialNotFound = errors.New("credentials not found")
+
+// CredentialProvider supplies credentials for an Atlassian tool on demand.
+// The AuthenticationManager consults its provider for any tool that has no
+// credentials configured inline in config.yaml.
+type CredentialProvider interface {
+	// Get returns the stored credentials for a tool.
+	// Returns ErrCredentialNotFound if the tool has no stored credentials.
+	Get(tool string) (*Credentials, error)
+}
+
+// CredentialStore is a CredentialProvider that can also be managed,
+// e.g. by the "credentials" CLI subcommand.
+// Implementations keep secrets outside of the configuration file
+// (an encrypted local file or the operating system keyring).
+type CredentialStore interface {
+	CredentialProvider
+
+	// Set stores credentials for a tool, replacing any existing entry.
+	Set(tool string, creds *Credentials) error
+
+	// Remove deletes the credentials stored for a tool.
+	// Returns ErrCredentialNotFound if the tool has no stored credentials.
+	Remove(tool string) error
+
+	// List returns the names of all tools with stored credentials, sorted.
+	List() ([]string, error)
+}
+
+// storedCredentials is the serialized form of Credentials used by credential stores.
+type storedCredentials struct {
+	Type     string `json:"type"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Token    string `json:"token,omitempty"`
+}
+
+// MarshalCredentials converts credentials to the JSON-compatible form persisted by stores.
+func MarshalCredentials(creds *Credentials) ([]byte, error) {
+	if err := validateCredentials(creds); err != nil {
+		return nil, err
+	}
+	return json.Marshal(storedCredentials{
+		Type:     creds.Type.String(),
+		Username: creds.Username,
+		Password: creds.Password,
+		Token:    creds.Token,
+	})
+}
+
+// UnmarshalCredentials parses credentials previously produced by MarshalCredentials.
+func UnmarshalCredentials(data []byte) (*Credentials, error) {
+	var stored storedCredentials
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, err
+	}
+
+	creds := &Credentials{
+		Type:     ParseAuthType(stored.Type),
+		Username: stored.Username,
+		Password: stored.Password,
+		Token:    stored.Token,
+	}
+	if err := validateCredentials(creds); err != nil {
+		return nil, err
+	}
+
+	return creds, nil
+}
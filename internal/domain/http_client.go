@@ -0,0 +1,236 @@
+package domain
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// Connection defaults applied when ConnectionConfig leaves a value unset.
+const (
+	defaultDialTimeout         = 30 * time.Second
+	defaultKeepAlive           = 30 * time.Second
+	defaultTLSHandshakeTimeout = 10 * time.Second
+	defaultIdleConnTimeout     = 90 * time.Second
+	defaultMaxIdleConns        = 100
+	defaultMaxIdleConnsPerHost = 10
+)
+
+// NewToolTransport builds the base HTTP transport for an Atlassian tool from its
+// TLS, proxy and connection settings. Returns an error if certificate files
+// cannot be loaded or settings are invalid.
+func NewToolTransport(tc *ToolConfig) (*http.Transport, error) {
+	conn := ConnectionConfig{}
+	if tc != nil && tc.Connection != nil {
+		conn = *tc.Connection
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   durationOrDefault(conn.DialTimeout, defaultDialTimeout),
+		KeepAlive: defaultKeepAlive,
+	}
+
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		TLSHandshakeTimeout:   durationOrDefault(conn.TLSHandshakeTimeout, defaultTLSHandshakeTimeout),
+		ResponseHeaderTimeout: conn.ResponseHeaderTimeout,
+		IdleConnTimeout:       durationOrDefault(conn.IdleConnTimeout, defaultIdleConnTimeout),
+		MaxIdleConns:          intOrDefault(conn.MaxIdleConns, defaultMaxIdleConns),
+		MaxIdleConnsPerHost:   intOrDefault(conn.MaxIdleConnsPerHost, defaultMaxIdleConnsPerHost),
+		MaxConnsPerHost:       conn.MaxConnsPerHost,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+
+	if tc == nil {
+		return transport, nil
+	}
+
+	if tc.TLS != nil {
+		tlsConfig, err := buildTLSConfig(tc.TLS)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	if tc.Proxy != nil && tc.Proxy.URL != "" {
+		proxy, err := proxyFunc(tc.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = proxy
+	}
+
+	return transport, nil
+}
+
+// buildTLSConfig converts TLS settings to a crypto/tls configuration.
+func buildTLSConfig(cfg *TLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         cfg.ServerName,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	switch cfg.MinVersion {
+	case "", "1.2":
+	case "1.3":
+		tlsConfig.MinVersion = tls.VersionTLS13
+	default:
+		return nil, fmt.Errorf("unsupported TLS min_version '%s': must be '1.2' or '1.3'", cfg.MinVersion)
+	}
+
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA file: %s", cfg.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		if cfg.CertFile == "" || cfg.KeyFile == "" {
+			return nil, fmt.Errorf("both cert_file and key_file are required for a client certificate")
+		}
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// proxyFunc returns a proxy selector for an explicitly configured proxy.
+// Requests to hosts matching NoProxy bypass the proxy.
+func proxyFunc(cfg *ProxyConfig) (func(*http.Request) (*url.URL, error), error) {
+	proxyURL, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy url: %w", err)
+	}
+	if proxyURL.Scheme != "http" && proxyURL.Scheme != "https" && proxyURL.Scheme != "socks5" {
+		return nil, fmt.Errorf("proxy url must use http, https or socks5 scheme")
+	}
+
+	noProxy := parseNoProxy(cfg.NoProxy)
+
+	return func(req *http.Request) (*url.URL, error) {
+		if noProxy.matches(req.URL) {
+			return nil, nil
+		}
+		return proxyURL, nil
+	}, nil
+}
+
+// noProxyList holds parsed NO_PROXY entries.
+type noProxyList struct {
+	all     bool
+	domains []string // lower-case host names or ".suffix" entries, optionally with ":port"
+	cidrs   []*net.IPNet
+}
+
+// parseNoProxy parses a comma-separated NO_PROXY value.
+// Supported entries: "*", host names, domain suffixes (".example.com" or "example.com"
+// which also matches subdomains), IP addresses, CIDR ranges and any of those with ":port".
+func parseNoProxy(value string) noProxyList {
+	var list noProxyList
+
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if entry == "*" {
+			list.all = true
+			continue
+		}
+		if _, cidr, err := net.ParseCIDR(entry); err == nil {
+			list.cidrs = append(list.cidrs, cidr)
+			continue
+		}
+		list.domains = append(list.domains, entry)
+	}
+
+	return list
+}
+
+// matches reports whether a request URL should bypass the proxy.
+func (l noProxyList) matches(u *url.URL) bool {
+	if l.all {
+		return true
+	}
+
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+	if port == "" {
+		if u.Scheme == "https" {
+			port = "443"
+		} else {
+			port = "80"
+		}
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		for _, cidr := range l.cidrs {
+			if cidr.Contains(ip) {
+				return true
+			}
+		}
+	}
+
+	for _, entry := range l.domains {
+		entryHost, entryPort := entry, ""
+		if h, p, err := net.SplitHostPort(entry); err == nil {
+			entryHost, entryPort = h, p
+		}
+		if entryPort != "" && entryPort != port {
+			continue
+		}
+
+		entryHost = strings.TrimPrefix(entryHost, "*")
+		if strings.HasPrefix(entryHost, ".") {
+			if strings.HasSuffix(host, entryHost) || host == entryHost[1:] {
+				return true
+			}
+			continue
+		}
+		if host == entryHost || strings.HasSuffix(host, "."+entryHost) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// durationOrDefault returns d, or def when d is not positive.
+func durationOrDefault(d, def time.Duration) time.Duration {
+	if d > 0 {
+		return d
+	}
+	return def
+}
+
+// intOrDefault returns n, or def when n is not positive.
+func intOrDefault(n, def int) int {
+	if n > 0 {
+		return n
+	}
+	return def
+}
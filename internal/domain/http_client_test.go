@@ -0,0 +1,367 @@
+package domain
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeServerCA writes the certificate of a TLS test server to a PEM file.
+func writeServerCA(t *testing.T, server *httptest.Server) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatalf("failed to write CA file: %v", err)
+	}
+	return path
+}
+
+// writeClientCert generates a self-signed client certificate and returns the
+// certificate, certificate file and key file paths.
+func writeClientCert(t *testing.T) (*x509.Certificate, string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "atlassian-mcp-test-client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client-key.pem")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatalf("failed to write certificate: %v", err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+
+	return cert, certFile, keyFile
+}
+
+// doGet performs a GET request through a transport built from the tool config.
+func doGet(t *testing.T, tc *ToolConfig, target string) error {
+	t.Helper()
+	transport, err := NewToolTransport(tc)
+	if err != nil {
+		t.Fatalf("NewToolTransport() error = %v", err)
+	}
+	defer transport.CloseIdleConnections()
+
+	resp, err := (&http.Client{Transport: transport}).Get(target)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func TestNewToolTransport_CustomCA(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	if err := doGet(t, &ToolConfig{}, server.URL); err == nil {
+		t.Error("expected certificate error without custom CA")
+	}
+
+	tc := &ToolConfig{TLS: &TLSConfig{CAFile: writeServerCA(t, server)}}
+	if err := doGet(t, tc, server.URL); err != nil {
+		t.Errorf("request with custom CA failed: %v", err)
+	}
+}
+
+func TestNewToolTransport_ServerNameOverride(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	caFile := writeServerCA(t, server)
+
+	// The test certificate is issued for example.com
+	tc := &ToolConfig{TLS: &TLSConfig{CAFile: caFile, ServerName: "example.com"}}
+	if err := doGet(t, tc, server.URL); err != nil {
+		t.Errorf("request with matching server name failed: %v", err)
+	}
+
+	tc = &ToolConfig{TLS: &TLSConfig{CAFile: caFile, ServerName: "jira.internal"}}
+	if err := doGet(t, tc, server.URL); err == nil {
+		t.Error("expected certificate error for mismatched server name")
+	}
+}
+
+func TestNewToolTransport_InsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	tc := &ToolConfig{TLS: &TLSConfig{InsecureSkipVerify: true}}
+	if err := doGet(t, tc, server.URL); err != nil {
+		t.Errorf("request with insecure_skip_verify failed: %v", err)
+	}
+}
+
+func TestNewToolTransport_ClientCertificate(t *testing.T) {
+	clientCert, certFile, keyFile := writeClientCert(t)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 || r.TLS.PeerCertificates[0].Subject.CommonName != "atlassian-mcp-test-client" {
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+	caFile := writeServerCA(t, server)
+
+	if err := doGet(t, &ToolConfig{TLS: &TLSConfig{CAFile: caFile}}, server.URL); err == nil {
+		t.Error("expected handshake failure without client certificate")
+	}
+
+	tc := &ToolConfig{TLS: &TLSConfig{CAFile: caFile, CertFile: certFile, KeyFile: keyFile}}
+	if err := doGet(t, tc, server.URL); err != nil {
+		t.Errorf("request with client certificate failed: %v", err)
+	}
+}
+
+func TestNewToolTransport_MinVersion(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.TLS = &tls.Config{MaxVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+	caFile := writeServerCA(t, server)
+
+	if err := doGet(t, &ToolConfig{TLS: &TLSConfig{CAFile: caFile, MinVersion: "1.2"}}, server.URL); err != nil {
+		t.Errorf("TLS 1.2 request failed: %v", err)
+	}
+	if err := doGet(t, &ToolConfig{TLS: &TLSConfig{CAFile: caFile, MinVersion: "1.3"}}, server.URL); err == nil {
+		t.Error("expected handshake failure when server only supports TLS 1.2")
+	}
+}
+
+func TestNewToolTransport_InvalidSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		tc      *ToolConfig
+		wantErr string
+	}{
+		{"missing CA file", &ToolConfig{TLS: &TLSConfig{CAFile: filepath.Join(t.TempDir(), "missing.pem")}}, "failed to read CA file"},
+		{"cert without key", &ToolConfig{TLS: &TLSConfig{CertFile: "client.pem"}}, "both cert_file and key_file"},
+		{"bad min version", &ToolConfig{TLS: &TLSConfig{MinVersion: "1.0"}}, "unsupported TLS min_version"},
+		{"bad proxy scheme", &ToolConfig{Proxy: &ProxyConfig{URL: "ftp://proxy:21"}}, "proxy url must use"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewToolTransport(tt.tc)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("NewToolTransport() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("CA file without certificates", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "empty.pem")
+		if err := os.WriteFile(path, []byte("not a certificate"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		_, err := NewToolTransport(&ToolConfig{TLS: &TLSConfig{CAFile: path}})
+		if err == nil || !strings.Contains(err.Error(), "no certificates found") {
+			t.Errorf("NewToolTransport() error = %v, want no certificates error", err)
+		}
+	})
+}
+
+func TestNewToolTransport_ConnectionSettings(t *testing.T) {
+	transport, err := NewToolTransport(nil)
+	if err != nil {
+		t.Fatalf("NewToolTransport(nil) error = %v", err)
+	}
+	if transport.MaxIdleConns != defaultMaxIdleConns || transport.MaxIdleConnsPerHost != defaultMaxIdleConnsPerHost {
+		t.Errorf("default pool = %d/%d, want %d/%d", transport.MaxIdleConns, transport.MaxIdleConnsPerHost, defaultMaxIdleConns, defaultMaxIdleConnsPerHost)
+	}
+	if transport.IdleConnTimeout != defaultIdleConnTimeout || transport.TLSHandshakeTimeout != defaultTLSHandshakeTimeout {
+		t.Errorf("default timeouts = %v/%v", transport.IdleConnTimeout, transport.TLSHandshakeTimeout)
+	}
+
+	transport, err = NewToolTransport(&ToolConfig{Connection: &ConnectionConfig{
+		TLSHandshakeTimeout:   5 * time.Second,
+		ResponseHeaderTimeout: 20 * time.Second,
+		IdleConnTimeout:       time.Minute,
+		MaxIdleConns:          50,
+		MaxIdleConnsPerHost:   25,
+		MaxConnsPerHost:       40,
+	}})
+	if err != nil {
+		t.Fatalf("NewToolTransport() error = %v", err)
+	}
+	if transport.TLSHandshakeTimeout != 5*time.Second || transport.ResponseHeaderTimeout != 20*time.Second || transport.IdleConnTimeout != time.Minute {
+		t.Errorf("timeouts not applied: %v/%v/%v", transport.TLSHandshakeTimeout, transport.ResponseHeaderTimeout, transport.IdleConnTimeout)
+	}
+	if transport.MaxIdleConns != 50 || transport.MaxIdleConnsPerHost != 25 || transport.MaxConnsPerHost != 40 {
+		t.Errorf("pool limits not applied: %d/%d/%d", transport.MaxIdleConns, transport.MaxIdleConnsPerHost, transport.MaxConnsPerHost)
+	}
+}
+
+func TestNewToolTransport_Proxy(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A forward proxy receives the absolute target URL
+		proxied = append(proxied, r.URL.String())
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	tc := &ToolConfig{Proxy: &ProxyConfig{URL: proxy.URL, NoProxy: "direct.example.com"}}
+	if err := doGet(t, tc, "http://jira.example.com/rest/api/2/myself"); err != nil {
+		t.Fatalf("proxied request failed: %v", err)
+	}
+	if len(proxied) != 1 || proxied[0] != "http://jira.example.com/rest/api/2/myself" {
+		t.Errorf("proxy saw %v, want the Jira request", proxied)
+	}
+
+	transport, err := NewToolTransport(tc)
+	if err != nil {
+		t.Fatalf("NewToolTransport() error = %v", err)
+	}
+	req, _ := http.NewRequest("GET", "http://direct.example.com/", nil)
+	proxyURL, err := transport.Proxy(req)
+	if err != nil || proxyURL != nil {
+		t.Errorf("Proxy() for NO_PROXY host = %v, %v; want direct connection", proxyURL, err)
+	}
+}
+
+func TestNoProxyMatches(t *testing.T) {
+	list := parseNoProxy("localhost, .corp.example.com, atlassian.net, 10.0.0.0/8, build.example.org:8443")
+
+	tests := []struct {
+		target string
+		want   bool
+	}{
+		{"http://localhost:8080/", true},
+		{"https://jira.corp.example.com/", true},
+		{"https://corp.example.com/", true},
+		{"https://example.com/", false},
+		{"https://acme.atlassian.net/", true},
+		{"https://atlassian.net/", true},
+		{"https://notatlassian.net/", false},
+		{"http://10.1.2.3/", true},
+		{"http://192.168.1.1/", false},
+		{"https://build.example.org:8443/", true},
+		{"https://build.example.org/", false},
+	}
+
+	for _, tt := range tests {
+		u, err := url.Parse(tt.target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := list.matches(u); got != tt.want {
+			t.Errorf("matches(%s) = %v, want %v", tt.target, got, tt.want)
+		}
+	}
+
+	all := parseNoProxy("*")
+	if u, _ := url.Parse("https://anything.example.com/"); !all.matches(u) {
+		t.Error("'*' should bypass the proxy for every host")
+	}
+}
+
+func TestAuthenticationManager_ToolTransport(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer jira-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Tools: ToolsConfig{
+			Jira: &ToolConfig{
+				BaseURL:    server.URL,
+				Auth:       &AuthConfig{Type: "token", Token: "jira-token"},
+				TLS:        &TLSConfig{CAFile: writeServerCA(t, server)},
+				Connection: &ConnectionConfig{Timeout: 15 * time.Second},
+			},
+		},
+	}
+	am := NewAuthenticationManagerFromConfig(config)
+
+	client, err := am.GetAuthenticatedClient("jira")
+	if err != nil {
+		t.Fatalf("GetAuthenticatedClient() error = %v", err)
+	}
+	if client.Timeout != 15*time.Second {
+		t.Errorf("client timeout = %v, want 15s", client.Timeout)
+	}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("request through configured CA failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200", resp.StatusCode)
+	}
+
+	// Client-provided credentials share the tool's transport
+	other, err := am.GetAuthenticatedClientForTool("jira", &Credentials{Type: TokenAuth, Token: "jira-token"})
+	if err != nil {
+		t.Fatalf("GetAuthenticatedClientForTool() error = %v", err)
+	}
+	if client.Transport.(*authenticatedTransport).base != other.Transport.(*authenticatedTransport).base {
+		t.Error("clients for the same tool should share one base transport")
+	}
+}
+
+func TestAuthenticationManager_ToolTransportError(t *testing.T) {
+	config := &Config{
+		Tools: ToolsConfig{
+			Jira: &ToolConfig{
+				BaseURL: "https://jira.example.com",
+				Auth:    &AuthConfig{Type: "token", Token: "t"},
+				TLS:     &TLSConfig{CAFile: filepath.Join(t.TempDir(), "missing.pem")},
+			},
+		},
+	}
+	am := NewAuthenticationManagerFromConfig(config)
+
+	_, err := am.GetAuthenticatedClient("jira")
+	if err == nil || !strings.Contains(err.Error(), "failed to configure HTTP transport for tool jira") {
+		t.Errorf("GetAuthenticatedClient() error = %v, want transport error", err)
+	}
+}
@@ -0,0 +1,12 @@
+package domain
+
+// Identity describes the account a configured Atlassian tool acts as.
+// It is resolved from each product's current-user endpoint at startup
+// and by the <tool>_whoami tools.
+type Identity struct {
+	Tool        string   `json:"tool"`
+	Username    string   `json:"username"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Email       string   `json:"email,omitempty"`
+	Permissions []string `json:"permissions,omitempty"` // Granted permission keys, where the product reports them
+}
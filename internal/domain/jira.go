@@ -3,6 +3,7 @@ package domain
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // FlexibleID is a type that can unmarshal both string and numeric IDs from JSON.
@@ -38,9 +39,17 @@ type JiraIssue struct {
 	ID     FlexibleID `json:"id"`
 	Key    string     `json:"key"`
 	Fields JiraFields `json:"fields"`
+
+	// Names maps field IDs (e.g. "customfield_10002") to their display names.
+	Names map[string]string `json:"names,omitempty"`
+
+	// RenderedFields holds the HTML of text fields when requested with expand=renderedFields.
+	RenderedFields map[string]json.RawMessage `json:"renderedFields,omitempty"`
 }
 
 // JiraFields contains all the field data for a Jira issue.
+// Fields without a dedicated struct member (components, labels, customfield_*, ...)
+// are kept in Extra so they survive decoding and are included when re-encoded.
 type JiraFields struct {
 	Summary     string    `json:"summary"`
 	Description string    `json:"description"`
@@ -51,19 +60,48 @@ type JiraFields struct {
 	Reporter    *User     `json:"reporter,omitempty"`
 	Created     string    `json:"created"`
 	Updated     string    `json:"updated"`
+
+	Extra map[string]json.RawMessage `json:"-"` // Other non-null fields keyed by field ID
+}
+
+// UnmarshalJSON decodes the modelled fields and collects all others in Extra.
+func (f *JiraFields) UnmarshalJSON(data []byte) error {
+	type plain JiraFields
+	if err := json.Unmarshal(data, (*plain)(f)); err != nil {
+		return err
+	}
+
+	extra, err := unmarshalExtraFields(data, plain{})
+	if err != nil {
+		return err
+	}
+	f.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the modelled fields followed by the fields in Extra.
+func (f JiraFields) MarshalJSON() ([]byte, error) {
+	type plain JiraFields
+	return marshalWithExtraFields(plain(f), f.Extra)
 }
 
 // IssueType represents a Jira issue type (e.g., Bug, Story, Task).
 type IssueType struct {
-	ID   FlexibleID `json:"id"`
-	Name string     `json:"name"`
+	ID      FlexibleID `json:"id"`
+	Name    string     `json:"name"`
+	Subtask bool       `json:"subtask,omitempty"`
 }
 
 // Project represents a Jira project.
+// Versions and components are only included when the project is fetched individually.
 type Project struct {
-	ID   FlexibleID `json:"id"`
-	Key  string     `json:"key"`
-	Name string     `json:"name"`
+	ID          FlexibleID    `json:"id"`
+	Key         string        `json:"key"`
+	Name        string        `json:"name"`
+	Description string        `json:"description,omitempty"`
+	Lead        *User         `json:"lead,omitempty"`
+	Versions    []JiraVersion `json:"versions,omitempty"`
+	Components  []Component   `json:"components,omitempty"`
 }
 
 // Status represents a Jira issue status (e.g., Open, In Progress, Done).
@@ -85,6 +123,49 @@ type SearchResults struct {
 	Total      int         `json:"total"`
 	StartAt    int         `json:"startAt"`
 	MaxResults int         `json:"maxResults"`
+	Truncated  bool        `json:"truncated,omitempty"` // Set when fetching all pages stopped at the ceiling
+}
+
+// CompactIssue is the key, summary, status and assignee of an issue.
+type CompactIssue struct {
+	Key      string `json:"key"`
+	Summary  string `json:"summary"`
+	Status   string `json:"status"`
+	Assignee string `json:"assignee,omitempty"` // Username, empty if unassigned
+}
+
+// CompactSearchResults are search results reduced to CompactIssues.
+type CompactSearchResults struct {
+	Issues     []CompactIssue `json:"issues"`
+	Total      int            `json:"total"`
+	StartAt    int            `json:"startAt"`
+	MaxResults int            `json:"maxResults"`
+	Truncated  bool           `json:"truncated,omitempty"`
+}
+
+// CompactIssueFields are the fields CompactSearch needs.
+var CompactIssueFields = []string{"summary", "status", "assignee"}
+
+// CompactSearch projects search results onto CompactIssues.
+func CompactSearch(results *SearchResults) *CompactSearchResults {
+	compact := &CompactSearchResults{
+		Issues:     make([]CompactIssue, len(results.Issues)),
+		Total:      results.Total,
+		StartAt:    results.StartAt,
+		MaxResults: results.MaxResults,
+		Truncated:  results.Truncated,
+	}
+	for i, issue := range results.Issues {
+		compact.Issues[i] = CompactIssue{
+			Key:     issue.Key,
+			Summary: issue.Fields.Summary,
+			Status:  issue.Fields.Status.Name,
+		}
+		if issue.Fields.Assignee != nil {
+			compact.Issues[i].Assignee = issue.Fields.Assignee.Name
+		}
+	}
+	return compact
 }
 
 // JiraIssueCreate represents the request body for creating a new Jira issue.
@@ -99,6 +180,20 @@ type JiraFieldsCreate struct {
 	IssueType   IssueTypeRef `json:"issuetype"`
 	Project     ProjectRef   `json:"project"`
 	Assignee    *UserRef     `json:"assignee,omitempty"`
+	Reporter    *UserRef     `json:"reporter,omitempty"`
+	Priority    *PriorityRef `json:"priority,omitempty"`
+	Labels      []string     `json:"labels,omitempty"`
+	DueDate     string       `json:"duedate,omitempty"` // YYYY-MM-DD
+	Environment string       `json:"environment,omitempty"`
+	Parent      *IssueRef    `json:"parent,omitempty"` // Parent issue (sub-tasks only)
+
+	Extra map[string]interface{} `json:"-"` // Additional field values keyed by field ID
+}
+
+// MarshalJSON encodes the modelled fields followed by the fields in Extra.
+func (f JiraFieldsCreate) MarshalJSON() ([]byte, error) {
+	type plain JiraFieldsCreate
+	return marshalWithExtraFields(plain(f), f.Extra)
 }
 
 // IssueTypeRef is a reference to an issue type (used in create/update operations).
@@ -113,11 +208,22 @@ type ProjectRef struct {
 	Key string `json:"key,omitempty"`
 }
 
+// IssueRef is a reference to an issue by key (used in create and link operations).
+type IssueRef struct {
+	Key string `json:"key"`
+}
+
 // UserRef is a reference to a user (used in create/update operations).
 type UserRef struct {
 	Name string `json:"name"`
 }
 
+// PriorityRef is a reference to a priority by name or ID (used in create/update operations).
+type PriorityRef struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
 // JiraIssueUpdate represents the request body for updating a Jira issue.
 type JiraIssueUpdate struct {
 	Fields JiraFieldsUpdate `json:"fields,omitempty"`
@@ -125,20 +231,92 @@ type JiraIssueUpdate struct {
 }
 
 // JiraFieldsUpdate contains the fields that can be updated on an issue.
+// Fields are cleared with a set operation in JiraIssueUpdate.Update.
 type JiraFieldsUpdate struct {
-	Summary     string   `json:"summary,omitempty"`
-	Description string   `json:"description,omitempty"`
-	Assignee    *UserRef `json:"assignee,omitempty"`
+	Summary     string       `json:"summary,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Assignee    *UserRef     `json:"assignee,omitempty"`
+	Reporter    *UserRef     `json:"reporter,omitempty"`
+	Priority    *PriorityRef `json:"priority,omitempty"`
+	DueDate     string       `json:"duedate,omitempty"` // YYYY-MM-DD
+	Environment string       `json:"environment,omitempty"`
+
+	Extra map[string]interface{} `json:"-"` // Additional field values keyed by field ID
+}
+
+// MarshalJSON encodes the modelled fields followed by the fields in Extra.
+func (f JiraFieldsUpdate) MarshalJSON() ([]byte, error) {
+	type plain JiraFieldsUpdate
+	return marshalWithExtraFields(plain(f), f.Extra)
+}
+
+// Update operation verbs.
+const (
+	UpdateAdd    = "add"
+	UpdateRemove = "remove"
+	UpdateSet    = "set"
+)
+
+// JiraUpdateOps holds update operations keyed by field ID, applied in order, e.g.
+// {"labels": [{"add": "triaged"}, {"remove": "new"}], "duedate": [{"set": null}]}.
+// Operations change part of a field (one label, one component) without replacing it.
+type JiraUpdateOps map[string][]UpdateOperation
+
+// UpdateOperation is a single add, remove or set operation on a field.
+type UpdateOperation struct {
+	Verb  string      // UpdateAdd, UpdateRemove or UpdateSet
+	Value interface{} // The value; nil with UpdateSet clears the field
+}
+
+// MarshalJSON encodes the operation as {"<verb>": value}.
+func (o UpdateOperation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{o.Verb: o.Value})
+}
+
+// UnmarshalJSON decodes an operation encoded as {"<verb>": value}.
+func (o *UpdateOperation) UnmarshalJSON(data []byte) error {
+	var operation map[string]interface{}
+	if err := json.Unmarshal(data, &operation); err != nil {
+		return err
+	}
+	if len(operation) != 1 {
+		return fmt.Errorf("update operation must have exactly one verb, got %d", len(operation))
+	}
+	for verb, value := range operation {
+		o.Verb, o.Value = verb, value
+	}
+	return nil
 }
 
-// JiraUpdateOps contains update operations for complex field updates.
-type JiraUpdateOps struct {
-	// This can be extended with specific update operations as needed
+// Add appends an operation adding value to a multi-value field.
+func (u *JiraUpdateOps) Add(field string, value interface{}) {
+	u.append(field, UpdateAdd, value)
+}
+
+// Remove appends an operation removing value from a multi-value field.
+func (u *JiraUpdateOps) Remove(field string, value interface{}) {
+	u.append(field, UpdateRemove, value)
+}
+
+// Set appends an operation replacing the value of a field; nil clears it.
+func (u *JiraUpdateOps) Set(field string, value interface{}) {
+	u.append(field, UpdateSet, value)
+}
+
+// append adds an operation, creating the map if needed.
+func (u *JiraUpdateOps) append(field, verb string, value interface{}) {
+	if *u == nil {
+		*u = make(JiraUpdateOps)
+	}
+	(*u)[field] = append((*u)[field], UpdateOperation{Verb: verb, Value: value})
 }
 
 // IssueTransition represents a workflow transition request.
+// Fields and Update set values on the transition screen, e.g. the resolution or a comment.
 type IssueTransition struct {
-	Transition TransitionRef `json:"transition"`
+	Transition TransitionRef                       `json:"transition"`
+	Fields     map[string]interface{}              `json:"fields,omitempty"`
+	Update     map[string][]map[string]interface{} `json:"update,omitempty"`
 }
 
 // TransitionRef is a reference to a workflow transition.
@@ -148,6 +326,43 @@ type TransitionRef struct {
 }
 
 // Comment represents a comment on a Jira issue.
+// Only Body and Visibility are sent when adding or updating a comment.
 type Comment struct {
-	Body string `json:"body"`
+	ID           FlexibleID         `json:"id,omitempty"`
+	Body         string             `json:"body"`
+	RenderedBody string             `json:"renderedBody,omitempty"` // HTML, with expand=renderedBody
+	Author       *User              `json:"author,omitempty"`
+	UpdateAuthor *User              `json:"updateAuthor,omitempty"`
+	Created      string             `json:"created,omitempty"`
+	Updated      string             `json:"updated,omitempty"`
+	Visibility   *CommentVisibility `json:"visibility,omitempty"`
+}
+
+// Comment visibility restriction types.
+const (
+	CommentVisibilityRole  = "role"
+	CommentVisibilityGroup = "group"
+)
+
+// CommentVisibility restricts a comment to members of a project role or group.
+type CommentVisibility struct {
+	Type  string `json:"type"`  // "role" or "group"
+	Value string `json:"value"` // Role or group name, e.g. "Developers"
+}
+
+// CommentPage is a page of comments on an issue.
+type CommentPage struct {
+	StartAt    int       `json:"startAt"`
+	MaxResults int       `json:"maxResults"`
+	Total      int       `json:"total"`
+	Comments   []Comment `json:"comments"`
+}
+
+// ProjectKeyFromIssueKey returns the project part of an issue key ("TEST" for "TEST-123").
+func ProjectKeyFromIssueKey(issueKey string) (string, bool) {
+	separator := strings.LastIndex(issueKey, "-")
+	if separator <= 0 || separator == len(issueKey)-1 {
+		return "", false
+	}
+	return issueKey[:separator], true
 }
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Board is a Jira Software scrum or kanban board.
+type Board struct {
+	ID       int            `json:"id"`
+	Name     string         `json:"name"`
+	Type     string         `json:"type"` // scrum or kanban
+	Location *BoardLocation `json:"location,omitempty"`
+}
+
+// BoardLocation identifies the project a board belongs to.
+type BoardLocation struct {
+	ProjectID   int    `json:"projectId,omitempty"`
+	ProjectKey  string `json:"projectKey,omitempty"`
+	ProjectName string `json:"projectName,omitempty"`
+}
+
+// BoardPage is a page of boards from /rest/agile/1.0/board.
+type BoardPage struct {
+	StartAt    int     `json:"startAt"`
+	MaxResults int     `json:"maxResults"`
+	Total      int     `json:"total"`
+	IsLast     bool    `json:"isLast"`
+	Values     []Board `json:"values"`
+}
+
+// Sprint states.
+const (
+	SprintStateFuture = "future"
+	SprintStateActive = "active"
+	SprintStateClosed = "closed"
+)
+
+// Sprint is a sprint of a scrum board.
+type Sprint struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	State         string `json:"state"`
+	StartDate     string `json:"startDate,omitempty"`
+	EndDate       string `json:"endDate,omitempty"`
+	CompleteDate  string `json:"completeDate,omitempty"`
+	OriginBoardID int    `json:"originBoardId,omitempty"`
+	Goal          string `json:"goal,omitempty"`
+}
+
+// SprintPage is a page of sprints from /rest/agile/1.0/board/{id}/sprint.
+type SprintPage struct {
+	StartAt    int      `json:"startAt"`
+	MaxResults int      `json:"maxResults"`
+	IsLast     bool     `json:"isLast"`
+	Values     []Sprint `json:"values"`
+}
+
+// SprintUpdate is a partial update of a sprint; empty fields are left unchanged.
+type SprintUpdate struct {
+	Name      string `json:"name,omitempty"`
+	State     string `json:"state,omitempty"`
+	StartDate string `json:"startDate,omitempty"`
+	EndDate   string `json:"endDate,omitempty"`
+	Goal      string `json:"goal,omitempty"`
+}
+
+// AgileTimeLayout is the timestamp format accepted by the Jira Agile REST API.
+const AgileTimeLayout = "2006-01-02T15:04:05.000Z07:00"
+
+// ParseSprintStates validates a list of sprint states (future, active, closed).
+func ParseSprintStates(states []string) ([]string, error) {
+	parsed := make([]string, 0, len(states))
+	for _, state := range states {
+		state = strings.ToLower(strings.TrimSpace(state))
+		switch state {
+		case SprintStateFuture, SprintStateActive, SprintStateClosed:
+			parsed = append(parsed, state)
+		default:
+			return nil, fmt.Errorf("invalid sprint state '%s' (use future, active or closed)", state)
+		}
+	}
+	return parsed, nil
+}
+
+// RankRequest moves issues before or after another issue in the board ranking.
+// Exactly one of RankBeforeIssue and RankAfterIssue is set.
+type RankRequest struct {
+	Issues          []string `json:"issues"`
+	RankBeforeIssue string   `json:"rankBeforeIssue,omitempty"`
+	RankAfterIssue  string   `json:"rankAfterIssue,omitempty"`
+}
+
+// RankResult reports the outcome of ranking one issue.
+type RankResult struct {
+	IssueID  int      `json:"issueId"`
+	IssueKey string   `json:"issueKey"`
+	Status   int      `json:"status"`
+	Errors   []string `json:"errors,omitempty"`
+}
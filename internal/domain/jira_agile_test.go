@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestParseSprintStates(t *testing.T) {
+	states, err := ParseSprintStates([]string{"Active", " future "})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(states, []string{"active", "future"}) {
+		t.Errorf("Unexpected states: %v", states)
+	}
+
+	if _, err := ParseSprintStates([]string{"open"}); err == nil {
+		t.Error("Expected error for unknown state")
+	}
+}
+
+func TestBoardPage_JSONDeserialization(t *testing.T) {
+	data := []byte(`{
+		"startAt": 0, "maxResults": 50, "total": 1, "isLast": true,
+		"values": [{"id": 7, "name": "TEST board", "type": "scrum",
+			"location": {"projectId": 10000, "projectKey": "TEST", "projectName": "Test Project"}}]
+	}`)
+
+	var page BoardPage
+	if err := json.Unmarshal(data, &page); err != nil {
+		t.Fatalf("Failed to unmarshal BoardPage: %v", err)
+	}
+	if len(page.Values) != 1 || page.Values[0].ID != 7 || page.Values[0].Location.ProjectKey != "TEST" {
+		t.Errorf("Unexpected boards: %+v", page)
+	}
+}
+
+func TestSprintUpdate_OmitsEmptyFields(t *testing.T) {
+	data, err := json.Marshal(SprintUpdate{State: SprintStateClosed})
+	if err != nil {
+		t.Fatalf("Failed to marshal SprintUpdate: %v", err)
+	}
+	if string(data) != `{"state":"closed"}` {
+		t.Errorf("Unexpected payload: %s", data)
+	}
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"mime"
+	"strings"
+)
+
+// Attachment describes a file attached to a Jira issue.
+type Attachment struct {
+	ID        FlexibleID `json:"id"`
+	Filename  string     `json:"filename"`
+	Author    *User      `json:"author,omitempty"`
+	Created   string     `json:"created,omitempty"`
+	Size      int64      `json:"size"`
+	MimeType  string     `json:"mimeType"`
+	Content   string     `json:"content,omitempty"`   // Download URL
+	Thumbnail string     `json:"thumbnail,omitempty"` // Thumbnail URL (images only)
+}
+
+// textMimeTypes lists non-text/* MIME types whose content is readable text.
+var textMimeTypes = map[string]bool{
+	"application/json":         true,
+	"application/xml":          true,
+	"application/javascript":   true,
+	"application/x-javascript": true,
+	"application/x-yaml":       true,
+	"application/yaml":         true,
+	"application/x-sh":         true,
+	"application/sql":          true,
+	"application/x-ndjson":     true,
+	"image/svg+xml":            true,
+}
+
+// IsTextMimeType reports whether content of the given MIME type can be returned as text.
+// Parameters such as charset are ignored.
+func IsTextMimeType(mimeType string) bool {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		mediaType = strings.ToLower(strings.TrimSpace(mimeType))
+	}
+
+	return strings.HasPrefix(mediaType, "text/") ||
+		strings.HasSuffix(mediaType, "+json") ||
+		strings.HasSuffix(mediaType, "+xml") ||
+		textMimeTypes[mediaType]
+}
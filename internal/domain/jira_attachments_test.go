@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIsTextMimeType(t *testing.T) {
+	tests := []struct {
+		mimeType string
+		want     bool
+	}{
+		{"text/plain", true},
+		{"text/csv; charset=UTF-8", true},
+		{"application/json", true},
+		{"application/vnd.api+json", true},
+		{"application/atom+xml", true},
+		{"image/svg+xml", true},
+		{"APPLICATION/XML", true},
+		{"image/png", false},
+		{"application/pdf", false},
+		{"application/octet-stream", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsTextMimeType(tt.mimeType); got != tt.want {
+			t.Errorf("IsTextMimeType(%q) = %v, want %v", tt.mimeType, got, tt.want)
+		}
+	}
+}
+
+func TestAttachment_JSONDeserialization(t *testing.T) {
+	data := []byte(`{
+		"id": 10200,
+		"filename": "screenshot.png",
+		"author": {"name": "jdoe"},
+		"created": "2024-01-01T10:00:00.000+0000",
+		"size": 2048,
+		"mimeType": "image/png",
+		"content": "https://jira.example.com/secure/attachment/10200/screenshot.png"
+	}`)
+
+	var attachment Attachment
+	if err := json.Unmarshal(data, &attachment); err != nil {
+		t.Fatalf("Failed to unmarshal Attachment: %v", err)
+	}
+	if attachment.ID != "10200" || attachment.Size != 2048 || attachment.MimeType != "image/png" {
+		t.Errorf("Unexpected attachment: %+v", attachment)
+	}
+}
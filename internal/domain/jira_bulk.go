@@ -0,0 +1,54 @@
+package domain
+
+// Outcomes of a bulk operation on one issue.
+const (
+	BulkStatusSucceeded = "succeeded"
+	BulkStatusFailed    = "failed"
+	BulkStatusPlanned   = "planned" // Dry run: the change passed validation but was not applied
+)
+
+// BulkIssueResult is the outcome of a bulk operation on one issue.
+type BulkIssueResult struct {
+	IssueKey string `json:"issueKey"`
+	Summary  string `json:"summary,omitempty"`
+	Status   string `json:"status"`
+	Error    string `json:"error,omitempty"`
+}
+
+// BulkResult reports a bulk update, transition or comment issue by issue.
+type BulkResult struct {
+	Operation string            `json:"operation"`
+	DryRun    bool              `json:"dryRun"`
+	Matched   int               `json:"matched"`
+	Succeeded int               `json:"succeeded"`
+	Failed    int               `json:"failed"`
+	Planned   int               `json:"planned,omitempty"`
+	Truncated bool              `json:"truncated"` // True if more issues matched than were processed
+	Issues    []BulkIssueResult `json:"issues"`
+}
+
+// NewBulkResult counts the per-issue outcomes of a bulk operation. matched is the
+// number of issues selected before the cap was applied.
+func NewBulkResult(operation string, dryRun bool, matched int, issues []BulkIssueResult) *BulkResult {
+	if issues == nil {
+		issues = []BulkIssueResult{}
+	}
+	result := &BulkResult{
+		Operation: operation,
+		DryRun:    dryRun,
+		Matched:   matched,
+		Truncated: matched > len(issues),
+		Issues:    issues,
+	}
+	for _, issue := range issues {
+		switch issue.Status {
+		case BulkStatusSucceeded:
+			result.Succeeded++
+		case BulkStatusPlanned:
+			result.Planned++
+		default:
+			result.Failed++
+		}
+	}
+	return result
+}
@@ -0,0 +1,36 @@
+package domain
+
+import "testing"
+
+func TestNewBulkResult(t *testing.T) {
+	result := NewBulkResult("comment", false, 5, []BulkIssueResult{
+		{IssueKey: "TEST-1", Status: BulkStatusSucceeded},
+		{IssueKey: "TEST-2", Status: BulkStatusFailed, Error: "forbidden"},
+		{IssueKey: "TEST-3", Status: BulkStatusSucceeded},
+	})
+
+	if result.Succeeded != 2 || result.Failed != 1 || result.Planned != 0 {
+		t.Errorf("unexpected counts: %+v", result)
+	}
+	if !result.Truncated || result.Matched != 5 {
+		t.Errorf("expected truncated result of 5 matches, got %+v", result)
+	}
+}
+
+func TestNewBulkResult_DryRun(t *testing.T) {
+	result := NewBulkResult("transition", true, 2, []BulkIssueResult{
+		{IssueKey: "TEST-1", Status: BulkStatusPlanned},
+		{IssueKey: "TEST-2", Status: BulkStatusFailed, Error: "no transition"},
+	})
+
+	if !result.DryRun || result.Planned != 1 || result.Failed != 1 || result.Truncated {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestNewBulkResult_Empty(t *testing.T) {
+	result := NewBulkResult("update", false, 0, nil)
+	if result.Issues == nil || len(result.Issues) != 0 {
+		t.Errorf("expected empty issue list, got %v", result.Issues)
+	}
+}
@@ -0,0 +1,197 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ChangelogItem is a single field change within a change history entry.
+type ChangelogItem struct {
+	Field      string `json:"field"`
+	FieldType  string `json:"fieldtype,omitempty"`
+	FieldID    string `json:"fieldId,omitempty"`
+	From       string `json:"from,omitempty"`
+	FromString string `json:"fromString,omitempty"`
+	To         string `json:"to,omitempty"`
+	ToString   string `json:"toString,omitempty"`
+}
+
+// ChangeHistory is a set of field changes made by one user at one time.
+type ChangeHistory struct {
+	ID      FlexibleID      `json:"id"`
+	Author  *User           `json:"author,omitempty"`
+	Created string          `json:"created"`
+	Items   []ChangelogItem `json:"items"`
+}
+
+// Changelog is a page of the change history of an issue, oldest first.
+type Changelog struct {
+	StartAt    int             `json:"startAt"`
+	MaxResults int             `json:"maxResults"`
+	Total      int             `json:"total"`
+	Histories  []ChangeHistory `json:"histories"`
+}
+
+// FieldChange is one field change flattened out of the changelog.
+type FieldChange struct {
+	HistoryID  string `json:"historyId"`
+	Created    string `json:"created"`
+	Author     string `json:"author,omitempty"`     // Username
+	AuthorName string `json:"authorName,omitempty"` // Display name
+	Field      string `json:"field"`
+	From       string `json:"from,omitempty"` // Display value before the change
+	To         string `json:"to,omitempty"`   // Display value after the change
+	FromID     string `json:"fromId,omitempty"`
+	ToID       string `json:"toId,omitempty"`
+}
+
+// IssueChangelog is a page of an issue's change history as flattened field changes.
+// Paging counts history entries, each of which may hold several changes.
+type IssueChangelog struct {
+	IssueKey   string        `json:"issueKey"`
+	StartAt    int           `json:"startAt"`
+	MaxResults int           `json:"maxResults"`
+	Total      int           `json:"total"`
+	Changes    []FieldChange `json:"changes"`
+}
+
+// FlattenChangelog lists the changes in histories, optionally only those to the
+// given fields (matched case-insensitively by name or ID).
+func FlattenChangelog(histories []ChangeHistory, fields []string) []FieldChange {
+	changes := []FieldChange{}
+	for _, history := range histories {
+		for _, item := range history.Items {
+			if len(fields) > 0 && !matchesChangelogField(item, fields) {
+				continue
+			}
+			change := FieldChange{
+				HistoryID: string(history.ID),
+				Created:   history.Created,
+				Field:     item.Field,
+				From:      item.FromString,
+				To:        item.ToString,
+				FromID:    item.From,
+				ToID:      item.To,
+			}
+			if history.Author != nil {
+				change.Author = history.Author.Name
+				change.AuthorName = history.Author.DisplayName
+			}
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// matchesChangelogField reports whether item changes one of fields.
+func matchesChangelogField(item ChangelogItem, fields []string) bool {
+	for _, field := range fields {
+		if strings.EqualFold(item.Field, field) || (item.FieldID != "" && strings.EqualFold(item.FieldID, field)) {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusDuration is the total time an issue spent in one status.
+type StatusDuration struct {
+	Status       string `json:"status"`
+	Seconds      int64  `json:"seconds"`
+	Duration     string `json:"duration"`
+	Visits       int    `json:"visits"`
+	FirstEntered string `json:"firstEntered"`
+}
+
+// TimeInStatus summarizes how long an issue spent in each status.
+type TimeInStatus struct {
+	IssueKey      string           `json:"issueKey"`
+	CurrentStatus string           `json:"currentStatus"`
+	Statuses      []StatusDuration `json:"statuses"` // In order of first entry
+}
+
+// ComputeTimeInStatus walks the status changes in histories from the creation of
+// the issue until now and totals the time spent in each status. Histories may be
+// in any order; entries with unparseable timestamps are ignored.
+func ComputeTimeInStatus(issueKey, created, currentStatus string, histories []ChangeHistory, now time.Time) (*TimeInStatus, error) {
+	start, err := ParseJiraTime(created)
+	if err != nil {
+		return nil, fmt.Errorf("invalid creation time of %s: %w", issueKey, err)
+	}
+
+	type statusChange struct {
+		at       time.Time
+		from, to string
+	}
+	var changes []statusChange
+	for _, history := range histories {
+		at, err := ParseJiraTime(history.Created)
+		if err != nil {
+			continue
+		}
+		for _, item := range history.Items {
+			if strings.EqualFold(item.Field, "status") {
+				changes = append(changes, statusChange{at: at, from: item.FromString, to: item.ToString})
+			}
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].at.Before(changes[j].at) })
+
+	result := &TimeInStatus{
+		IssueKey:      issueKey,
+		CurrentStatus: currentStatus,
+		Statuses:      []StatusDuration{},
+	}
+	index := make(map[string]int)
+	addPeriod := func(status string, from, to time.Time) {
+		i, ok := index[status]
+		if !ok {
+			i = len(result.Statuses)
+			index[status] = i
+			result.Statuses = append(result.Statuses, StatusDuration{
+				Status:       status,
+				FirstEntered: from.Format(JiraTimeLayout),
+			})
+		}
+		if to.After(from) {
+			result.Statuses[i].Seconds += int64(to.Sub(from).Seconds())
+		}
+		result.Statuses[i].Visits++
+	}
+
+	// The issue was created in the status the first change moved it out of
+	status := currentStatus
+	if len(changes) > 0 {
+		status = changes[0].from
+	}
+	for _, change := range changes {
+		addPeriod(status, start, change.at)
+		status, start = change.to, change.at
+	}
+	addPeriod(status, start, now)
+
+	for i := range result.Statuses {
+		result.Statuses[i].Duration = FormatElapsed(result.Statuses[i].Seconds)
+	}
+	return result, nil
+}
+
+// FormatElapsed renders calendar time in days, hours and minutes, e.g. "2d 3h 15m".
+func FormatElapsed(seconds int64) string {
+	days := seconds / 86400
+	hours := (seconds % 86400) / 3600
+	minutes := (seconds % 3600) / 60
+
+	var parts []string
+	if days > 0 {
+		parts = append(parts, fmt.Sprintf("%dd", days))
+	}
+	if hours > 0 {
+		parts = append(parts, fmt.Sprintf("%dh", hours))
+	}
+	if minutes > 0 || len(parts) == 0 {
+		parts = append(parts, fmt.Sprintf("%dm", minutes))
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+var testHistories = []ChangeHistory{
+	{ID: "1", Author: &User{Name: "jdoe", DisplayName: "John Doe"}, Created: "2024-01-01T10:00:00.000+0000", Items: []ChangelogItem{
+		{Field: "status", FieldID: "status", From: "1", FromString: "Open", To: "3", ToString: "In Progress"},
+		{Field: "assignee", FieldID: "assignee", To: "jdoe", ToString: "John Doe"},
+	}},
+	{ID: "2", Author: &User{Name: "asmith"}, Created: "2024-01-03T10:00:00.000+0000", Items: []ChangelogItem{
+		{Field: "status", FromString: "In Progress", ToString: "Open"},
+	}},
+	{ID: "3", Author: &User{Name: "jdoe"}, Created: "2024-01-03T22:00:00.000+0000", Items: []ChangelogItem{
+		{Field: "status", FromString: "Open", ToString: "In Progress"},
+	}},
+	{ID: "4", Author: &User{Name: "jdoe"}, Created: "2024-01-04T10:00:00.000+0000", Items: []ChangelogItem{
+		{Field: "status", FromString: "In Progress", ToString: "Done"},
+		{Field: "resolution", ToString: "Fixed"},
+	}},
+}
+
+func TestFlattenChangelog(t *testing.T) {
+	changes := FlattenChangelog(testHistories, nil)
+	if len(changes) != 6 {
+		t.Fatalf("Expected 6 changes, got %d", len(changes))
+	}
+	first := changes[0]
+	if first.HistoryID != "1" || first.Author != "jdoe" || first.AuthorName != "John Doe" || first.From != "Open" || first.To != "In Progress" || first.ToID != "3" {
+		t.Errorf("Unexpected first change: %+v", first)
+	}
+
+	statusChanges := FlattenChangelog(testHistories, []string{"Status"})
+	if len(statusChanges) != 4 {
+		t.Errorf("Expected 4 status changes, got %+v", statusChanges)
+	}
+	last := statusChanges[3]
+	if last.Author != "jdoe" || last.To != "Done" || last.Created != "2024-01-04T10:00:00.000+0000" {
+		t.Errorf("Unexpected last status change: %+v", last)
+	}
+
+	if changes := FlattenChangelog(nil, nil); changes == nil || len(changes) != 0 {
+		t.Errorf("Expected empty list, got %v", changes)
+	}
+}
+
+func TestComputeTimeInStatus(t *testing.T) {
+	now, _ := time.Parse(JiraTimeLayout, "2024-01-05T10:00:00.000+0000")
+
+	// Histories are deliberately out of order
+	histories := []ChangeHistory{testHistories[2], testHistories[0], testHistories[3], testHistories[1]}
+	result, err := ComputeTimeInStatus("TEST-1", "2023-12-31T10:00:00.000+0000", "Done", histories, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := []StatusDuration{
+		{Status: "Open", Seconds: 86400 + 12*3600, Duration: "1d 12h", Visits: 2, FirstEntered: "2023-12-31T10:00:00.000+0000"},
+		{Status: "In Progress", Seconds: 2*86400 + 12*3600, Duration: "2d 12h", Visits: 2, FirstEntered: "2024-01-01T10:00:00.000+0000"},
+		{Status: "Done", Seconds: 86400, Duration: "1d", Visits: 1, FirstEntered: "2024-01-04T10:00:00.000+0000"},
+	}
+	if len(result.Statuses) != len(want) {
+		t.Fatalf("Expected %d statuses, got %+v", len(want), result.Statuses)
+	}
+	for i := range want {
+		if result.Statuses[i] != want[i] {
+			t.Errorf("Status %d = %+v, want %+v", i, result.Statuses[i], want[i])
+		}
+	}
+}
+
+func TestComputeTimeInStatus_NoChanges(t *testing.T) {
+	now, _ := time.Parse(JiraTimeLayout, "2024-01-01T10:30:00.000+0000")
+	result, err := ComputeTimeInStatus("TEST-1", "2024-01-01T10:00:00.000+0000", "Open", nil, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(result.Statuses) != 1 || result.Statuses[0].Status != "Open" || result.Statuses[0].Duration != "30m" {
+		t.Errorf("Unexpected statuses: %+v", result.Statuses)
+	}
+
+	if _, err := ComputeTimeInStatus("TEST-1", "", "Open", nil, now); err == nil {
+		t.Error("Expected error for missing creation time")
+	}
+}
+
+func TestFormatElapsed(t *testing.T) {
+	tests := []struct {
+		seconds int64
+		want    string
+	}{
+		{0, "0m"},
+		{59, "0m"},
+		{3600, "1h"},
+		{90000, "1d 1h"},
+		{183300, "2d 2h 55m"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatElapsed(tt.seconds); got != tt.want {
+			t.Errorf("FormatElapsed(%d) = %q, want %q", tt.seconds, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,388 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// IssueCopy is the result of cloning or moving an issue.
+type IssueCopy struct {
+	Source      string         `json:"source"`
+	Key         string         `json:"key"`
+	Project     string         `json:"project"`
+	IssueType   string         `json:"issueType"`
+	Status      string         `json:"status,omitempty"` // Only when statuses are mapped
+	Subtasks    []*IssueCopy   `json:"subtasks,omitempty"`
+	Links       []string       `json:"links,omitempty"`       // Copied links, e.g. "blocks TEST-2"
+	Attachments []string       `json:"attachments,omitempty"` // Copied file names
+	Skipped     []SkippedField `json:"skipped,omitempty"`
+}
+
+// SkippedField is a field, link, attachment or sub-task that could not be carried over.
+type SkippedField struct {
+	Field  string `json:"field"`
+	Name   string `json:"name,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// IssueLink is a link as listed in an issue's issuelinks field. Only the other
+// end of the link is set: OutwardIssue for "issue <outward> OutwardIssue" and
+// InwardIssue for "issue <inward> InwardIssue".
+type IssueLink struct {
+	ID           FlexibleID    `json:"id"`
+	Type         IssueLinkType `json:"type"`
+	InwardIssue  *IssueRef     `json:"inwardIssue,omitempty"`
+	OutwardIssue *IssueRef     `json:"outwardIssue,omitempty"`
+}
+
+// String describes the link from the point of view of the issue, e.g. "blocks TEST-2".
+func (l IssueLink) String() string {
+	if l.OutwardIssue != nil {
+		return fmt.Sprintf("%s %s", l.Type.Outward, l.OutwardIssue.Key)
+	}
+	if l.InwardIssue != nil {
+		return fmt.Sprintf("%s %s", l.Type.Inward, l.InwardIssue.Key)
+	}
+	return l.Type.Name
+}
+
+// LinkRequest returns the request that creates the same link on issueKey.
+func (l IssueLink) LinkRequest(issueKey string) *IssueLinkRequest {
+	request := &IssueLinkRequest{
+		Type:         IssueLinkTypeRef{Name: l.Type.Name},
+		InwardIssue:  IssueRef{Key: issueKey},
+		OutwardIssue: IssueRef{Key: issueKey},
+	}
+	if l.OutwardIssue != nil {
+		request.OutwardIssue.Key = l.OutwardIssue.Key
+	}
+	if l.InwardIssue != nil {
+		request.InwardIssue.Key = l.InwardIssue.Key
+	}
+	return request
+}
+
+// IssueLinks decodes the issuelinks field of an issue.
+func (f *JiraFields) IssueLinks() ([]IssueLink, error) {
+	var links []IssueLink
+	if raw, ok := f.Extra["issuelinks"]; ok {
+		if err := json.Unmarshal(raw, &links); err != nil {
+			return nil, fmt.Errorf("failed to decode issue links: %w", err)
+		}
+	}
+	return links, nil
+}
+
+// SubtaskKeys returns the keys of the issue's sub-tasks from its subtasks field.
+func (f *JiraFields) SubtaskKeys() ([]string, error) {
+	var subtasks []IssueRef
+	if raw, ok := f.Extra["subtasks"]; ok {
+		if err := json.Unmarshal(raw, &subtasks); err != nil {
+			return nil, fmt.Errorf("failed to decode sub-tasks: %w", err)
+		}
+	}
+	keys := make([]string, len(subtasks))
+	for i, subtask := range subtasks {
+		keys[i] = subtask.Key
+	}
+	return keys, nil
+}
+
+// ParentKey returns the key of the issue's parent from its parent field, or "" if it has none.
+func (f *JiraFields) ParentKey() (string, error) {
+	var parent IssueRef
+	if raw, ok := f.Extra["parent"]; ok {
+		if err := json.Unmarshal(raw, &parent); err != nil {
+			return "", fmt.Errorf("failed to decode parent: %w", err)
+		}
+	}
+	return parent.Key, nil
+}
+
+// MapIssueType picks the issue type of a copy of an issue with the given type:
+// the type with the same name, or for sub-tasks the project's first sub-task type.
+func MapIssueType(issueTypes []CreateMetaIssueType, projectKey string, source IssueType) (*CreateMetaIssueType, error) {
+	for i := range issueTypes {
+		if strings.EqualFold(issueTypes[i].Name, source.Name) && issueTypes[i].Subtask == source.Subtask {
+			return &issueTypes[i], nil
+		}
+	}
+	if source.Subtask {
+		for i := range issueTypes {
+			if issueTypes[i].Subtask {
+				return &issueTypes[i], nil
+			}
+		}
+		return nil, fmt.Errorf("project %s has no sub-task issue type", projectKey)
+	}
+
+	names := make([]string, 0, len(issueTypes))
+	for _, issueType := range issueTypes {
+		if !issueType.Subtask {
+			names = append(names, issueType.Name)
+		}
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("issue type '%s' is not available in project %s (available: %s)", source.Name, projectKey, strings.Join(names, ", "))
+}
+
+// uncopiedFields are fields that are never copied: they are set explicitly (summary,
+// project, issuetype, parent), maintained by Jira or copied separately.
+var uncopiedFields = map[string]bool{
+	"summary":                       true,
+	"project":                       true,
+	"issuetype":                     true,
+	"parent":                        true,
+	"status":                        true,
+	"statuscategorychangedate":      true,
+	"resolution":                    true,
+	"resolutiondate":                true,
+	"created":                       true,
+	"updated":                       true,
+	"creator":                       true,
+	"lastViewed":                    true,
+	"votes":                         true,
+	"watches":                       true,
+	"comment":                       true,
+	"worklog":                       true,
+	"attachment":                    true,
+	"issuelinks":                    true,
+	"subtasks":                      true,
+	"progress":                      true,
+	"aggregateprogress":             true,
+	"timetracking":                  true,
+	"timespent":                     true,
+	"timeestimate":                  true,
+	"timeoriginalestimate":          true,
+	"aggregatetimespent":            true,
+	"aggregatetimeestimate":         true,
+	"aggregatetimeoriginalestimate": true,
+	"workratio":                     true,
+	"thumbnail":                     true,
+}
+
+// issueContent describes the uncopied fields that hold content of their own, which a
+// copy leaves behind on the original unless it is copied separately.
+var issueContent = []struct {
+	field, item, items string
+}{
+	{"comment", "comment", "comments"},
+	{"worklog", "work log", "work logs"},
+	{"issuelinks", "link", "links"},
+	{"attachment", "attachment", "attachments"},
+}
+
+// UncopiedContent reports the comments and work logs of source, and its links and
+// attachments unless links and attachments say they are copied, as skipped fields.
+func UncopiedContent(source *JiraIssue, links, attachments bool) []SkippedField {
+	var skipped []SkippedField
+	for _, content := range issueContent {
+		if content.field == "issuelinks" && links || content.field == "attachment" && attachments {
+			continue
+		}
+		count := contentCount(source.Fields.Extra[content.field])
+		if count == 0 {
+			continue
+		}
+		items := content.items
+		if count == 1 {
+			items = content.item
+		}
+		skipped = append(skipped, SkippedField{
+			Field:  content.field,
+			Name:   source.Names[content.field],
+			Reason: fmt.Sprintf("%d %s not copied, left on %s", count, items, source.Key),
+		})
+	}
+	return skipped
+}
+
+// contentCount returns the number of items in a list field such as attachment, or
+// the total of a paged field such as comment.
+func contentCount(raw json.RawMessage) int {
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err == nil {
+		return len(items)
+	}
+	var page struct {
+		Total int `json:"total"`
+	}
+	if err := json.Unmarshal(raw, &page); err == nil {
+		return page.Total
+	}
+	return 0
+}
+
+// CopyFieldValues maps the field values of source onto the create screen of issueType
+// in projectKey. Values of fields with allowed values (components, versions, options,
+// priorities) are matched by name, so they carry over between projects. Fields that
+// are not on the create screen or have no matching value are returned as skipped.
+func CopyFieldValues(source *JiraIssue, target []CreateMetaField, issueType, projectKey string) (map[string]interface{}, []SkippedField) {
+	copied := make(map[string]interface{})
+	data, err := json.Marshal(source.Fields)
+	if err != nil {
+		return copied, []SkippedField{{Field: "fields", Reason: err.Error()}}
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return copied, []SkippedField{{Field: "fields", Reason: err.Error()}}
+	}
+
+	screen := make(map[string]*CreateMetaField, len(target))
+	for i := range target {
+		screen[target[i].FieldID] = &target[i]
+	}
+
+	ids := make([]string, 0, len(values))
+	for id := range values {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var skipped []SkippedField
+	for _, id := range ids {
+		value := values[id]
+		if uncopiedFields[id] || isEmptyFieldValue(value) {
+			continue
+		}
+
+		field, ok := screen[id]
+		if !ok {
+			skipped = append(skipped, SkippedField{
+				Field:  id,
+				Name:   source.Names[id],
+				Reason: fmt.Sprintf("not on the create screen of %s in %s", issueType, projectKey),
+			})
+			continue
+		}
+
+		value, reason := copyFieldValue(field, value)
+		if value != nil {
+			copied[id] = value
+		}
+		if reason != "" {
+			skipped = append(skipped, SkippedField{Field: id, Name: field.Name, Reason: reason})
+		}
+	}
+
+	return copied, skipped
+}
+
+// isEmptyFieldValue reports whether a field is unset.
+func isEmptyFieldValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+// copyFieldValue converts a value read from an issue into one accepted when creating
+// an issue with field. A non-empty reason explains values that could not be copied;
+// for arrays the value holds the elements that could.
+func copyFieldValue(field *CreateMetaField, value interface{}) (interface{}, string) {
+	if field.Schema != nil && field.Schema.Custom == customTypeSprint {
+		return nil, "sprints are not copied"
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		copied, ok := copyFieldItem(field, value)
+		if !ok {
+			return nil, fmt.Sprintf("no matching value for '%s'", fieldItemLabel(value))
+		}
+		return copied, ""
+	}
+
+	copied := make([]interface{}, 0, len(items))
+	var unmatched []string
+	for _, item := range items {
+		if value, ok := copyFieldItem(field, item); ok {
+			copied = append(copied, value)
+		} else {
+			unmatched = append(unmatched, fieldItemLabel(item))
+		}
+	}
+	reason := ""
+	if len(unmatched) > 0 {
+		reason = fmt.Sprintf("no matching value for '%s'", strings.Join(unmatched, "', '"))
+	}
+	if len(copied) == 0 {
+		return nil, reason
+	}
+	return copied, reason
+}
+
+// copyFieldItem converts a single value. Scalars are copied as they are; objects
+// become references to the allowed value with the same name, users references by
+// username and other objects references by ID.
+func copyFieldItem(field *CreateMetaField, item interface{}) (interface{}, bool) {
+	object, ok := item.(map[string]interface{})
+	if !ok {
+		return item, true
+	}
+
+	if len(field.AllowedValues) > 0 {
+		allowed, ok := matchAllowedValue(field.AllowedValues, object)
+		if !ok {
+			return nil, false
+		}
+		ref := map[string]interface{}{"id": string(allowed.ID)}
+		if child, ok := object["child"].(map[string]interface{}); ok {
+			ref["child"] = map[string]interface{}{"value": fieldItemLabel(child)}
+		}
+		return ref, true
+	}
+
+	if field.Schema != nil && (field.Schema.Type == "user" || field.Schema.Items == "user") {
+		if name, ok := object["name"].(string); ok {
+			return map[string]interface{}{"name": name}, true
+		}
+		return nil, false
+	}
+	for _, key := range []string{"id", "key", "name", "value"} {
+		if ref, ok := object[key]; ok {
+			return map[string]interface{}{key: ref}, true
+		}
+	}
+	return nil, false
+}
+
+// matchAllowedValue finds the allowed value with the name of object, or failing that its ID.
+func matchAllowedValue(allowed []AllowedValue, object map[string]interface{}) (*AllowedValue, bool) {
+	label := fieldItemLabel(object)
+	for i := range allowed {
+		if label != "" && strings.EqualFold(allowed[i].Label(), label) {
+			return &allowed[i], true
+		}
+	}
+	if id, ok := object["id"].(string); ok {
+		for i := range allowed {
+			if string(allowed[i].ID) == id {
+				return &allowed[i], true
+			}
+		}
+	}
+	return nil, false
+}
+
+// fieldItemLabel returns the name a user knows a value by.
+func fieldItemLabel(item interface{}) string {
+	object, ok := item.(map[string]interface{})
+	if !ok {
+		return fmt.Sprint(item)
+	}
+	for _, key := range []string{"name", "value", "key", "id"} {
+		if label, ok := object[key].(string); ok && label != "" {
+			return label
+		}
+	}
+	return ""
+}
@@ -0,0 +1,191 @@
+package domain
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestJiraFields_IssueLinksAndSubtasks(t *testing.T) {
+	data := []byte(`{
+		"key": "TEST-1",
+		"fields": {
+			"summary": "Source",
+			"issuelinks": [
+				{"id": "1", "type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "outwardIssue": {"key": "TEST-2"}},
+				{"id": "2", "type": {"name": "Relates", "inward": "relates to", "outward": "relates to"}, "inwardIssue": {"key": "OPS-7"}}
+			],
+			"subtasks": [{"id": "10", "key": "TEST-3"}, {"id": "11", "key": "TEST-4"}]
+		}
+	}`)
+
+	var issue JiraIssue
+	if err := json.Unmarshal(data, &issue); err != nil {
+		t.Fatalf("Failed to unmarshal issue: %v", err)
+	}
+
+	links, err := issue.Fields.IssueLinks()
+	if err != nil {
+		t.Fatalf("IssueLinks() error: %v", err)
+	}
+	if len(links) != 2 || links[0].String() != "blocks TEST-2" || links[1].String() != "relates to OPS-7" {
+		t.Fatalf("Unexpected links: %+v", links)
+	}
+
+	// The copy keeps the direction of each link
+	outward := links[0].LinkRequest("PLAT-1")
+	if outward.Type.Name != "Blocks" || outward.InwardIssue.Key != "PLAT-1" || outward.OutwardIssue.Key != "TEST-2" {
+		t.Errorf("Unexpected outward link request: %+v", outward)
+	}
+	inward := links[1].LinkRequest("PLAT-1")
+	if inward.InwardIssue.Key != "OPS-7" || inward.OutwardIssue.Key != "PLAT-1" {
+		t.Errorf("Unexpected inward link request: %+v", inward)
+	}
+
+	keys, err := issue.Fields.SubtaskKeys()
+	if err != nil || !reflect.DeepEqual(keys, []string{"TEST-3", "TEST-4"}) {
+		t.Errorf("SubtaskKeys() = %v, %v", keys, err)
+	}
+
+	if parent, err := issue.Fields.ParentKey(); err != nil || parent != "" {
+		t.Errorf("ParentKey() = %q, %v, want no parent", parent, err)
+	}
+	subtask := JiraFields{Extra: map[string]json.RawMessage{"parent": json.RawMessage(`{"id":"1","key":"TEST-1"}`)}}
+	if parent, err := subtask.ParentKey(); err != nil || parent != "TEST-1" {
+		t.Errorf("ParentKey() = %q, %v, want TEST-1", parent, err)
+	}
+
+	var empty JiraFields
+	if links, err := empty.IssueLinks(); err != nil || len(links) != 0 {
+		t.Errorf("Expected no links, got %v (%v)", links, err)
+	}
+	if keys, err := empty.SubtaskKeys(); err != nil || len(keys) != 0 {
+		t.Errorf("Expected no sub-tasks, got %v (%v)", keys, err)
+	}
+}
+
+func TestMapIssueType(t *testing.T) {
+	issueTypes := []CreateMetaIssueType{
+		{ID: "1", Name: "Bug"},
+		{ID: "2", Name: "Story"},
+		{ID: "5", Name: "Sub-task", Subtask: true},
+	}
+
+	if issueType, err := MapIssueType(issueTypes, "PLAT", IssueType{Name: "bug"}); err != nil || issueType.ID != "1" {
+		t.Errorf("Expected Bug, got %+v (%v)", issueType, err)
+	}
+	if issueType, err := MapIssueType(issueTypes, "PLAT", IssueType{Name: "Technical task", Subtask: true}); err != nil || issueType.ID != "5" {
+		t.Errorf("Expected the sub-task type, got %+v (%v)", issueType, err)
+	}
+
+	_, err := MapIssueType(issueTypes, "PLAT", IssueType{Name: "Epic"})
+	if err == nil || !strings.Contains(err.Error(), "available: Bug, Story") {
+		t.Errorf("Expected the available types in the error, got %v", err)
+	}
+	_, err = MapIssueType(issueTypes[:2], "PLAT", IssueType{Name: "Sub-task", Subtask: true})
+	if err == nil || !strings.Contains(err.Error(), "no sub-task issue type") {
+		t.Errorf("Expected a missing sub-task type error, got %v", err)
+	}
+}
+
+func TestCopyFieldValues(t *testing.T) {
+	data := []byte(`{
+		"key": "TEST-1",
+		"fields": {
+			"summary": "Login fails",
+			"description": "Steps to reproduce",
+			"issuetype": {"id": "1", "name": "Bug"},
+			"project": {"id": "10000", "key": "TEST"},
+			"status": {"id": "3", "name": "In Progress"},
+			"assignee": {"name": "jdoe", "displayName": "John Doe"},
+			"created": "2024-01-01T10:00:00.000+0000",
+			"priority": {"id": "2", "name": "High"},
+			"labels": ["login", "regression"],
+			"components": [{"id": "100", "name": "API"}, {"id": "101", "name": "Legacy"}],
+			"fixVersions": [],
+			"environment": "",
+			"customfield_10001": 5,
+			"customfield_10002": {"id": "300", "value": "Backend", "child": {"id": "301", "value": "Auth"}},
+			"customfield_10003": ["com.atlassian.greenhopper.service.sprint.Sprint@1[id=1,name=Sprint 1]"],
+			"customfield_10004": "internal note",
+			"watches": {"watchCount": 1}
+		},
+		"names": {"customfield_10004": "Internal Notes"}
+	}`)
+	var issue JiraIssue
+	if err := json.Unmarshal(data, &issue); err != nil {
+		t.Fatalf("Failed to unmarshal issue: %v", err)
+	}
+
+	target := []CreateMetaField{
+		{FieldID: "summary", Name: "Summary", Required: true},
+		{FieldID: "description", Name: "Description"},
+		{FieldID: "assignee", Name: "Assignee", Schema: &FieldSchema{Type: "user", System: "assignee"}},
+		{FieldID: "priority", Name: "Priority", AllowedValues: []AllowedValue{{ID: "1", Name: "Highest"}, {ID: "2", Name: "High"}}},
+		{FieldID: "labels", Name: "Labels", Schema: &FieldSchema{Type: "array", Items: "string"}},
+		{FieldID: "components", Name: "Component/s", AllowedValues: []AllowedValue{{ID: "900", Name: "api"}}},
+		{FieldID: "customfield_10001", Name: "Story Points", Schema: &FieldSchema{Type: "number"}},
+		{FieldID: "customfield_10002", Name: "Area", AllowedValues: []AllowedValue{{ID: "700", Value: "Backend"}}},
+		{FieldID: "customfield_10003", Name: "Sprint", Schema: &FieldSchema{Type: "array", Items: "string", Custom: customTypeSprint}},
+	}
+
+	values, skipped := CopyFieldValues(&issue, target, "Bug", "PLAT")
+
+	want := map[string]interface{}{
+		"description":       "Steps to reproduce",
+		"assignee":          map[string]interface{}{"name": "jdoe"},
+		"priority":          map[string]interface{}{"id": "2"},
+		"labels":            []interface{}{"login", "regression"},
+		"components":        []interface{}{map[string]interface{}{"id": "900"}},
+		"customfield_10001": float64(5),
+		"customfield_10002": map[string]interface{}{"id": "700", "child": map[string]interface{}{"value": "Auth"}},
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("CopyFieldValues() values =\n%v\nwant\n%v", values, want)
+	}
+
+	wantSkipped := []SkippedField{
+		{Field: "components", Name: "Component/s", Reason: "no matching value for 'Legacy'"},
+		{Field: "customfield_10003", Name: "Sprint", Reason: "sprints are not copied"},
+		{Field: "customfield_10004", Name: "Internal Notes", Reason: "not on the create screen of Bug in PLAT"},
+	}
+	if !reflect.DeepEqual(skipped, wantSkipped) {
+		t.Errorf("CopyFieldValues() skipped =\n%+v\nwant\n%+v", skipped, wantSkipped)
+	}
+}
+
+func TestCopyFieldValue_NoMatch(t *testing.T) {
+	field := &CreateMetaField{FieldID: "priority", AllowedValues: []AllowedValue{{ID: "1", Name: "Highest"}}}
+
+	value, reason := copyFieldValue(field, map[string]interface{}{"id": "9", "name": "Trivial"})
+	if value != nil || reason != "no matching value for 'Trivial'" {
+		t.Errorf("copyFieldValue() = %v, %q", value, reason)
+	}
+}
+
+func TestUncopiedContent(t *testing.T) {
+	source := &JiraIssue{
+		Key: "TEST-1",
+		Fields: JiraFields{Extra: map[string]json.RawMessage{
+			"comment":    json.RawMessage(`{"startAt":0,"maxResults":3,"total":3,"comments":[{"id":"1"},{"id":"2"},{"id":"3"}]}`),
+			"worklog":    json.RawMessage(`{"startAt":0,"maxResults":0,"total":0,"worklogs":[]}`),
+			"issuelinks": json.RawMessage(`[{"id":"7"}]`),
+			"attachment": json.RawMessage(`[]`),
+		}},
+		Names: map[string]string{"comment": "Comment", "issuelinks": "Linked Issues"},
+	}
+
+	want := []SkippedField{
+		{Field: "comment", Name: "Comment", Reason: "3 comments not copied, left on TEST-1"},
+		{Field: "issuelinks", Name: "Linked Issues", Reason: "1 link not copied, left on TEST-1"},
+	}
+	if skipped := UncopiedContent(source, false, false); !reflect.DeepEqual(skipped, want) {
+		t.Errorf("UncopiedContent() =\n%+v\nwant\n%+v", skipped, want)
+	}
+
+	// Copied links are not reported
+	if skipped := UncopiedContent(source, true, true); !reflect.DeepEqual(skipped, want[:1]) {
+		t.Errorf("UncopiedContent() with links =\n%+v\nwant\n%+v", skipped, want[:1])
+	}
+}
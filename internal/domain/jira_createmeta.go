@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CreateMetaIssueType is an issue type that can be created in a project,
+// as returned by /rest/api/2/issue/createmeta/{project}/issuetypes.
+type CreateMetaIssueType struct {
+	ID          FlexibleID `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	Subtask     bool       `json:"subtask"`
+}
+
+// CreateMetaField describes a field on the create screen of a project and issue type.
+type CreateMetaField struct {
+	FieldID         string         `json:"fieldId"`
+	Name            string         `json:"name"`
+	Required        bool           `json:"required"`
+	HasDefaultValue bool           `json:"hasDefaultValue"`
+	Schema          *FieldSchema   `json:"schema,omitempty"`
+	Operations      []string       `json:"operations,omitempty"`
+	AllowedValues   []AllowedValue `json:"allowedValues,omitempty"`
+}
+
+// AllowedValue is one of the values a field accepts (an option, component, version, priority, ...).
+type AllowedValue struct {
+	ID    FlexibleID `json:"id,omitempty"`
+	Name  string     `json:"name,omitempty"`
+	Value string     `json:"value,omitempty"`
+	Key   string     `json:"key,omitempty"`
+}
+
+// Label returns the text a user would pick the value by.
+func (v AllowedValue) Label() string {
+	switch {
+	case v.Name != "":
+		return v.Name
+	case v.Value != "":
+		return v.Value
+	case v.Key != "":
+		return v.Key
+	default:
+		return string(v.ID)
+	}
+}
+
+// maxListedAllowedValues caps the allowed values listed per field in error messages.
+const maxListedAllowedValues = 20
+
+// ResolveCreateMetaIssueType finds an issue type by ID or by name (case-insensitive).
+// The error lists the issue types available in the project.
+func ResolveCreateMetaIssueType(issueTypes []CreateMetaIssueType, projectKey, nameOrID string) (*CreateMetaIssueType, error) {
+	for i := range issueTypes {
+		if string(issueTypes[i].ID) == nameOrID || strings.EqualFold(issueTypes[i].Name, nameOrID) {
+			return &issueTypes[i], nil
+		}
+	}
+
+	names := make([]string, len(issueTypes))
+	for i, issueType := range issueTypes {
+		names[i] = issueType.Name
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("issue type '%s' is not available in project %s (available: %s)", nameOrID, projectKey, strings.Join(names, ", "))
+}
+
+// MissingRequiredFields returns the required fields without a default value
+// whose IDs are not in provided.
+func MissingRequiredFields(fields []CreateMetaField, provided map[string]bool) []CreateMetaField {
+	var missing []CreateMetaField
+	for _, field := range fields {
+		if field.Required && !field.HasDefaultValue && !provided[field.FieldID] {
+			missing = append(missing, field)
+		}
+	}
+	return missing
+}
+
+// FormatMissingFields describes missing required fields and the values they accept, e.g.
+//
+//	missing required fields for Story in TEST:
+//	  - Component/s (components): one of API, UI
+func FormatMissingFields(issueType, projectKey string, missing []CreateMetaField) string {
+	return formatMissingFields(fmt.Sprintf("missing required fields for %s in %s:", issueType, projectKey), missing)
+}
+
+// formatMissingFields lists missing fields with their allowed values below a heading.
+func formatMissingFields(heading string, missing []CreateMetaField) string {
+	var b strings.Builder
+	b.WriteString(heading)
+	for _, field := range missing {
+		fmt.Fprintf(&b, "\n  - %s (%s)", field.Name, field.FieldID)
+		if len(field.AllowedValues) == 0 {
+			continue
+		}
+
+		labels := make([]string, 0, maxListedAllowedValues)
+		for i, value := range field.AllowedValues {
+			if i == maxListedAllowedValues {
+				labels = append(labels, fmt.Sprintf("and %d more", len(field.AllowedValues)-i))
+				break
+			}
+			labels = append(labels, value.Label())
+		}
+		fmt.Fprintf(&b, ": one of %s", strings.Join(labels, ", "))
+	}
+	return b.String()
+}
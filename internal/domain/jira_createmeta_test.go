@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestCreateMetaField_JSONDeserialization(t *testing.T) {
+	data := []byte(`{
+		"fieldId": "components",
+		"name": "Component/s",
+		"required": true,
+		"hasDefaultValue": false,
+		"schema": {"type": "array", "items": "component", "system": "components"},
+		"operations": ["add", "set", "remove"],
+		"allowedValues": [{"id": "10000", "name": "API"}, {"id": 10001, "name": "UI"}]
+	}`)
+
+	var field CreateMetaField
+	if err := json.Unmarshal(data, &field); err != nil {
+		t.Fatalf("Failed to unmarshal CreateMetaField: %v", err)
+	}
+	if field.FieldID != "components" || !field.Required || field.HasDefaultValue {
+		t.Errorf("Unexpected field: %+v", field)
+	}
+	if len(field.AllowedValues) != 2 || field.AllowedValues[1].ID != "10001" {
+		t.Errorf("Unexpected allowed values: %+v", field.AllowedValues)
+	}
+}
+
+func TestAllowedValue_Label(t *testing.T) {
+	tests := []struct {
+		value AllowedValue
+		want  string
+	}{
+		{AllowedValue{ID: "1", Name: "High"}, "High"},
+		{AllowedValue{ID: "2", Value: "Yes"}, "Yes"},
+		{AllowedValue{ID: "3", Key: "TEST"}, "TEST"},
+		{AllowedValue{ID: "4"}, "4"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.Label(); got != tt.want {
+			t.Errorf("Label() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestResolveCreateMetaIssueType(t *testing.T) {
+	issueTypes := []CreateMetaIssueType{
+		{ID: "1", Name: "Bug"},
+		{ID: "10001", Name: "Story"},
+	}
+
+	if issueType, err := ResolveCreateMetaIssueType(issueTypes, "TEST", "story"); err != nil || issueType.ID != "10001" {
+		t.Errorf("Expected Story by name, got %+v (%v)", issueType, err)
+	}
+	if issueType, err := ResolveCreateMetaIssueType(issueTypes, "TEST", "1"); err != nil || issueType.Name != "Bug" {
+		t.Errorf("Expected Bug by ID, got %+v (%v)", issueType, err)
+	}
+
+	_, err := ResolveCreateMetaIssueType(issueTypes, "TEST", "Epic")
+	if err == nil || !strings.Contains(err.Error(), "available: Bug, Story") {
+		t.Errorf("Expected error listing available issue types, got %v", err)
+	}
+}
+
+func TestMissingRequiredFields(t *testing.T) {
+	fields := []CreateMetaField{
+		{FieldID: "summary", Name: "Summary", Required: true},
+		{FieldID: "priority", Name: "Priority", Required: true, HasDefaultValue: true},
+		{FieldID: "components", Name: "Component/s", Required: true},
+		{FieldID: "labels", Name: "Labels"},
+		{FieldID: "customfield_10005", Name: "Severity", Required: true},
+	}
+
+	missing := MissingRequiredFields(fields, map[string]bool{"summary": true, "customfield_10005": true})
+	if len(missing) != 1 || missing[0].FieldID != "components" {
+		t.Errorf("Expected only components to be missing, got %+v", missing)
+	}
+
+	if missing := MissingRequiredFields(fields, map[string]bool{"summary": true, "components": true, "customfield_10005": true}); len(missing) != 0 {
+		t.Errorf("Expected no missing fields, got %+v", missing)
+	}
+}
+
+func TestFormatMissingFields(t *testing.T) {
+	var versions []AllowedValue
+	for i := 0; i < maxListedAllowedValues+5; i++ {
+		versions = append(versions, AllowedValue{ID: FlexibleID(fmt.Sprint(i)), Name: fmt.Sprintf("v%d", i)})
+	}
+
+	message := FormatMissingFields("Story", "TEST", []CreateMetaField{
+		{FieldID: "components", Name: "Component/s", AllowedValues: []AllowedValue{{Name: "API"}, {Name: "UI"}}},
+		{FieldID: "customfield_10010", Name: "Team"},
+		{FieldID: "fixVersions", Name: "Fix Version/s", AllowedValues: versions},
+	})
+
+	expected := []string{
+		"missing required fields for Story in TEST:",
+		"  - Component/s (components): one of API, UI",
+		"  - Team (customfield_10010)\n",
+		"v19, and 5 more",
+	}
+	for _, want := range expected {
+		if !strings.Contains(message, want) {
+			t.Errorf("Expected message to contain %q, got:\n%s", want, message)
+		}
+	}
+	if strings.Contains(message, "v20") {
+		t.Errorf("Expected allowed values to be capped, got:\n%s", message)
+	}
+}
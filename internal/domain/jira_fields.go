@@ -0,0 +1,262 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// JiraField describes a system or custom field as returned by /rest/api/2/field.
+type JiraField struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Custom      bool         `json:"custom"`
+	Schema      *FieldSchema `json:"schema,omitempty"`
+	ClauseNames []string     `json:"clauseNames,omitempty"`
+}
+
+// FieldSchema describes the value type of a Jira field.
+type FieldSchema struct {
+	Type     string `json:"type"`               // e.g. string, number, date, user, option, array, any
+	Items    string `json:"items,omitempty"`    // Element type for arrays
+	System   string `json:"system,omitempty"`   // System field ID (system fields only)
+	Custom   string `json:"custom,omitempty"`   // Custom field type key (custom fields only)
+	CustomID int    `json:"customId,omitempty"` // Numeric custom field ID (custom fields only)
+}
+
+// Custom field type keys that need special value handling.
+const (
+	customTypeSprint   = "com.pyxis.greenhopper.jira:gh-sprint"
+	customTypeEpicLink = "com.pyxis.greenhopper.jira:gh-epic-link"
+)
+
+// FieldNames maps field IDs to display names.
+func FieldNames(fields []JiraField) map[string]string {
+	names := make(map[string]string, len(fields))
+	for _, field := range fields {
+		names[field.ID] = field.Name
+	}
+	return names
+}
+
+// ResolveJiraField finds a field by ID (e.g. "customfield_10002", "components")
+// or by display name (case-insensitive, e.g. "Story Points").
+// Returns an error if no field matches or a name is shared by several fields.
+func ResolveJiraField(fields []JiraField, nameOrID string) (*JiraField, error) {
+	for i := range fields {
+		if fields[i].ID == nameOrID {
+			return &fields[i], nil
+		}
+	}
+
+	var matches []*JiraField
+	for i := range fields {
+		if strings.EqualFold(fields[i].Name, nameOrID) {
+			matches = append(matches, &fields[i])
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("unknown field '%s'", nameOrID)
+	case 1:
+		return matches[0], nil
+	default:
+		ids := make([]string, len(matches))
+		for i, match := range matches {
+			ids[i] = match.ID
+		}
+		sort.Strings(ids)
+		return nil, fmt.Errorf("field name '%s' is ambiguous, use one of the IDs: %s", nameOrID, strings.Join(ids, ", "))
+	}
+}
+
+// CoerceFieldValue converts a loosely typed tool argument into the JSON shape Jira
+// expects for the field. For example a story-point string becomes a number, an
+// option name becomes {"value": ...} and a component name becomes [{"name": ...}].
+// Objects are passed through unchanged so callers can always send the raw shape.
+func CoerceFieldValue(field *JiraField, value interface{}) (interface{}, error) {
+	if value == nil || field.Schema == nil {
+		return value, nil
+	}
+
+	schema := field.Schema
+
+	// Agile fields carry their own conventions
+	switch schema.Custom {
+	case customTypeSprint:
+		if list, ok := value.([]interface{}); ok && len(list) == 1 {
+			value = list[0]
+		}
+		n, err := toNumber(value)
+		if err != nil {
+			return nil, fmt.Errorf("field '%s' expects a sprint ID: %w", field.Name, err)
+		}
+		return int64(n), nil
+	case customTypeEpicLink:
+		return toString(value), nil
+	}
+
+	if schema.Type == "array" {
+		var items []interface{}
+		switch v := value.(type) {
+		case []interface{}:
+			items = v
+		case []string:
+			for _, item := range v {
+				items = append(items, item)
+			}
+		case string:
+			// Allow "a, b" for multi-value fields such as labels or components
+			for _, item := range strings.Split(v, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+		default:
+			items = []interface{}{v}
+		}
+
+		result := make([]interface{}, 0, len(items))
+		for _, item := range items {
+			coerced, err := coerceScalar(field, schema.Items, item)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, coerced)
+		}
+		return result, nil
+	}
+
+	return coerceScalar(field, schema.Type, value)
+}
+
+// coerceScalar converts a single value according to a schema type.
+func coerceScalar(field *JiraField, schemaType string, value interface{}) (interface{}, error) {
+	// Structured values are sent as given
+	if _, ok := value.(map[string]interface{}); ok {
+		return value, nil
+	}
+
+	switch schemaType {
+	case "number":
+		n, err := toNumber(value)
+		if err != nil {
+			return nil, fmt.Errorf("field '%s' expects a number: %w", field.Name, err)
+		}
+		return n, nil
+	case "string", "date", "datetime":
+		return toString(value), nil
+	case "option", "option-with-child":
+		return map[string]interface{}{"value": toString(value)}, nil
+	case "user", "group", "version", "component", "priority", "resolution", "securitylevel", "issuetype":
+		return map[string]interface{}{"name": toString(value)}, nil
+	case "project":
+		return map[string]interface{}{"key": toString(value)}, nil
+	case "issuelink", "issuelinks":
+		return map[string]interface{}{"key": toString(value)}, nil
+	default:
+		return value, nil
+	}
+}
+
+// toNumber converts numbers and numeric strings to float64.
+func toNumber(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case json.Number:
+		return v.Float64()
+	case string:
+		n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, fmt.Errorf("'%s' is not a number", v)
+		}
+		return n, nil
+	default:
+		return 0, fmt.Errorf("unsupported value %v", value)
+	}
+}
+
+// toString renders scalar values as strings.
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// jsonFieldNames returns the JSON keys of a struct type's fields.
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	names := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// unmarshalExtraFields returns the non-null keys of a JSON object that do not
+// correspond to a field of the given struct. Returns nil when there are none.
+func unmarshalExtraFields(data []byte, known interface{}) (map[string]json.RawMessage, error) {
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+
+	names := jsonFieldNames(reflect.TypeOf(known))
+	var extra map[string]json.RawMessage
+	for key, value := range all {
+		if names[key] || bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			continue
+		}
+		if extra == nil {
+			extra = make(map[string]json.RawMessage)
+		}
+		extra[key] = value
+	}
+
+	return extra, nil
+}
+
+// marshalWithExtraFields encodes a struct and merges additional keys into the object.
+// Modelled fields take precedence over extra keys with the same name.
+func marshalWithExtraFields(v interface{}, extra interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	extraValue := reflect.ValueOf(extra)
+	if !extraValue.IsValid() || extraValue.Len() == 0 {
+		return data, nil
+	}
+
+	var merged map[string]interface{}
+	if err := json.Unmarshal(data, &merged); err != nil {
+		return nil, err
+	}
+	iter := extraValue.MapRange()
+	for iter.Next() {
+		key := iter.Key().String()
+		if _, exists := merged[key]; !exists {
+			merged[key] = iter.Value().Interface()
+		}
+	}
+
+	return json.Marshal(merged)
+}
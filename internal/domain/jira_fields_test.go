@@ -0,0 +1,204 @@
+package domain
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testJiraFields mirrors a typical /rest/api/2/field response.
+var testJiraFields = []JiraField{
+	{ID: "summary", Name: "Summary", Schema: &FieldSchema{Type: "string", System: "summary"}},
+	{ID: "components", Name: "Component/s", Schema: &FieldSchema{Type: "array", Items: "component", System: "components"}},
+	{ID: "labels", Name: "Labels", Schema: &FieldSchema{Type: "array", Items: "string", System: "labels"}},
+	{ID: "customfield_10002", Name: "Story Points", Custom: true, Schema: &FieldSchema{Type: "number", Custom: "com.atlassian.jira.plugin.system.customfieldtypes:float", CustomID: 10002}},
+	{ID: "customfield_10003", Name: "Sprint", Custom: true, Schema: &FieldSchema{Type: "array", Items: "string", Custom: customTypeSprint, CustomID: 10003}},
+	{ID: "customfield_10004", Name: "Epic Link", Custom: true, Schema: &FieldSchema{Type: "any", Custom: customTypeEpicLink, CustomID: 10004}},
+	{ID: "customfield_10005", Name: "Severity", Custom: true, Schema: &FieldSchema{Type: "option", CustomID: 10005}},
+	{ID: "customfield_10006", Name: "Team", Custom: true, Schema: &FieldSchema{Type: "string", CustomID: 10006}},
+	{ID: "customfield_10007", Name: "Team", Custom: true, Schema: &FieldSchema{Type: "user", CustomID: 10007}},
+}
+
+func TestJiraFields_ExtraRoundTrip(t *testing.T) {
+	data := []byte(`{
+		"summary": "Test issue",
+		"customfield_10002": 5,
+		"components": [{"name": "API"}],
+		"customfield_10099": null
+	}`)
+
+	var fields JiraFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("Failed to unmarshal JiraFields: %v", err)
+	}
+
+	if fields.Summary != "Test issue" {
+		t.Errorf("Expected summary 'Test issue', got %s", fields.Summary)
+	}
+	if len(fields.Extra) != 2 {
+		t.Fatalf("Expected 2 extra fields, got %v", fields.Extra)
+	}
+	if string(fields.Extra["customfield_10002"]) != "5" {
+		t.Errorf("Expected story points 5, got %s", fields.Extra["customfield_10002"])
+	}
+	if _, ok := fields.Extra["customfield_10099"]; ok {
+		t.Error("Expected null fields to be dropped")
+	}
+	if _, ok := fields.Extra["summary"]; ok {
+		t.Error("Expected modelled fields to be excluded from Extra")
+	}
+
+	encoded, err := json.Marshal(fields)
+	if err != nil {
+		t.Fatalf("Failed to marshal JiraFields: %v", err)
+	}
+	var decoded map[string]interface{}
+	json.Unmarshal(encoded, &decoded)
+	if decoded["summary"] != "Test issue" || decoded["customfield_10002"] != float64(5) {
+		t.Errorf("Unexpected encoding: %s", encoded)
+	}
+	if _, ok := decoded["components"]; !ok {
+		t.Errorf("Expected components to be re-encoded: %s", encoded)
+	}
+}
+
+func TestJiraFields_NoExtraFields(t *testing.T) {
+	var fields JiraFields
+	if err := json.Unmarshal([]byte(`{"summary":"Test"}`), &fields); err != nil {
+		t.Fatalf("Failed to unmarshal JiraFields: %v", err)
+	}
+	if fields.Extra != nil {
+		t.Errorf("Expected nil Extra, got %v", fields.Extra)
+	}
+}
+
+func TestJiraFieldsCreate_MarshalExtra(t *testing.T) {
+	create := JiraFieldsCreate{
+		Summary:   "New issue",
+		IssueType: IssueTypeRef{Name: "Story"},
+		Project:   ProjectRef{Key: "TEST"},
+		Extra: map[string]interface{}{
+			"customfield_10002": 3.0,
+			"summary":           "ignored",
+		},
+	}
+
+	data, err := json.Marshal(create)
+	if err != nil {
+		t.Fatalf("Failed to marshal JiraFieldsCreate: %v", err)
+	}
+
+	var decoded map[string]interface{}
+	json.Unmarshal(data, &decoded)
+	if decoded["customfield_10002"] != 3.0 {
+		t.Errorf("Expected custom field in payload: %s", data)
+	}
+	if decoded["summary"] != "New issue" {
+		t.Errorf("Expected modelled summary to take precedence: %s", data)
+	}
+}
+
+func TestJiraFieldsUpdate_MarshalWithoutExtra(t *testing.T) {
+	data, err := json.Marshal(JiraFieldsUpdate{Summary: "Updated"})
+	if err != nil {
+		t.Fatalf("Failed to marshal JiraFieldsUpdate: %v", err)
+	}
+	if string(data) != `{"summary":"Updated"}` {
+		t.Errorf("Unexpected payload: %s", data)
+	}
+}
+
+func TestResolveJiraField(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantID  string
+		wantErr string
+	}{
+		{name: "by ID", input: "customfield_10002", wantID: "customfield_10002"},
+		{name: "by system ID", input: "components", wantID: "components"},
+		{name: "by name", input: "Story Points", wantID: "customfield_10002"},
+		{name: "case-insensitive name", input: "story points", wantID: "customfield_10002"},
+		{name: "unknown", input: "Nope", wantErr: "unknown field 'Nope'"},
+		{name: "ambiguous", input: "team", wantErr: "customfield_10006, customfield_10007"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field, err := ResolveJiraField(testJiraFields, tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if field.ID != tt.wantID {
+				t.Errorf("Expected %s, got %s", tt.wantID, field.ID)
+			}
+		})
+	}
+}
+
+func TestCoerceFieldValue(t *testing.T) {
+	tests := []struct {
+		field   string
+		value   interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{field: "customfield_10002", value: "5", want: 5.0},
+		{field: "customfield_10002", value: 2.5, want: 2.5},
+		{field: "customfield_10002", value: "many", wantErr: true},
+		{field: "customfield_10003", value: "42", want: int64(42)},
+		{field: "customfield_10003", value: []interface{}{42.0}, want: int64(42)},
+		{field: "customfield_10004", value: "TEST-1", want: "TEST-1"},
+		{field: "customfield_10005", value: "High", want: map[string]interface{}{"value": "High"}},
+		{field: "customfield_10007", value: "jdoe", want: map[string]interface{}{"name": "jdoe"}},
+		{field: "summary", value: 12.0, want: "12"},
+		{field: "labels", value: "backend, urgent", want: []interface{}{"backend", "urgent"}},
+		{field: "components", value: []interface{}{"API", "UI"}, want: []interface{}{
+			map[string]interface{}{"name": "API"},
+			map[string]interface{}{"name": "UI"},
+		}},
+		{field: "components", value: "API", want: []interface{}{map[string]interface{}{"name": "API"}}},
+		{field: "components", value: []interface{}{map[string]interface{}{"id": "10000"}}, want: []interface{}{
+			map[string]interface{}{"id": "10000"},
+		}},
+	}
+
+	for _, tt := range tests {
+		field, err := ResolveJiraField(testJiraFields, tt.field)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		got, err := CoerceFieldValue(field, tt.value)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s(%v): expected error, got %v", tt.field, tt.value, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s(%v): unexpected error: %v", tt.field, tt.value, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s(%v) = %#v, want %#v", tt.field, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestFieldNames(t *testing.T) {
+	names := FieldNames(testJiraFields)
+	if names["customfield_10002"] != "Story Points" {
+		t.Errorf("Expected 'Story Points', got %q", names["customfield_10002"])
+	}
+	if len(names) != len(testJiraFields) {
+		t.Errorf("Expected %d names, got %d", len(testJiraFields), len(names))
+	}
+}
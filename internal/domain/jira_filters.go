@@ -0,0 +1,160 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// Filter is a saved JQL search.
+type Filter struct {
+	ID               FlexibleID        `json:"id"`
+	Name             string            `json:"name"`
+	Description      string            `json:"description,omitempty"`
+	Owner            *User             `json:"owner,omitempty"`
+	JQL              string            `json:"jql"`
+	ViewURL          string            `json:"viewUrl,omitempty"`
+	Favourite        bool              `json:"favourite"`
+	SharePermissions []SharePermission `json:"sharePermissions"`
+}
+
+// Share permission types of filters and dashboards.
+const (
+	ShareGlobal        = "global"        // Everyone, including anonymous users
+	ShareAuthenticated = "authenticated" // Every logged-in user
+	ShareGroup         = "group"
+	ShareProject       = "project"     // Users who can browse the project
+	ShareProjectRole   = "projectRole" // Members of a role in a project
+)
+
+// SharePermission grants a group, project, project role or everyone access to a filter.
+type SharePermission struct {
+	ID      FlexibleID      `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Project *Project        `json:"project,omitempty"`
+	Role    *ProjectRoleRef `json:"role,omitempty"`
+	Group   *GroupRef       `json:"group,omitempty"`
+}
+
+// ProjectRoleRef is a reference to a project role.
+type ProjectRoleRef struct {
+	ID   FlexibleID `json:"id"`
+	Name string     `json:"name,omitempty"`
+}
+
+// FilterRequest creates or partially updates a filter; empty fields are left unchanged.
+type FilterRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	JQL         string `json:"jql,omitempty"`
+	Favourite   *bool  `json:"favourite,omitempty"`
+}
+
+// SharePermissionRequest adds a share permission to a filter.
+type SharePermissionRequest struct {
+	Type          string `json:"type"`
+	ProjectID     string `json:"projectId,omitempty"`     // project and projectRole
+	GroupName     string `json:"groupname,omitempty"`     // group
+	ProjectRoleID string `json:"projectRoleId,omitempty"` // projectRole
+}
+
+// Dashboard is a Jira dashboard.
+type Dashboard struct {
+	ID   FlexibleID `json:"id"`
+	Name string     `json:"name"`
+	View string     `json:"view,omitempty"` // Browser URL
+}
+
+// DashboardPage is a page of dashboards.
+type DashboardPage struct {
+	StartAt    int         `json:"startAt"`
+	MaxResults int         `json:"maxResults"`
+	Total      int         `json:"total"`
+	Dashboards []Dashboard `json:"dashboards"`
+}
+
+// DashboardGadget is a gadget on a dashboard with the filters it displays.
+type DashboardGadget struct {
+	ID        FlexibleID `json:"id"`
+	ModuleKey string     `json:"moduleKey,omitempty"`
+	URI       string     `json:"uri,omitempty"`
+	Title     string     `json:"title,omitempty"`
+	Color     string     `json:"color,omitempty"`
+	Position  struct {
+		Row    int `json:"row"`
+		Column int `json:"column"`
+	} `json:"position"`
+	FilterIDs []string `json:"filterIds,omitempty"` // Filters referenced by the gadget configuration
+}
+
+// FilterIDsFromProperty finds the filters referenced by a gadget configuration
+// property: values of keys named like "filterId" that hold a filter ID, either
+// plain ("10000") or prefixed ("filter-10000"). Nested objects and JSON-encoded
+// strings are searched too. The IDs are returned sorted.
+func FilterIDsFromProperty(value json.RawMessage) []string {
+	found := make(map[string]bool)
+	var walk func(key string, node interface{})
+	walk = func(key string, node interface{}) {
+		switch v := node.(type) {
+		case map[string]interface{}:
+			for childKey, child := range v {
+				walk(childKey, child)
+			}
+		case []interface{}:
+			for _, child := range v {
+				walk(key, child)
+			}
+		case json.Number:
+			walk(key, v.String())
+		case string:
+			if nested, ok := decodeJSONNumbers([]byte(v)); ok && strings.HasPrefix(strings.TrimSpace(v), "{") {
+				walk(key, nested)
+			} else if id, ok := filterIDValue(key, v); ok {
+				found[id] = true
+			}
+		}
+	}
+
+	root, ok := decodeJSONNumbers(value)
+	if !ok {
+		return nil
+	}
+	walk("", root)
+
+	ids := make([]string, 0, len(found))
+	for id := range found {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// decodeJSONNumbers decodes JSON keeping numbers as json.Number.
+func decodeJSONNumbers(data []byte) (interface{}, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// filterIDValue returns the filter ID held by value if key names a filter reference.
+func filterIDValue(key, value string) (string, bool) {
+	lower := strings.ToLower(key)
+	if !strings.Contains(lower, "filterid") && lower != "filter" {
+		return "", false
+	}
+	id := strings.TrimPrefix(strings.TrimSpace(value), "filter-")
+	if id == "" {
+		return "", false
+	}
+	for _, r := range id {
+		if r < '0' || r > '9' {
+			return "", false
+		}
+	}
+	return id, true
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestFilterIDsFromProperty(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{"prefixed", `{"filterId":"filter-10000","num":"10"}`, []string{"10000"}},
+		{"numeric", `{"filterId":10001}`, []string{"10001"}},
+		{"nested and duplicated", `{"config":{"statType":"assignees","filterId":"10002"},"other":{"filterId":"filter-10002"}}`, []string{"10002"}},
+		{"json string", `"{\"filterId\":\"filter-10003\",\"refresh\":15}"`, []string{"10003"}},
+		{"project or jql", `{"filterId":"project-10000","jql":"project = TEST"}`, []string{}},
+		{"several", `{"filterId1":"filter-2","filterId2":"filter-10"}`, []string{"10", "2"}},
+		{"invalid", `not json`, nil},
+	}
+
+	for _, tt := range tests {
+		got := FilterIDsFromProperty(json.RawMessage(tt.value))
+		if len(got) == 0 && len(tt.want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: FilterIDsFromProperty() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFilterJSONDeserialization(t *testing.T) {
+	data := `{"id":"10000","name":"My bugs","jql":"assignee = currentUser()","favourite":true,
+		"owner":{"name":"jdoe"},
+		"sharePermissions":[{"id":10,"type":"group","group":{"name":"jira-users"}},
+			{"id":11,"type":"projectRole","project":{"id":"10100","key":"TEST","name":"Test"},"role":{"id":10360,"name":"Developers"}}]}`
+
+	var filter Filter
+	if err := json.Unmarshal([]byte(data), &filter); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if filter.ID != "10000" || !filter.Favourite || filter.Owner.Name != "jdoe" || len(filter.SharePermissions) != 2 {
+		t.Fatalf("unexpected filter: %+v", filter)
+	}
+	if role := filter.SharePermissions[1]; role.ID != "11" || role.Role.ID != "10360" || role.Project.Key != "TEST" {
+		t.Errorf("unexpected project role permission: %+v", role)
+	}
+}
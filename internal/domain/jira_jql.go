@@ -0,0 +1,212 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// orderByPattern finds the ORDER BY clause of a JQL query.
+var orderByPattern = regexp.MustCompile(`(?i)\s+order\s+by\s+`)
+
+// AddJQLClause restricts a JQL query with an additional clause, keeping any ORDER BY
+// at the end: AddJQLClause("project = TEST ORDER BY key", "status = Open") returns
+// "(project = TEST) AND status = Open ORDER BY key".
+func AddJQLClause(jql, clause string) string {
+	query, orderBy := splitJQLOrderBy(jql)
+	if query == "" {
+		return clause + orderBy
+	}
+	return "(" + query + ") AND " + clause + orderBy
+}
+
+// SetJQLOrderBy replaces the ORDER BY clause of a JQL query: SetJQLOrderBy(
+// "project = TEST ORDER BY key", "updated DESC") returns "project = TEST ORDER BY updated DESC".
+// An empty orderBy keeps the query unchanged.
+func SetJQLOrderBy(jql, orderBy string) string {
+	orderBy = strings.TrimSpace(orderBy)
+	if orderBy == "" {
+		return jql
+	}
+	query, _ := splitJQLOrderBy(jql)
+	if query == "" {
+		return "ORDER BY " + orderBy
+	}
+	return query + " ORDER BY " + orderBy
+}
+
+// splitJQLOrderBy splits a JQL query into its trimmed condition and its ORDER BY
+// clause, which keeps a leading space. "order by" inside quoted values is ignored.
+func splitJQLOrderBy(jql string) (query, orderBy string) {
+	query = jql
+	if loc := orderByPattern.FindStringIndex(" " + maskJQLStrings(jql)); loc != nil {
+		start := loc[0]
+		if start > 0 {
+			start--
+		}
+		query, orderBy = jql[:start], " "+strings.TrimSpace(jql[start:])
+	}
+	return strings.TrimSpace(query), orderBy
+}
+
+// maskJQLStrings blanks out the contents of quoted JQL values (keeping the length of
+// the query) so that keywords inside them are not matched.
+func maskJQLStrings(jql string) string {
+	masked := []byte(jql)
+	var quote byte
+	for i := 0; i < len(masked); i++ {
+		switch c := masked[i]; {
+		case quote == 0:
+			if c == '"' || c == '\'' {
+				quote = c
+			}
+		case c == quote:
+			quote = 0
+		case c == '\\' && i+1 < len(masked):
+			masked[i], masked[i+1] = '_', '_'
+			i++
+		default:
+			masked[i] = '_'
+		}
+	}
+	return string(masked)
+}
+
+// QuoteJQLValue quotes a value for use in JQL, escaping quotes and backslashes:
+// QuoteJQLValue(`say "hi"`) returns `"say \"hi\""`.
+func QuoteJQLValue(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + replacer.Replace(value) + `"`
+}
+
+// jqlDatePattern matches the absolute ("2024-01-31", "2024-01-31 14:00") and relative
+// ("-7d", "-2w 3d") dates JQL accepts.
+var jqlDatePattern = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2}( \d{2}:\d{2})?|-?\d+[wdhm]( \d+[wdhm])*)$`)
+
+// JQLFilter describes a search as structured conditions. Empty fields are ignored;
+// list fields match any of their values.
+type JQLFilter struct {
+	Projects     []string `json:"projects,omitempty"`
+	IssueTypes   []string `json:"issueTypes,omitempty"`
+	Statuses     []string `json:"statuses,omitempty"`
+	Assignee     string   `json:"assignee,omitempty"` // Username, "currentUser()" or "unassigned"
+	Reporter     string   `json:"reporter,omitempty"` // Username or "currentUser()"
+	Labels       []string `json:"labels,omitempty"`
+	Text         string   `json:"text,omitempty"`         // Full-text search of summary, description and comments
+	UpdatedSince string   `json:"updatedSince,omitempty"` // YYYY-MM-DD or relative, e.g. -7d
+	CreatedSince string   `json:"createdSince,omitempty"` // YYYY-MM-DD or relative, e.g. -7d
+	Resolved     *bool    `json:"resolved,omitempty"`     // Only resolved (true) or unresolved (false) issues
+	JQL          string   `json:"jql,omitempty"`          // Additional raw JQL condition
+	OrderBy      string   `json:"orderBy,omitempty"`      // e.g. "updated DESC"
+}
+
+// BuildJQL turns a filter into JQL with every value quoted. The conditions are
+// joined with AND in a fixed order.
+func BuildJQL(filter *JQLFilter) (string, error) {
+	var clauses []string
+	addIn := func(field string, values []string) {
+		switch len(values) {
+		case 0:
+		case 1:
+			clauses = append(clauses, field+" = "+QuoteJQLValue(values[0]))
+		default:
+			quoted := make([]string, len(values))
+			for i, value := range values {
+				quoted[i] = QuoteJQLValue(value)
+			}
+			clauses = append(clauses, field+" in ("+strings.Join(quoted, ", ")+")")
+		}
+	}
+	addUser := func(field, user string) {
+		switch {
+		case user == "":
+		case strings.EqualFold(user, "unassigned") || strings.EqualFold(user, "empty"):
+			clauses = append(clauses, field+" is EMPTY")
+		case strings.EqualFold(user, "currentUser()"):
+			clauses = append(clauses, field+" = currentUser()")
+		default:
+			clauses = append(clauses, field+" = "+QuoteJQLValue(user))
+		}
+	}
+	addSince := func(field, since string) error {
+		if since == "" {
+			return nil
+		}
+		if !jqlDatePattern.MatchString(since) {
+			return fmt.Errorf("%s date '%s' is invalid: use YYYY-MM-DD, 'YYYY-MM-DD HH:mm' or a relative date like -7d", field, since)
+		}
+		clauses = append(clauses, field+" >= "+QuoteJQLValue(since))
+		return nil
+	}
+
+	addIn("project", filter.Projects)
+	addIn("issuetype", filter.IssueTypes)
+	addIn("status", filter.Statuses)
+	addUser("assignee", filter.Assignee)
+	addUser("reporter", filter.Reporter)
+	addIn("labels", filter.Labels)
+	if filter.Text != "" {
+		clauses = append(clauses, "text ~ "+QuoteJQLValue(filter.Text))
+	}
+	if err := addSince("updated", filter.UpdatedSince); err != nil {
+		return "", err
+	}
+	if err := addSince("created", filter.CreatedSince); err != nil {
+		return "", err
+	}
+	if filter.Resolved != nil {
+		if *filter.Resolved {
+			clauses = append(clauses, "resolution is not EMPTY")
+		} else {
+			clauses = append(clauses, "resolution is EMPTY")
+		}
+	}
+	if jql := strings.TrimSpace(filter.JQL); jql != "" {
+		clauses = append(clauses, "("+jql+")")
+	}
+
+	if len(clauses) == 0 && filter.OrderBy == "" {
+		return "", fmt.Errorf("the filter has no conditions")
+	}
+	return SetJQLOrderBy(strings.Join(clauses, " AND "), filter.OrderBy), nil
+}
+
+// JQLQueryValidation is the outcome of validating one JQL query.
+type JQLQueryValidation struct {
+	Query    string   `json:"query"`
+	Valid    bool     `json:"valid"`
+	Errors   []string `json:"errors,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// JQLAutocompleteData lists the fields, functions and reserved words usable in JQL.
+type JQLAutocompleteData struct {
+	VisibleFieldNames    []JQLFieldReference    `json:"visibleFieldNames"`
+	VisibleFunctionNames []JQLFunctionReference `json:"visibleFunctionNames"`
+	JQLReservedWords     []string               `json:"jqlReservedWords,omitempty"`
+}
+
+// JQLFieldReference is a field that can be used in JQL.
+type JQLFieldReference struct {
+	Value       string   `json:"value"`       // The name to use in JQL
+	DisplayName string   `json:"displayName"` // Name with the custom field ID, e.g. "Story Points - cf[10002]"
+	Orderable   string   `json:"orderable,omitempty"`
+	Searchable  string   `json:"searchable,omitempty"`
+	CFID        string   `json:"cfid,omitempty"` // Custom field reference, e.g. cf[10002]
+	Operators   []string `json:"operators,omitempty"`
+	Types       []string `json:"types,omitempty"`
+}
+
+// JQLFunctionReference is a function that can be used in JQL.
+type JQLFunctionReference struct {
+	Value       string   `json:"value"`
+	DisplayName string   `json:"displayName"`
+	IsList      string   `json:"isList,omitempty"`
+	Types       []string `json:"types,omitempty"`
+}
+
+// JQLSuggestion is a suggested value for a JQL field.
+type JQLSuggestion struct {
+	Value       string `json:"value"`
+	DisplayName string `json:"displayName"` // May contain <b> highlighting from Jira
+}
@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAddJQLClause(t *testing.T) {
+	tests := []struct {
+		jql  string
+		want string
+	}{
+		{"project = TEST", "(project = TEST) AND status = Open"},
+		{"project = TEST ORDER BY key ASC", "(project = TEST) AND status = Open ORDER BY key ASC"},
+		{"project = A OR project = B order by created DESC", "(project = A OR project = B) AND status = Open order by created DESC"},
+		{"ORDER BY rank", "status = Open ORDER BY rank"},
+		{"", "status = Open"},
+		{"summary ~ \"order\"", "(summary ~ \"order\") AND status = Open"},
+		{"summary ~ \"sort order by date\"", "(summary ~ \"sort order by date\") AND status = Open"},
+		{`summary ~ 'a \' order by b' ORDER BY key`, `(summary ~ 'a \' order by b') AND status = Open ORDER BY key`},
+	}
+
+	for _, tt := range tests {
+		if got := AddJQLClause(tt.jql, "status = Open"); got != tt.want {
+			t.Errorf("AddJQLClause(%q) = %q, want %q", tt.jql, got, tt.want)
+		}
+	}
+}
+
+func TestSetJQLOrderBy(t *testing.T) {
+	tests := []struct {
+		jql     string
+		orderBy string
+		want    string
+	}{
+		{"project = TEST", "updated DESC", "project = TEST ORDER BY updated DESC"},
+		{"project = TEST ORDER BY key ASC", "priority DESC, key", "project = TEST ORDER BY priority DESC, key"},
+		{"project = TEST order by rank", "created", "project = TEST ORDER BY created"},
+		{"", "key", "ORDER BY key"},
+		{"project = TEST ORDER BY key", "", "project = TEST ORDER BY key"},
+		{"text ~ \"order by\" ORDER BY key", "updated", "text ~ \"order by\" ORDER BY updated"},
+	}
+
+	for _, tt := range tests {
+		if got := SetJQLOrderBy(tt.jql, tt.orderBy); got != tt.want {
+			t.Errorf("SetJQLOrderBy(%q, %q) = %q, want %q", tt.jql, tt.orderBy, got, tt.want)
+		}
+	}
+}
+
+func TestQuoteJQLValue(t *testing.T) {
+	tests := map[string]string{
+		"Open":             `"Open"`,
+		"In Progress":      `"In Progress"`,
+		`say "hi"`:         `"say \"hi\""`,
+		`C:\temp`:          `"C:\\temp"`,
+		"o'brien OR x = y": `"o'brien OR x = y"`,
+	}
+	for value, want := range tests {
+		if got := QuoteJQLValue(value); got != want {
+			t.Errorf("QuoteJQLValue(%q) = %s, want %s", value, got, want)
+		}
+	}
+}
+
+func TestBuildJQL(t *testing.T) {
+	resolved := false
+	tests := []struct {
+		name   string
+		filter JQLFilter
+		want   string
+	}{
+		{
+			"single values",
+			JQLFilter{Projects: []string{"TEST"}, Statuses: []string{"In Progress"}, Assignee: "jdoe"},
+			`project = "TEST" AND status = "In Progress" AND assignee = "jdoe"`,
+		},
+		{
+			"lists and text",
+			JQLFilter{Projects: []string{"A", "B"}, Labels: []string{"backend", "urgent"}, Text: `login "fails"`},
+			`project in ("A", "B") AND labels in ("backend", "urgent") AND text ~ "login \"fails\""`,
+		},
+		{
+			"users and dates",
+			JQLFilter{Assignee: "unassigned", Reporter: "currentUser()", UpdatedSince: "-7d", CreatedSince: "2024-01-31", Resolved: &resolved},
+			`assignee is EMPTY AND reporter = currentUser() AND updated >= "-7d" AND created >= "2024-01-31" AND resolution is EMPTY`,
+		},
+		{
+			"raw jql and order",
+			JQLFilter{IssueTypes: []string{"Bug"}, JQL: "priority = High OR priority = Highest", OrderBy: "updated DESC"},
+			`issuetype = "Bug" AND (priority = High OR priority = Highest) ORDER BY updated DESC`,
+		},
+		{
+			"order only",
+			JQLFilter{OrderBy: "created"},
+			`ORDER BY created`,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := BuildJQL(&tt.filter)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: BuildJQL() = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestBuildJQL_Errors(t *testing.T) {
+	if _, err := BuildJQL(&JQLFilter{}); err == nil {
+		t.Error("expected an error for an empty filter")
+	}
+	if _, err := BuildJQL(&JQLFilter{UpdatedSince: "last week"}); err == nil || !strings.Contains(err.Error(), "updated date 'last week' is invalid") {
+		t.Errorf("expected invalid date error, got %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// IssueLinkType describes a kind of issue link, e.g. Blocks ("blocks" / "is blocked by").
+type IssueLinkType struct {
+	ID      FlexibleID `json:"id"`
+	Name    string     `json:"name"`
+	Inward  string     `json:"inward"`
+	Outward string     `json:"outward"`
+}
+
+// IssueLinkRequest represents the request body for creating an issue link.
+// Jira reads it as "InwardIssue <outward description> OutwardIssue",
+// e.g. inward TEST-1 and outward TEST-2 with type Blocks means "TEST-1 blocks TEST-2".
+type IssueLinkRequest struct {
+	Type         IssueLinkTypeRef `json:"type"`
+	InwardIssue  IssueRef         `json:"inwardIssue"`
+	OutwardIssue IssueRef         `json:"outwardIssue"`
+	Comment      *Comment         `json:"comment,omitempty"`
+}
+
+// IssueLinkTypeRef is a reference to an issue link type by name.
+type IssueLinkTypeRef struct {
+	Name string `json:"name"`
+}
+
+// NewIssueLinkRequest builds a link that reads "issueKey <relation> targetKey". The relation
+// may be a link type name or its outward or inward description (case-insensitive), so
+// both "blocks" and "is blocked by" can be used with the Blocks type.
+func NewIssueLinkRequest(linkTypes []IssueLinkType, issueKey, relation, targetKey string) (*IssueLinkRequest, error) {
+	for _, linkType := range linkTypes {
+		switch {
+		case strings.EqualFold(linkType.Name, relation), strings.EqualFold(linkType.Outward, relation):
+			return &IssueLinkRequest{
+				Type:         IssueLinkTypeRef{Name: linkType.Name},
+				InwardIssue:  IssueRef{Key: issueKey},
+				OutwardIssue: IssueRef{Key: targetKey},
+			}, nil
+		case strings.EqualFold(linkType.Inward, relation):
+			return &IssueLinkRequest{
+				Type:         IssueLinkTypeRef{Name: linkType.Name},
+				InwardIssue:  IssueRef{Key: targetKey},
+				OutwardIssue: IssueRef{Key: issueKey},
+			}, nil
+		}
+	}
+
+	available := make([]string, len(linkTypes))
+	for i, linkType := range linkTypes {
+		available[i] = fmt.Sprintf("%s (%s / %s)", linkType.Name, linkType.Outward, linkType.Inward)
+	}
+	sort.Strings(available)
+	return nil, fmt.Errorf("unknown link type '%s' (available: %s)", relation, strings.Join(available, ", "))
+}
+
+// EpicLinkField returns the Epic Link custom field, or nil if Jira Software is not installed.
+func EpicLinkField(fields []JiraField) *JiraField {
+	for i := range fields {
+		if fields[i].Schema != nil && fields[i].Schema.Custom == customTypeEpicLink {
+			return &fields[i]
+		}
+	}
+	return nil
+}
+
+// IssueTreeNode is an issue in an epic → story → sub-task hierarchy.
+type IssueTreeNode struct {
+	Key       string           `json:"key"`
+	Summary   string           `json:"summary"`
+	IssueType string           `json:"issueType"`
+	Status    string           `json:"status"`
+	Children  []*IssueTreeNode `json:"children,omitempty"`
+}
+
+// NewIssueTreeNode creates a tree node from an issue.
+func NewIssueTreeNode(issue *JiraIssue) *IssueTreeNode {
+	return &IssueTreeNode{
+		Key:       issue.Key,
+		Summary:   issue.Fields.Summary,
+		IssueType: issue.Fields.IssueType.Name,
+		Status:    issue.Fields.Status.Name,
+	}
+}
+
+// IssueTree is the result of walking an issue hierarchy.
+type IssueTree struct {
+	Root       *IssueTreeNode `json:"root"`
+	IssueCount int            `json:"issueCount"`
+	Truncated  bool           `json:"truncated"` // True if the issue limit was reached
+}
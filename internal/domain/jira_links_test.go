@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var testLinkTypes = []IssueLinkType{
+	{ID: "10000", Name: "Blocks", Inward: "is blocked by", Outward: "blocks"},
+	{ID: "10001", Name: "Relates", Inward: "relates to", Outward: "relates to"},
+}
+
+func TestNewIssueLinkRequest(t *testing.T) {
+	tests := []struct {
+		relation    string
+		wantType    string
+		wantInward  string
+		wantOutward string
+	}{
+		{relation: "Blocks", wantType: "Blocks", wantInward: "TEST-1", wantOutward: "TEST-2"},
+		{relation: "blocks", wantType: "Blocks", wantInward: "TEST-1", wantOutward: "TEST-2"},
+		{relation: "is blocked by", wantType: "Blocks", wantInward: "TEST-2", wantOutward: "TEST-1"},
+		{relation: "Relates to", wantType: "Relates", wantInward: "TEST-1", wantOutward: "TEST-2"},
+	}
+
+	for _, tt := range tests {
+		link, err := NewIssueLinkRequest(testLinkTypes, "TEST-1", tt.relation, "TEST-2")
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.relation, err)
+			continue
+		}
+		if link.Type.Name != tt.wantType || link.InwardIssue.Key != tt.wantInward || link.OutwardIssue.Key != tt.wantOutward {
+			t.Errorf("%s: unexpected link %+v", tt.relation, link)
+		}
+	}
+
+	_, err := NewIssueLinkRequest(testLinkTypes, "TEST-1", "duplicates", "TEST-2")
+	if err == nil || !strings.Contains(err.Error(), "Blocks (blocks / is blocked by)") {
+		t.Errorf("Expected error listing link types, got %v", err)
+	}
+}
+
+func TestIssueLinkRequest_JSONSerialization(t *testing.T) {
+	link, _ := NewIssueLinkRequest(testLinkTypes, "TEST-1", "blocks", "TEST-2")
+	data, err := json.Marshal(link)
+	if err != nil {
+		t.Fatalf("Failed to marshal IssueLinkRequest: %v", err)
+	}
+	expected := `{"type":{"name":"Blocks"},"inwardIssue":{"key":"TEST-1"},"outwardIssue":{"key":"TEST-2"}}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+}
+
+func TestEpicLinkField(t *testing.T) {
+	field := EpicLinkField(testJiraFields)
+	if field == nil || field.ID != "customfield_10004" {
+		t.Errorf("Expected Epic Link field, got %+v", field)
+	}
+	if EpicLinkField(testJiraFields[:3]) != nil {
+		t.Error("Expected nil without Jira Software fields")
+	}
+}
+
+func TestNewIssueTreeNode(t *testing.T) {
+	node := NewIssueTreeNode(&JiraIssue{
+		Key: "TEST-1",
+		Fields: JiraFields{
+			Summary:   "Epic",
+			IssueType: IssueType{Name: "Epic"},
+			Status:    Status{Name: "Open"},
+		},
+	})
+	if node.Key != "TEST-1" || node.IssueType != "Epic" || node.Status != "Open" || node.Children != nil {
+		t.Errorf("Unexpected node: %+v", node)
+	}
+}
+
+func TestJiraFieldsCreate_Parent(t *testing.T) {
+	data, err := json.Marshal(JiraFieldsCreate{
+		Summary:   "Sub-task",
+		IssueType: IssueTypeRef{Name: "Sub-task"},
+		Project:   ProjectRef{Key: "TEST"},
+		Parent:    &IssueRef{Key: "TEST-1"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal JiraFieldsCreate: %v", err)
+	}
+	if !strings.Contains(string(data), `"parent":{"key":"TEST-1"}`) {
+		t.Errorf("Expected parent in payload, got %s", data)
+	}
+}
@@ -0,0 +1,500 @@
+package domain
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Text formats for issue descriptions and comments. Jira Server stores and renders
+// wiki markup; Markdown is converted on the way in and out.
+const (
+	TextFormatWiki     = "wiki"     // Jira wiki markup, as stored by Jira
+	TextFormatMarkdown = "markdown" // Markdown (CommonMark with GitHub tables and strikethrough)
+	TextFormatText     = "text"     // Plain text without markup
+	TextFormatRendered = "rendered" // HTML rendered by Jira
+)
+
+// Block-level Markdown syntax.
+var (
+	mdFencePattern     = regexp.MustCompile("^\\s*(```+|~~~+)\\s*([\\w+#.-]*)\\s*$")
+	mdHeadingPattern   = regexp.MustCompile(`^\s{0,3}(#{1,6})\s+(.*?)(?:\s+#+)?\s*$`)
+	mdQuotePattern     = regexp.MustCompile(`^\s{0,3}>\s?(.*)$`)
+	mdRulePattern      = regexp.MustCompile(`^\s{0,3}(?:(?:-\s*){3,}|(?:\*\s*){3,}|(?:_\s*){3,})$`)
+	mdListPattern      = regexp.MustCompile(`^(\s*)([-*+]|\d+[.)])\s+(.*)$`)
+	mdTableRowPattern  = regexp.MustCompile(`^\s*\|(.*)\|\s*$`)
+	mdTableRulePattern = regexp.MustCompile(`^\s*\|(?:\s*:?-+:?\s*\|)+\s*$`)
+)
+
+// Inline Markdown syntax.
+var (
+	mdEscapePattern         = regexp.MustCompile(`\\[\\` + "`" + `*_{}\[\]()#+\-.!|~>]`)
+	mdCodeSpanPattern       = regexp.MustCompile("(`+)(.+?)(`+)")
+	mdImagePattern          = regexp.MustCompile(`!\[([^\]]*)\]\(([^)\s]+)(?:\s+"[^"]*")?\)`)
+	mdLinkPattern           = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)(?:\s+"[^"]*")?\)`)
+	mdAutolinkPattern       = regexp.MustCompile(`<((?:https?|ftp|mailto):[^>\s]+)>`)
+	mdBoldPattern           = regexp.MustCompile(`\*\*(\S|\S.*?\S)\*\*`)
+	mdBoldUnderscorePattern = regexp.MustCompile(`(^|[^\w])__(\S|\S.*?\S)__($|[^\w])`) // Not inside words, as in snake__case
+	mdItalicPattern         = regexp.MustCompile(`(^|[^\w*])\*(\S|\S[^*]*?\S)\*($|[^\w*])`)
+	mdStrikePattern         = regexp.MustCompile(`~~(\S|\S.*?\S)~~`)
+)
+
+// Block-level wiki markup.
+var (
+	wikiBlockPattern    = regexp.MustCompile(`^\s*\{(code|noformat|quote|panel|info|note|tip|warning)(?::([^}]*))?\}(.*)$`)
+	wikiHeadingPattern  = regexp.MustCompile(`^\s*h([1-6])\.\s+(.*)$`)
+	wikiQuotePattern    = regexp.MustCompile(`^\s*bq\.\s+(.*)$`)
+	wikiRulePattern     = regexp.MustCompile(`^\s*-{4,}\s*$`)
+	wikiListPattern     = regexp.MustCompile(`^\s*([*#]+|-)\s+(.*)$`)
+	wikiTableRowPattern = regexp.MustCompile(`^\s*\|`)
+)
+
+// Inline wiki markup.
+var (
+	wikiEscapePattern    = regexp.MustCompile(`\\([{}\[\]*_\-+!|^~?#])`)
+	wikiMonospacePattern = regexp.MustCompile(`\{\{(.+?)\}\}`)
+	wikiImagePattern     = regexp.MustCompile(`!([^!\s|]+[./][^!\s|]+)(?:\|[^!]*)?!`)
+	wikiLinkPattern      = regexp.MustCompile(`\[([^\]|]*)\|([^\]|]+)(?:\|[^\]]*)?\]`)
+	wikiBareLinkPattern  = regexp.MustCompile(`\[([~^]?)([^\]|\s]+)\]`)
+	wikiColorPattern     = regexp.MustCompile(`\{color(?::[^}]*)?\}`)
+	wikiBoldPattern      = regexp.MustCompile(`(^|[^\w*])\*(\S|\S[^*]*?\S)\*($|[^\w*])`)
+	wikiItalicPattern    = regexp.MustCompile(`(^|[^\w])_(\S|\S[^_]*?\S)_($|[^\w])`)
+	wikiStrikePattern    = regexp.MustCompile(`(^|[^\w-])-(\S|\S[^-]*?\S)-($|[^\w-])`)
+	wikiUnderlinePattern = regexp.MustCompile(`(^|[^\w+])\+(\S|\S[^+]*?\S)\+($|[^\w+])`)
+)
+
+// placeholderPattern matches the markers left by placeholders.protect.
+var placeholderPattern = regexp.MustCompile("\x00(\\d+)\x00")
+
+// placeholders holds already converted inline fragments (code spans, links, escapes)
+// so that later emphasis rules cannot rewrite the text inside them.
+type placeholders []string
+
+// protect stores text and returns the marker that replaces it.
+func (p *placeholders) protect(text string) string {
+	*p = append(*p, text)
+	return "\x00" + strconv.Itoa(len(*p)-1) + "\x00"
+}
+
+// restore replaces the markers in text with the stored fragments. Fragments may
+// themselves contain markers, e.g. a code span inside link text.
+func (p placeholders) restore(text string) string {
+	for placeholderPattern.MatchString(text) {
+		text = placeholderPattern.ReplaceAllStringFunc(text, func(marker string) string {
+			index, _ := strconv.Atoi(strings.Trim(marker, "\x00"))
+			return p[index]
+		})
+	}
+	return text
+}
+
+// replaceAllRepeated applies an emphasis pattern until the text stops changing. The
+// boundary characters captured around each match would otherwise hide adjacent matches
+// such as "*a* *b*".
+func replaceAllRepeated(pattern *regexp.Regexp, text, replacement string) string {
+	for i := 0; i < 10; i++ {
+		replaced := pattern.ReplaceAllString(text, replacement)
+		if replaced == text {
+			break
+		}
+		text = replaced
+	}
+	return text
+}
+
+// mdListLevel is an open Markdown list while converting to wiki markup.
+type mdListLevel struct {
+	indent int
+	marker byte // '*' for bullets, '#' for numbered items
+}
+
+// MarkdownToWiki converts Markdown to Jira wiki markup. It handles headings, emphasis,
+// strikethrough, inline code, fenced code blocks, links, images, block quotes, nested
+// bullet and numbered lists, horizontal rules and tables; other text is passed through.
+func MarkdownToWiki(markdown string) string {
+	lines := strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n")
+	out := make([]string, 0, len(lines))
+	var lists []mdListLevel
+	fence := "" // The open code fence, empty outside code blocks
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		// Copy code blocks verbatim
+		if fence != "" {
+			trimmed := strings.TrimSpace(line)
+			if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+				out = append(out, "{code}")
+				fence = ""
+			} else {
+				out = append(out, line)
+			}
+			continue
+		}
+		if m := mdFencePattern.FindStringSubmatch(line); m != nil {
+			fence = m[1]
+			lists = nil
+			if m[2] != "" {
+				out = append(out, "{code:"+m[2]+"}")
+			} else {
+				out = append(out, "{code}")
+			}
+			continue
+		}
+
+		if m := mdRulePattern.FindStringSubmatch(line); m != nil {
+			lists = nil
+			out = append(out, "----")
+			continue
+		}
+		if m := mdHeadingPattern.FindStringSubmatch(line); m != nil {
+			lists = nil
+			out = append(out, "h"+strconv.Itoa(len(m[1]))+". "+markdownInlineToWiki(m[2]))
+			continue
+		}
+		if m := mdQuotePattern.FindStringSubmatch(line); m != nil {
+			lists = nil
+			out = append(out, "bq. "+markdownInlineToWiki(m[1]))
+			continue
+		}
+		if m := mdTableRowPattern.FindStringSubmatch(line); m != nil {
+			lists = nil
+			cells := strings.Split(m[1], "|")
+			for j, cell := range cells {
+				cells[j] = markdownInlineToWiki(strings.TrimSpace(cell))
+				if cells[j] == "" {
+					cells[j] = " "
+				}
+			}
+			if i+1 < len(lines) && mdTableRulePattern.MatchString(lines[i+1]) {
+				out = append(out, "||"+strings.Join(cells, "||")+"||")
+				i++
+			} else {
+				out = append(out, "|"+strings.Join(cells, "|")+"|")
+			}
+			continue
+		}
+		if m := mdListPattern.FindStringSubmatch(line); m != nil {
+			indent := len(strings.ReplaceAll(m[1], "\t", "    "))
+			marker := byte('*')
+			if m[2][0] >= '0' && m[2][0] <= '9' {
+				marker = '#'
+			}
+			for len(lists) > 0 && lists[len(lists)-1].indent > indent {
+				lists = lists[:len(lists)-1]
+			}
+			if len(lists) > 0 && lists[len(lists)-1].indent == indent {
+				lists[len(lists)-1].marker = marker
+			} else {
+				lists = append(lists, mdListLevel{indent: indent, marker: marker})
+			}
+			prefix := make([]byte, len(lists))
+			for j, level := range lists {
+				prefix[j] = level.marker
+			}
+			out = append(out, string(prefix)+" "+markdownInlineToWiki(m[3]))
+			continue
+		}
+
+		// Paragraph text ends any list unless it is an indented continuation
+		if strings.TrimSpace(line) != "" && line[0] != ' ' && line[0] != '\t' {
+			lists = nil
+		}
+		out = append(out, markdownInlineToWiki(strings.TrimSpace(line)))
+	}
+
+	if fence != "" {
+		out = append(out, "{code}")
+	}
+	return strings.Join(out, "\n")
+}
+
+// markdownInlineToWiki converts the inline Markdown of a single line.
+func markdownInlineToWiki(text string) string {
+	var p placeholders
+
+	text = mdEscapePattern.ReplaceAllStringFunc(text, func(escape string) string {
+		if strings.ContainsAny(escape[1:], "*_{}[]-+!|~") {
+			return p.protect(escape)
+		}
+		return p.protect(escape[1:])
+	})
+	text = mdCodeSpanPattern.ReplaceAllStringFunc(text, func(span string) string {
+		m := mdCodeSpanPattern.FindStringSubmatch(span)
+		return p.protect("{{" + strings.TrimSpace(m[2]) + "}}")
+	})
+	text = mdImagePattern.ReplaceAllStringFunc(text, func(image string) string {
+		m := mdImagePattern.FindStringSubmatch(image)
+		return p.protect("!" + m[2] + "!")
+	})
+	text = mdLinkPattern.ReplaceAllStringFunc(text, func(link string) string {
+		m := mdLinkPattern.FindStringSubmatch(link)
+		if m[1] == m[2] {
+			return p.protect("[" + m[2] + "]")
+		}
+		return p.protect("[" + m[1] + "|" + m[2] + "]")
+	})
+	text = mdAutolinkPattern.ReplaceAllStringFunc(text, func(link string) string {
+		return p.protect("[" + strings.Trim(link, "<>") + "]")
+	})
+
+	// Escape characters that would otherwise start wiki macros or links
+	text = strings.NewReplacer("{", `\{`, "[", `\[`).Replace(text)
+
+	// Bold is marked with \x01 until italics have been converted
+	text = mdBoldPattern.ReplaceAllString(text, "\x01$1\x01")
+	text = replaceAllRepeated(mdBoldUnderscorePattern, text, "${1}\x01${2}\x01${3}")
+	text = replaceAllRepeated(mdItalicPattern, text, "${1}_${2}_${3}")
+	text = mdStrikePattern.ReplaceAllString(text, "-$1-")
+	text = strings.ReplaceAll(text, "\x01", "*")
+
+	return p.restore(text)
+}
+
+// WikiToMarkdown converts Jira wiki markup to Markdown.
+func WikiToMarkdown(wiki string) string {
+	return convertWiki(wiki, false)
+}
+
+// WikiToPlainText strips Jira wiki markup, keeping the text of headings, lists,
+// tables, links and code blocks.
+func WikiToPlainText(wiki string) string {
+	return convertWiki(wiki, true)
+}
+
+// convertWiki converts wiki markup to Markdown, or to plain text if plain is set.
+func convertWiki(wiki string, plain bool) string {
+	lines := strings.Split(strings.ReplaceAll(wiki, "\r\n", "\n"), "\n")
+	out := make([]string, 0, len(lines))
+	var counters []int // Item numbers of the open list levels, 0 for bullet levels
+	inTable := false
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		if !wikiTableRowPattern.MatchString(line) {
+			inTable = false
+		}
+		if m := wikiListPattern.FindStringSubmatch(line); m == nil && strings.TrimSpace(line) != "" {
+			counters = nil
+		}
+
+		// Code, quote and panel blocks, which may span several lines
+		if m := wikiBlockPattern.FindStringSubmatch(line); m != nil {
+			block, closing := m[1], "{"+m[1]+"}"
+			body := m[3]
+			for !strings.Contains(body, closing) && i+1 < len(lines) {
+				i++
+				body += "\n" + lines[i]
+			}
+			if index := strings.Index(body, closing); index >= 0 {
+				body = body[:index]
+			}
+			body = strings.Trim(body, "\n")
+
+			switch {
+			case block == "code" || block == "noformat":
+				if plain {
+					out = append(out, body)
+				} else {
+					out = append(out, "```"+wikiCodeLanguage(block, m[2]), body, "```")
+				}
+			case plain:
+				out = append(out, convertWiki(body, true))
+			default:
+				for _, quoted := range strings.Split(convertWiki(body, false), "\n") {
+					out = append(out, strings.TrimRight("> "+quoted, " "))
+				}
+			}
+			continue
+		}
+
+		if wikiRulePattern.MatchString(line) {
+			out = append(out, "---")
+			continue
+		}
+		if m := wikiHeadingPattern.FindStringSubmatch(line); m != nil {
+			level, _ := strconv.Atoi(m[1])
+			if plain {
+				out = append(out, wikiInline(m[2], true))
+			} else {
+				out = append(out, strings.Repeat("#", level)+" "+wikiInline(m[2], false))
+			}
+			continue
+		}
+		if m := wikiQuotePattern.FindStringSubmatch(line); m != nil {
+			if plain {
+				out = append(out, wikiInline(m[1], true))
+			} else {
+				out = append(out, "> "+wikiInline(m[1], false))
+			}
+			continue
+		}
+		if m := wikiListPattern.FindStringSubmatch(line); m != nil {
+			markers := m[1]
+			depth := len(markers) - 1
+			for len(counters) <= depth {
+				counters = append(counters, 0)
+			}
+			counters = counters[:depth+1]
+
+			indent := ""
+			for _, marker := range markers[:depth] {
+				if marker == '#' {
+					indent += "   "
+				} else {
+					indent += "  "
+				}
+			}
+			item := "-"
+			if markers[depth] == '#' {
+				counters[depth]++
+				item = strconv.Itoa(counters[depth]) + "."
+			} else {
+				counters[depth] = 0
+			}
+			out = append(out, indent+item+" "+wikiInline(m[2], plain))
+			continue
+		}
+		if wikiTableRowPattern.MatchString(line) {
+			trimmed := strings.TrimSpace(line)
+			header := strings.HasPrefix(trimmed, "||")
+			separator := "|"
+			if header {
+				separator = "||"
+			}
+			cells := splitWikiCells(strings.TrimSuffix(strings.TrimPrefix(trimmed, separator), separator), separator)
+			for j, cell := range cells {
+				cells[j] = wikiInline(strings.TrimSpace(cell), plain)
+			}
+
+			if plain {
+				out = append(out, strings.Join(cells, " | "))
+				continue
+			}
+			if !inTable {
+				// Markdown tables need a header row
+				if !header {
+					out = append(out, "|"+strings.Repeat("  |", len(cells)))
+				}
+				rule := "|" + strings.Repeat(" --- |", len(cells))
+				if header {
+					out = append(out, "| "+strings.Join(cells, " | ")+" |", rule)
+					inTable = true
+					continue
+				}
+				out = append(out, rule)
+				inTable = true
+			}
+			out = append(out, "| "+strings.Join(cells, " | ")+" |")
+			continue
+		}
+
+		out = append(out, wikiInline(line, plain))
+	}
+
+	return strings.Join(out, "\n")
+}
+
+// wikiCodeLanguage returns the language of a {code} block from its parameters,
+// e.g. "java" for {code:java} or {code:language=java|title=Example}.
+func wikiCodeLanguage(block, params string) string {
+	if block != "code" {
+		return ""
+	}
+	for _, param := range strings.Split(params, "|") {
+		name, value, found := strings.Cut(param, "=")
+		if !found && name != "" {
+			return strings.TrimSpace(name)
+		}
+		if strings.TrimSpace(name) == "language" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+// splitWikiCells splits a table row on separator, ignoring separators inside links
+// and monospace text.
+func splitWikiCells(row, separator string) []string {
+	var cells []string
+	depth, start := 0, 0
+	for i := 0; i < len(row); i++ {
+		switch {
+		case row[i] == '[' || row[i] == '{':
+			depth++
+		case (row[i] == ']' || row[i] == '}') && depth > 0:
+			depth--
+		case depth == 0 && strings.HasPrefix(row[i:], separator):
+			cells = append(cells, row[start:i])
+			i += len(separator) - 1
+			start = i + 1
+		}
+	}
+	return append(cells, row[start:])
+}
+
+// wikiInline converts the inline wiki markup of a single line to Markdown, or strips
+// it if plain is set.
+func wikiInline(text string, plain bool) string {
+	var p placeholders
+
+	text = wikiEscapePattern.ReplaceAllStringFunc(text, func(escape string) string {
+		if plain || strings.ContainsAny(escape[1:], "{}^?#") {
+			return p.protect(escape[1:])
+		}
+		return p.protect(escape)
+	})
+	text = wikiMonospacePattern.ReplaceAllStringFunc(text, func(span string) string {
+		code := wikiMonospacePattern.FindStringSubmatch(span)[1]
+		if plain {
+			return p.protect(code)
+		}
+		return p.protect("`" + code + "`")
+	})
+	text = wikiImagePattern.ReplaceAllStringFunc(text, func(image string) string {
+		if plain {
+			return ""
+		}
+		return p.protect("![](" + wikiImagePattern.FindStringSubmatch(image)[1] + ")")
+	})
+	text = wikiLinkPattern.ReplaceAllStringFunc(text, func(link string) string {
+		m := wikiLinkPattern.FindStringSubmatch(link)
+		label, target := m[1], strings.TrimPrefix(m[2], "~")
+		switch {
+		case plain && label == "":
+			return p.protect(target)
+		case plain:
+			return p.protect(label + " (" + target + ")")
+		case label == "":
+			return p.protect("<" + target + ">")
+		}
+		return p.protect("[" + label + "](" + target + ")")
+	})
+	text = wikiBareLinkPattern.ReplaceAllStringFunc(text, func(link string) string {
+		m := wikiBareLinkPattern.FindStringSubmatch(link)
+		switch {
+		case m[1] == "~":
+			return p.protect("@" + m[2])
+		case m[1] == "^" || plain:
+			return p.protect(m[2])
+		case strings.Contains(m[2], "://") || strings.HasPrefix(m[2], "mailto:"):
+			return p.protect("<" + m[2] + ">")
+		}
+		return link
+	})
+	text = wikiColorPattern.ReplaceAllString(text, "")
+
+	bold, italic, strike, underline := "\x01$2\x01", "*$2*", "~~$2~~", "<ins>$2</ins>"
+	if plain {
+		bold, italic, strike, underline = "$2", "$2", "$2", "$2"
+	}
+	// Bold is marked with \x01 until italics have been converted
+	text = replaceAllRepeated(wikiBoldPattern, text, "${1}"+bold+"${3}")
+	text = replaceAllRepeated(wikiItalicPattern, text, "${1}"+italic+"${3}")
+	text = replaceAllRepeated(wikiStrikePattern, text, "${1}"+strike+"${3}")
+	text = replaceAllRepeated(wikiUnderlinePattern, text, "${1}"+underline+"${3}")
+	text = strings.ReplaceAll(text, "\x01", "**")
+
+	return p.restore(text)
+}
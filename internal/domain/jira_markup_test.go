@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestMarkdownToWiki(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{"plain text", "Just some text.", "Just some text."},
+		{"bold", "This is **important**.", "This is *important*."},
+		{"bold underscores", "This is __important__.", "This is *important*."},
+		{"bold underscores at line edges", "__a__ and __b__", "*a* and *b*"},
+		{"double underscores inside words", "Set snake__case__name and __init__", "Set snake__case__name and *init*"},
+		{"double underscores in identifiers", "Use my__var or a__b__c here", "Use my__var or a__b__c here"},
+		{"italic", "This is *subtle*.", "This is _subtle_."},
+		{"italic underscores", "This is _subtle_.", "This is _subtle_."},
+		{"bold and italic", "**bold** and *italic* and *more*", "*bold* and _italic_ and _more_"},
+		{"strikethrough", "~~removed~~ text", "-removed- text"},
+		{"inline code", "Run `go test ./...` now", "Run {{go test ./...}} now"},
+		{"emphasis inside code untouched", "`**not bold**`", "{{**not bold**}}"},
+		{"snake case untouched", "call my_func_name here", "call my_func_name here"},
+		{"multiplication untouched", "2 * 3 * 4", "2 * 3 * 4"},
+		{"link", "See [the docs](https://example.com/a_b_c).", "See [the docs|https://example.com/a_b_c]."},
+		{"link with same text", "[https://example.com](https://example.com)", "[https://example.com]"},
+		{"link with code text", "[`main.go`](https://example.com)", "[{{main.go}}|https://example.com]"},
+		{"autolink", "<https://example.com>", "[https://example.com]"},
+		{"image", "![diagram](diagram.png)", "!diagram.png!"},
+		{"braces escaped", "Use {placeholder} here", `Use \{placeholder} here`},
+		{"brackets escaped", "- [ ] todo", `* \[ ] todo`},
+		{"markdown escape", `a \*literal\* star`, `a \*literal\* star`},
+		{"heading 1", "# Title", "h1. Title"},
+		{"heading 3 with closing hashes", "### Section ###", "h3. Section"},
+		{"heading with emphasis", "## The **new** API", "h2. The *new* API"},
+		{"block quote", "> quoted *text*", "bq. quoted _text_"},
+		{"horizontal rule", "---", "----"},
+		{"horizontal rule stars", "* * *", "----"},
+		{"bullet list", "- one\n- two\n* three", "* one\n* two\n* three"},
+		{"numbered list", "1. one\n2. two", "# one\n# two"},
+		{"nested list", "- one\n  - nested\n    1. deep\n- two", "* one\n** nested\n**# deep\n* two"},
+		{"numbered with nested bullets", "1. step\n   - detail\n2. next", "# step\n#* detail\n# next"},
+		{"list with bold item", "- **Note:** read this", "* *Note:* read this"},
+		{"paragraph ends list", "- item\n\nText\n  - new", "* item\n\nText\n* new"},
+		{"fenced code", "```go\nfunc main() {\n\t**x**\n}\n```", "{code:go}\nfunc main() {\n\t**x**\n}\n{code}"},
+		{"fenced code without language", "```\nplain\n```", "{code}\nplain\n{code}"},
+		{"tilde fence", "~~~\n# not a heading\n~~~", "{code}\n# not a heading\n{code}"},
+		{"unclosed fence", "```\ncode", "{code}\ncode\n{code}"},
+		{"table", "| Name | Value |\n|------|:-----:|\n| a | **b** |\n| c |  |",
+			"||Name||Value||\n|a|*b*|\n|c| |"},
+		{"table without header", "| a | b |", "|a|b|"},
+		{"windows line endings", "# Title\r\ntext", "h1. Title\ntext"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MarkdownToWiki(tt.markdown); got != tt.want {
+				t.Errorf("MarkdownToWiki(%q) =\n%q\nwant\n%q", tt.markdown, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWikiToMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		wiki string
+		want string
+	}{
+		{"plain text", "Just some text.", "Just some text."},
+		{"bold", "This is *important*.", "This is **important**."},
+		{"italic", "This is _subtle_.", "This is *subtle*."},
+		{"bold and italic", "*bold* and _italic_ and *more*", "**bold** and *italic* and **more**"},
+		{"strikethrough", "-removed- text", "~~removed~~ text"},
+		{"underline", "+inserted+", "<ins>inserted</ins>"},
+		{"hyphens untouched", "a well-known - and long - word on 2024-01-02", "a well-known - and long - word on 2024-01-02"},
+		{"snake case untouched", "call my_func_name here", "call my_func_name here"},
+		{"monospace", "Run {{go test}} now", "Run `go test` now"},
+		{"emphasis inside monospace untouched", "{{*ptr*}}", "`*ptr*`"},
+		{"link", "See [the docs|https://example.com/a_b_c].", "See [the docs](https://example.com/a_b_c)."},
+		{"bare link", "[https://example.com]", "<https://example.com>"},
+		{"mention", "Thanks [~jdoe]", "Thanks @jdoe"},
+		{"mention in link", "[John|~jdoe]", "[John](jdoe)"},
+		{"attachment link", "[^log.txt]", "log.txt"},
+		{"issue reference untouched", "[TEST-1]", "[TEST-1]"},
+		{"image", "!screenshot.png|thumbnail!", "![](screenshot.png)"},
+		{"exclamations untouched", "Hello! Great!", "Hello! Great!"},
+		{"color", "{color:red}alert{color}", "alert"},
+		{"escaped brace", `Use \{placeholder\}`, "Use {placeholder}"},
+		{"escaped star kept", `a \*b\* c`, `a \*b\* c`},
+		{"heading", "h2. The *new* API", "## The **new** API"},
+		{"block quote", "bq. quoted _text_", "> quoted *text*"},
+		{"horizontal rule", "----", "---"},
+		{"bullet list", "* one\n* two\n- three", "- one\n- two\n- three"},
+		{"numbered list", "# one\n# two\n# three", "1. one\n2. two\n3. three"},
+		{"nested list", "* one\n** nested\n**# deep\n**# deeper\n* two", "- one\n  - nested\n    1. deep\n    2. deeper\n- two"},
+		{"numbered with nested bullets", "# step\n#* detail\n# next", "1. step\n   - detail\n2. next"},
+		{"numbering restarts", "# a\n\ntext\n# b", "1. a\n\ntext\n1. b"},
+		{"list with bold item", "* *Note:* read this", "- **Note:** read this"},
+		{"code block", "{code:java}\nint x = *y*;\n{code}", "```java\nint x = *y*;\n```"},
+		{"code block with parameters", "{code:title=Example|language=go}\nx := 1\n{code}", "```go\nx := 1\n```"},
+		{"single line code block", "{code}x = 1{code}", "```\nx = 1\n```"},
+		{"noformat", "{noformat}\n* not a list\n{noformat}", "```\n* not a list\n```"},
+		{"quote block", "{quote}\nfirst *line*\n\nsecond\n{quote}", "> first **line**\n>\n> second"},
+		{"panel", "{panel:title=Note}\nh3. Inside\n{panel}", "> ### Inside"},
+		{"table", "||Name||Value||\n|a|*b*|\n|c|[link|http://x]|",
+			"| Name | Value |\n| --- | --- |\n| a | **b** |\n| c | [link](http://x) |"},
+		{"table without header", "|a|b|", "|  |  |\n| --- | --- |\n| a | b |"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WikiToMarkdown(tt.wiki); got != tt.want {
+				t.Errorf("WikiToMarkdown(%q) =\n%q\nwant\n%q", tt.wiki, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWikiToPlainText(t *testing.T) {
+	tests := []struct {
+		name string
+		wiki string
+		want string
+	}{
+		{"emphasis", "*bold* _italic_ -gone- +under+", "bold italic gone under"},
+		{"heading", "h1. Title", "Title"},
+		{"block quote", "bq. quoted", "quoted"},
+		{"monospace", "Run {{go test}}", "Run go test"},
+		{"link", "See [docs|https://example.com]", "See docs (https://example.com)"},
+		{"bare link and mention", "[https://example.com] by [~jdoe]", "https://example.com by @jdoe"},
+		{"image removed", "Before !shot.png! after", "Before  after"},
+		{"escapes", `\*literal\*`, "*literal*"},
+		{"lists", "* one\n*# first\n*# second", "- one\n  1. first\n  2. second"},
+		{"code block", "{code:go}\nx := *y*\n{code}", "x := *y*"},
+		{"quote block", "{quote}\n*said*\n{quote}", "said"},
+		{"table", "||Name||Value||\n|a|*b*|", "Name | Value\na | b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WikiToPlainText(tt.wiki); got != tt.want {
+				t.Errorf("WikiToPlainText(%q) =\n%q\nwant\n%q", tt.wiki, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkdownWikiRoundTrip(t *testing.T) {
+	tests := []string{
+		"# Title",
+		"Some **bold**, *italic* and ~~struck~~ text with `code`.",
+		"- one\n  - nested\n- two",
+		"1. first\n2. second",
+		"See [the docs](https://example.com).",
+		"```go\nfmt.Println(\"hi\")\n```",
+		"> quoted",
+		"| A | B |\n| --- | --- |\n| 1 | 2 |",
+	}
+
+	for _, markdown := range tests {
+		if got := WikiToMarkdown(MarkdownToWiki(markdown)); got != markdown {
+			t.Errorf("round trip of %q = %q", markdown, got)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ServiceDeskPaging is the paging information of Jira Service Management API lists.
+type ServiceDeskPaging struct {
+	Size       int  `json:"size"`
+	Start      int  `json:"start"`
+	Limit      int  `json:"limit"`
+	IsLastPage bool `json:"isLastPage"`
+}
+
+// ServiceDesk represents a Jira Service Management service desk.
+type ServiceDesk struct {
+	ID          FlexibleID `json:"id"`
+	ProjectID   FlexibleID `json:"projectId"`
+	ProjectKey  string     `json:"projectKey"`
+	ProjectName string     `json:"projectName"`
+}
+
+// ServiceDeskList is a page of service desks.
+type ServiceDeskList struct {
+	ServiceDeskPaging
+	Values []ServiceDesk `json:"values"`
+}
+
+// RequestType represents a customer request type of a service desk.
+type RequestType struct {
+	ID            FlexibleID   `json:"id"`
+	Name          string       `json:"name"`
+	Description   string       `json:"description,omitempty"`
+	HelpText      string       `json:"helpText,omitempty"`
+	IssueTypeID   FlexibleID   `json:"issueTypeId,omitempty"`
+	ServiceDeskID FlexibleID   `json:"serviceDeskId"`
+	GroupIDs      []FlexibleID `json:"groupIds,omitempty"`
+}
+
+// RequestTypeList is a page of request types.
+type RequestTypeList struct {
+	ServiceDeskPaging
+	Values []RequestType `json:"values"`
+}
+
+// RequestTypeField is a field customers fill in when raising a request.
+type RequestTypeField struct {
+	FieldID     string                  `json:"fieldId"`
+	Name        string                  `json:"name"`
+	Description string                  `json:"description,omitempty"`
+	Required    bool                    `json:"required"`
+	ValidValues []RequestTypeFieldValue `json:"validValues,omitempty"`
+	JiraSchema  json.RawMessage         `json:"jiraSchema,omitempty"`
+}
+
+// RequestTypeFieldValue is an allowed value of a request type field.
+type RequestTypeFieldValue struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+// RequestTypeFields are the fields of a request type and what the caller may set.
+type RequestTypeFields struct {
+	RequestTypeFields         []RequestTypeField `json:"requestTypeFields"`
+	CanRaiseOnBehalfOf        bool               `json:"canRaiseOnBehalfOf"`
+	CanAddRequestParticipants bool               `json:"canAddRequestParticipants"`
+}
+
+// MissingRequiredFields returns the names of required fields without a value,
+// keyed in values by field ID.
+func (f *RequestTypeFields) MissingRequiredFields(values map[string]interface{}) []string {
+	var missing []string
+	for _, field := range f.RequestTypeFields {
+		if _, ok := values[field.FieldID]; field.Required && !ok {
+			missing = append(missing, fmt.Sprintf("%s (%s)", field.Name, field.FieldID))
+		}
+	}
+	return missing
+}
+
+// CustomerRequestCreate represents the request body for raising a customer request.
+type CustomerRequestCreate struct {
+	ServiceDeskID       string                 `json:"serviceDeskId"`
+	RequestTypeID       string                 `json:"requestTypeId"`
+	RequestFieldValues  map[string]interface{} `json:"requestFieldValues"`
+	RaiseOnBehalfOf     string                 `json:"raiseOnBehalfOf,omitempty"`
+	RequestParticipants []string               `json:"requestParticipants,omitempty"`
+}
+
+// CustomerRequest represents a Jira Service Management customer request.
+type CustomerRequest struct {
+	IssueID            FlexibleID                  `json:"issueId"`
+	IssueKey           string                      `json:"issueKey"`
+	RequestTypeID      FlexibleID                  `json:"requestTypeId"`
+	ServiceDeskID      FlexibleID                  `json:"serviceDeskId"`
+	CreatedDate        *ServiceDeskDate            `json:"createdDate,omitempty"`
+	Reporter           *User                       `json:"reporter,omitempty"`
+	RequestFieldValues []CustomerRequestFieldValue `json:"requestFieldValues,omitempty"`
+	CurrentStatus      *CustomerRequestStatus      `json:"currentStatus,omitempty"`
+}
+
+// CustomerRequestFieldValue is the value of a field on a customer request.
+type CustomerRequestFieldValue struct {
+	FieldID string          `json:"fieldId"`
+	Label   string          `json:"label"`
+	Value   json.RawMessage `json:"value"`
+}
+
+// CustomerRequestStatus is the current status of a customer request.
+type CustomerRequestStatus struct {
+	Status     string           `json:"status"`
+	StatusDate *ServiceDeskDate `json:"statusDate,omitempty"`
+}
+
+// ServiceDeskDate is a timestamp as returned by the Service Management API.
+type ServiceDeskDate struct {
+	ISO8601     string `json:"iso8601"`
+	Friendly    string `json:"friendly,omitempty"`
+	EpochMillis int64  `json:"epochMillis"`
+}
+
+// RequestComment represents a comment on a customer request. Public comments are
+// visible to customers; internal comments only to agents.
+type RequestComment struct {
+	ID      FlexibleID       `json:"id,omitempty"`
+	Body    string           `json:"body"`
+	Public  bool             `json:"public"`
+	Author  *User            `json:"author,omitempty"`
+	Created *ServiceDeskDate `json:"created,omitempty"`
+}
+
+// RequestCommentList is a page of request comments.
+type RequestCommentList struct {
+	ServiceDeskPaging
+	Values []RequestComment `json:"values"`
+}
+
+// Queue represents a service desk queue.
+type Queue struct {
+	ID         FlexibleID `json:"id"`
+	Name       string     `json:"name"`
+	JQL        string     `json:"jql"`
+	Fields     []string   `json:"fields,omitempty"`
+	IssueCount *int       `json:"issueCount,omitempty"` // Only with includeCount
+}
+
+// QueueList is a page of queues.
+type QueueList struct {
+	ServiceDeskPaging
+	Values []Queue `json:"values"`
+}
+
+// QueueIssueList is a page of the issues in a queue.
+type QueueIssueList struct {
+	ServiceDeskPaging
+	Values []JiraIssue `json:"values"`
+}
+
+// SLA is a service level agreement metric of a customer request.
+type SLA struct {
+	ID              FlexibleID `json:"id"`
+	Name            string     `json:"name"`
+	OngoingCycle    *SLACycle  `json:"ongoingCycle,omitempty"`
+	CompletedCycles []SLACycle `json:"completedCycles"`
+}
+
+// SLACycle is one measurement of an SLA, from start to stop or still running.
+type SLACycle struct {
+	StartTime           *ServiceDeskDate `json:"startTime,omitempty"`
+	StopTime            *ServiceDeskDate `json:"stopTime,omitempty"`
+	BreachTime          *ServiceDeskDate `json:"breachTime,omitempty"`
+	Breached            bool             `json:"breached"`
+	Paused              bool             `json:"paused"`
+	WithinCalendarHours bool             `json:"withinCalendarHours"`
+	GoalDuration        *SLADuration     `json:"goalDuration,omitempty"`
+	ElapsedTime         *SLADuration     `json:"elapsedTime,omitempty"`
+	RemainingTime       *SLADuration     `json:"remainingTime,omitempty"`
+}
+
+// SLADuration is a duration as returned by the Service Management API.
+type SLADuration struct {
+	Millis   int64  `json:"millis"`
+	Friendly string `json:"friendly"`
+}
+
+// SLAList is a page of SLAs.
+type SLAList struct {
+	ServiceDeskPaging
+	Values []SLA `json:"values"`
+}
+
+// FindServiceDesk returns the service desk identified by its ID or project key.
+func FindServiceDesk(desks []ServiceDesk, idOrKey string) (*ServiceDesk, bool) {
+	for i, desk := range desks {
+		if string(desk.ID) == idOrKey || strings.EqualFold(desk.ProjectKey, idOrKey) {
+			return &desks[i], true
+		}
+	}
+	return nil, false
+}
+
+// FindRequestType returns the request type identified by its ID or name
+// (case-insensitive).
+func FindRequestType(types []RequestType, idOrName string) (*RequestType, bool) {
+	for i, requestType := range types {
+		if string(requestType.ID) == idOrName {
+			return &types[i], true
+		}
+	}
+	for i, requestType := range types {
+		if strings.EqualFold(requestType.Name, idOrName) {
+			return &types[i], true
+		}
+	}
+	return nil, false
+}
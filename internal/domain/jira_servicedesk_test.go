@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFindServiceDesk(t *testing.T) {
+	desks := []ServiceDesk{{ID: "1", ProjectKey: "HELP"}, {ID: "2", ProjectKey: "IT"}}
+
+	tests := []struct {
+		idOrKey string
+		want    FlexibleID
+		found   bool
+	}{
+		{"1", "1", true},
+		{"IT", "2", true},
+		{"help", "1", true},
+		{"HR", "", false},
+	}
+	for _, tt := range tests {
+		desk, found := FindServiceDesk(desks, tt.idOrKey)
+		if found != tt.found || (found && desk.ID != tt.want) {
+			t.Errorf("FindServiceDesk(%q) = %+v, %v", tt.idOrKey, desk, found)
+		}
+	}
+}
+
+func TestFindRequestType(t *testing.T) {
+	types := []RequestType{{ID: "10", Name: "Get IT help"}, {ID: "11", Name: "10"}}
+
+	tests := []struct {
+		idOrName string
+		want     FlexibleID
+		found    bool
+	}{
+		{"10", "10", true}, // IDs win over names
+		{"get it help", "10", true},
+		{"11", "11", true},
+		{"Report a bug", "", false},
+	}
+	for _, tt := range tests {
+		requestType, found := FindRequestType(types, tt.idOrName)
+		if found != tt.found || (found && requestType.ID != tt.want) {
+			t.Errorf("FindRequestType(%q) = %+v, %v", tt.idOrName, requestType, found)
+		}
+	}
+}
+
+func TestRequestTypeFields_MissingRequiredFields(t *testing.T) {
+	var fields RequestTypeFields
+	data := `{"requestTypeFields":[
+		{"fieldId":"summary","name":"Summary","required":true},
+		{"fieldId":"description","name":"Description","required":false},
+		{"fieldId":"customfield_10010","name":"Impact","required":true,"validValues":[{"value":"1","label":"High"}]}
+	]}`
+	if err := json.Unmarshal([]byte(data), &fields); err != nil {
+		t.Fatalf("Failed to unmarshal fields: %v", err)
+	}
+
+	missing := fields.MissingRequiredFields(map[string]interface{}{"summary": "Broken"})
+	if len(missing) != 1 || missing[0] != "Impact (customfield_10010)" {
+		t.Errorf("Unexpected missing fields: %v", missing)
+	}
+	if missing := fields.MissingRequiredFields(map[string]interface{}{"summary": "x", "customfield_10010": "1"}); len(missing) != 0 {
+		t.Errorf("Expected no missing fields, got %v", missing)
+	}
+}
+
+func TestServiceDeskListJSONDeserialization(t *testing.T) {
+	var list QueueList
+	data := `{"size":2,"start":0,"limit":50,"isLastPage":false,"values":[{"id":1,"name":"All open","jql":"resolution = EMPTY","issueCount":4},{"id":"2","name":"Mine","jql":"assignee = currentUser()"}]}`
+	if err := json.Unmarshal([]byte(data), &list); err != nil {
+		t.Fatalf("Failed to unmarshal queues: %v", err)
+	}
+	if list.Size != 2 || list.IsLastPage || len(list.Values) != 2 || list.Values[0].ID != "1" || *list.Values[0].IssueCount != 4 || list.Values[1].IssueCount != nil {
+		t.Errorf("Unexpected queues: %+v", list)
+	}
+}
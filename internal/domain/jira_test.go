@@ -2,6 +2,7 @@ package domain
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 )
 
@@ -155,6 +156,50 @@ func TestJiraIssueUpdateJSONSerialization(t *testing.T) {
 	}
 }
 
+func TestJiraUpdateOpsJSONSerialization(t *testing.T) {
+	var ops JiraUpdateOps
+	ops.Add("labels", "triaged")
+	ops.Remove("labels", "new")
+	ops.Set("duedate", nil)
+
+	issueUpdate := JiraIssueUpdate{
+		Fields: JiraFieldsUpdate{Priority: &PriorityRef{Name: "High"}},
+		Update: ops,
+	}
+
+	data, err := json.Marshal(issueUpdate)
+	if err != nil {
+		t.Fatalf("Failed to marshal JiraIssueUpdate: %v", err)
+	}
+	want := `{"fields":{"priority":{"name":"High"}},"update":{"duedate":[{"set":null}],"labels":[{"add":"triaged"},{"remove":"new"}]}}`
+	if string(data) != want {
+		t.Errorf("Expected %s, got %s", want, data)
+	}
+
+	var decoded JiraIssueUpdate
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal JiraIssueUpdate: %v", err)
+	}
+	labels := decoded.Update["labels"]
+	if len(labels) != 2 || labels[0].Verb != UpdateAdd || labels[0].Value != "triaged" || labels[1].Verb != UpdateRemove {
+		t.Errorf("Unexpected label operations: %+v", labels)
+	}
+	if dueDate := decoded.Update["duedate"]; len(dueDate) != 1 || dueDate[0].Verb != UpdateSet || dueDate[0].Value != nil {
+		t.Errorf("Unexpected due date operations: %+v", dueDate)
+	}
+
+	// Without operations the update key is omitted
+	data, _ = json.Marshal(JiraIssueUpdate{Fields: JiraFieldsUpdate{Summary: "x"}})
+	if strings.Contains(string(data), "update") {
+		t.Errorf("Expected no update operations, got %s", data)
+	}
+
+	var invalid UpdateOperation
+	if err := json.Unmarshal([]byte(`{"add":"a","remove":"b"}`), &invalid); err == nil {
+		t.Error("Expected error for operation with two verbs")
+	}
+}
+
 func TestIssueTransitionJSONSerialization(t *testing.T) {
 	transition := IssueTransition{
 		Transition: TransitionRef{
@@ -205,6 +250,37 @@ func TestCommentJSONSerialization(t *testing.T) {
 	}
 }
 
+func TestComment_VisibilityAndMetadata(t *testing.T) {
+	data := []byte(`{
+		"id": 10100,
+		"body": "Internal note",
+		"author": {"name": "jdoe", "displayName": "Jane Doe"},
+		"created": "2024-01-01T10:00:00.000+0000",
+		"updated": "2024-01-02T10:00:00.000+0000",
+		"visibility": {"type": "role", "value": "Developers"}
+	}`)
+
+	var comment Comment
+	if err := json.Unmarshal(data, &comment); err != nil {
+		t.Fatalf("Failed to unmarshal Comment: %v", err)
+	}
+	if comment.ID != "10100" || comment.Author == nil || comment.Author.Name != "jdoe" {
+		t.Errorf("Unexpected comment: %+v", comment)
+	}
+	if comment.Visibility == nil || comment.Visibility.Type != CommentVisibilityRole || comment.Visibility.Value != "Developers" {
+		t.Errorf("Unexpected visibility: %+v", comment.Visibility)
+	}
+
+	// Requests only carry the body and visibility
+	encoded, err := json.Marshal(Comment{Body: "Hi", Visibility: &CommentVisibility{Type: CommentVisibilityGroup, Value: "jira-users"}})
+	if err != nil {
+		t.Fatalf("Failed to marshal Comment: %v", err)
+	}
+	if string(encoded) != `{"body":"Hi","visibility":{"type":"group","value":"jira-users"}}` {
+		t.Errorf("Unexpected payload: %s", encoded)
+	}
+}
+
 func TestSearchResultsJSONSerialization(t *testing.T) {
 	results := SearchResults{
 		Issues: []JiraIssue{
@@ -434,3 +510,48 @@ func TestFlexibleIDWithStringJSON(t *testing.T) {
 		t.Errorf("Expected Status.ID '1', got '%s'", issue.Fields.Status.ID.String())
 	}
 }
+
+func TestProjectKeyFromIssueKey(t *testing.T) {
+	tests := []struct {
+		issueKey string
+		want     string
+		wantOK   bool
+	}{
+		{"TEST-123", "TEST", true},
+		{"MY-PROJ-7", "MY-PROJ", true},
+		{"TEST", "", false},
+		{"-1", "", false},
+		{"TEST-", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ProjectKeyFromIssueKey(tt.issueKey)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("ProjectKeyFromIssueKey(%q) = %q, %v; want %q, %v", tt.issueKey, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestCompactSearch(t *testing.T) {
+	results := &SearchResults{
+		Issues: []JiraIssue{
+			{Key: "TEST-1", Fields: JiraFields{Summary: "First", Status: Status{Name: "Open"}, Assignee: &User{Name: "jdoe", DisplayName: "John Doe"}}},
+			{Key: "TEST-2", Fields: JiraFields{Summary: "Second", Status: Status{Name: "Done"}}},
+		},
+		Total:      10,
+		StartAt:    0,
+		MaxResults: 2,
+		Truncated:  true,
+	}
+
+	compact := CompactSearch(results)
+	if compact.Total != 10 || compact.MaxResults != 2 || !compact.Truncated || len(compact.Issues) != 2 {
+		t.Fatalf("unexpected compact results: %+v", compact)
+	}
+	if compact.Issues[0] != (CompactIssue{Key: "TEST-1", Summary: "First", Status: "Open", Assignee: "jdoe"}) {
+		t.Errorf("unexpected first issue: %+v", compact.Issues[0])
+	}
+	if compact.Issues[1].Assignee != "" || compact.Issues[1].Status != "Done" {
+		t.Errorf("unexpected second issue: %+v", compact.Issues[1])
+	}
+}
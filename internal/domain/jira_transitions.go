@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Transition is a workflow transition available on an issue, as returned by
+// /rest/api/2/issue/{key}/transitions?expand=transitions.fields.
+type Transition struct {
+	ID        FlexibleID                 `json:"id"`
+	Name      string                     `json:"name"`
+	To        Status                     `json:"to"`
+	HasScreen bool                       `json:"hasScreen"`
+	Fields    map[string]CreateMetaField `json:"fields,omitempty"` // Screen fields keyed by field ID
+}
+
+// ScreenFields returns the transition screen fields sorted by ID, with FieldID set from the map key.
+func (t *Transition) ScreenFields() []CreateMetaField {
+	fields := make([]CreateMetaField, 0, len(t.Fields))
+	for id, field := range t.Fields {
+		field.FieldID = id
+		fields = append(fields, field)
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].FieldID < fields[j].FieldID })
+	return fields
+}
+
+// ResolveTransition finds a transition by ID, by transition name or by the name of
+// its target status (all names case-insensitive). The error lists the available
+// transitions, or the candidates if a status is reached by several transitions.
+func ResolveTransition(transitions []Transition, idOrName string) (*Transition, error) {
+	for i := range transitions {
+		if string(transitions[i].ID) == idOrName {
+			return &transitions[i], nil
+		}
+	}
+	for i := range transitions {
+		if strings.EqualFold(transitions[i].Name, idOrName) {
+			return &transitions[i], nil
+		}
+	}
+
+	var matches []*Transition
+	for i := range transitions {
+		if strings.EqualFold(transitions[i].To.Name, idOrName) {
+			matches = append(matches, &transitions[i])
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		available := make([]string, len(transitions))
+		for i := range transitions {
+			available[i] = describeTransition(&transitions[i])
+		}
+		if len(available) == 0 {
+			return nil, fmt.Errorf("no transitions are available for '%s'", idOrName)
+		}
+		return nil, fmt.Errorf("no transition matches '%s' (available: %s)", idOrName, strings.Join(available, ", "))
+	default:
+		candidates := make([]string, len(matches))
+		for i, match := range matches {
+			candidates[i] = describeTransition(match)
+		}
+		return nil, fmt.Errorf("several transitions lead to '%s', use a transition ID: %s", idOrName, strings.Join(candidates, ", "))
+	}
+}
+
+// describeTransition renders a transition as "Name [id] -> Status".
+func describeTransition(t *Transition) string {
+	return fmt.Sprintf("%s [%s] -> %s", t.Name, t.ID, t.To.Name)
+}
+
+// FormatMissingTransitionFields describes required transition screen fields that were not set.
+func FormatMissingTransitionFields(t *Transition, issueKey string, missing []CreateMetaField) string {
+	return formatMissingFields(fmt.Sprintf("missing required fields for transition '%s' on %s:", t.Name, issueKey), missing)
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const testTransitionsJSON = `[
+	{"id":"11","name":"Start Progress","to":{"id":"3","name":"In Progress"},"hasScreen":false},
+	{"id":"21","name":"Resolve","to":{"id":"5","name":"Done"},"hasScreen":true,"fields":{
+		"resolution":{"required":true,"hasDefaultValue":false,"name":"Resolution","schema":{"type":"resolution","system":"resolution"},
+			"allowedValues":[{"id":"1","name":"Fixed"},{"id":"2","name":"Won't Fix"}]},
+		"fixVersions":{"required":false,"name":"Fix Version/s","schema":{"type":"array","items":"version","system":"fixVersions"}}
+	}},
+	{"id":"31","name":"Close","to":{"id":"6","name":"Closed"}},
+	{"id":"41","name":"Reject","to":{"id":"6","name":"Closed"}}
+]`
+
+func testTransitions(t *testing.T) []Transition {
+	t.Helper()
+	var transitions []Transition
+	if err := json.Unmarshal([]byte(testTransitionsJSON), &transitions); err != nil {
+		t.Fatalf("Failed to unmarshal transitions: %v", err)
+	}
+	return transitions
+}
+
+func TestResolveTransition(t *testing.T) {
+	transitions := testTransitions(t)
+
+	tests := []struct {
+		name    string
+		input   string
+		wantID  FlexibleID
+		wantErr string
+	}{
+		{name: "by ID", input: "21", wantID: "21"},
+		{name: "by transition name", input: "start progress", wantID: "11"},
+		{name: "by target status", input: "done", wantID: "21"},
+		{name: "ambiguous target status", input: "Closed", wantErr: "Close [31] -> Closed, Reject [41] -> Closed"},
+		{name: "unknown", input: "Reopen", wantErr: "available: Start Progress [11] -> In Progress"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transition, err := ResolveTransition(transitions, tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if transition.ID != tt.wantID {
+				t.Errorf("Expected transition %s, got %s", tt.wantID, transition.ID)
+			}
+		})
+	}
+
+	if _, err := ResolveTransition(nil, "Done"); err == nil || !strings.Contains(err.Error(), "no transitions are available") {
+		t.Errorf("Expected error for no transitions, got %v", err)
+	}
+}
+
+func TestTransition_ScreenFields(t *testing.T) {
+	transitions := testTransitions(t)
+
+	fields := transitions[1].ScreenFields()
+	if len(fields) != 2 || fields[0].FieldID != "fixVersions" || fields[1].FieldID != "resolution" {
+		t.Fatalf("Unexpected screen fields: %+v", fields)
+	}
+
+	missing := MissingRequiredFields(fields, map[string]bool{})
+	message := FormatMissingTransitionFields(&transitions[1], "TEST-1", missing)
+	if !strings.Contains(message, "missing required fields for transition 'Resolve' on TEST-1:") ||
+		!strings.Contains(message, "Resolution (resolution): one of Fixed, Won't Fix") {
+		t.Errorf("Unexpected message:\n%s", message)
+	}
+	if strings.Contains(message, "Fix Version") {
+		t.Errorf("Expected optional fields to be omitted:\n%s", message)
+	}
+
+	if len(transitions[0].ScreenFields()) != 0 {
+		t.Error("Expected no screen fields")
+	}
+}
+
+func TestIssueTransition_JSONSerialization(t *testing.T) {
+	data, err := json.Marshal(IssueTransition{Transition: TransitionRef{ID: "21"}})
+	if err != nil {
+		t.Fatalf("Failed to marshal IssueTransition: %v", err)
+	}
+	if string(data) != `{"transition":{"id":"21"}}` {
+		t.Errorf("Unexpected payload: %s", data)
+	}
+}
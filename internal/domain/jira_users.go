@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GroupMembers is a page of the members of a Jira group.
+type GroupMembers struct {
+	Group      string `json:"group"`
+	StartAt    int    `json:"startAt"`
+	MaxResults int    `json:"maxResults"`
+	Total      int    `json:"total"`
+	IsLast     bool   `json:"isLast"`
+	Values     []User `json:"values"`
+}
+
+// AmbiguousUserError reports that a user query matched more than one user.
+type AmbiguousUserError struct {
+	Query      string
+	Candidates []User
+}
+
+// Error lists the matching users so the caller can pick one by username.
+func (e *AmbiguousUserError) Error() string {
+	matches := make([]string, len(e.Candidates))
+	for i, user := range e.Candidates {
+		matches[i] = FormatUser(user)
+	}
+	return fmt.Sprintf("'%s' matches %d users, use one of the usernames: %s", e.Query, len(e.Candidates), strings.Join(matches, ", "))
+}
+
+// FormatUser renders a user as "Display Name (username, email)" for messages.
+func FormatUser(user User) string {
+	details := []string{user.Name}
+	if user.EmailAddress != "" {
+		details = append(details, user.EmailAddress)
+	}
+	if user.DisplayName == "" {
+		return strings.Join(details, ", ")
+	}
+	return fmt.Sprintf("%s (%s)", user.DisplayName, strings.Join(details, ", "))
+}
+
+// ResolveUser picks the user identified by query (a username, email address or
+// display name) among the candidates returned by a Jira user search. Exact
+// matches win, checked by username, then email, then display name; otherwise
+// a single candidate is accepted. Several matches yield an *AmbiguousUserError.
+func ResolveUser(candidates []User, query string) (*User, error) {
+	fields := []func(User) string{
+		func(user User) string { return user.Name },
+		func(user User) string { return user.EmailAddress },
+		func(user User) string { return user.DisplayName },
+	}
+	for _, field := range fields {
+		var matches []User
+		for _, user := range candidates {
+			if strings.EqualFold(field(user), query) {
+				matches = append(matches, user)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			continue
+		case 1:
+			return &matches[0], nil
+		default:
+			return nil, &AmbiguousUserError{Query: query, Candidates: matches}
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf("no user matches '%s'", query)
+	case 1:
+		return &candidates[0], nil
+	default:
+		return nil, &AmbiguousUserError{Query: query, Candidates: candidates}
+	}
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestResolveUser(t *testing.T) {
+	candidates := []User{
+		{Name: "jdoe", DisplayName: "John Doe", EmailAddress: "john@example.com"},
+		{Name: "jdoe2", DisplayName: "John Doe", EmailAddress: "john.doe@example.com"},
+		{Name: "asmith", DisplayName: "Alice Smith", EmailAddress: "alice@example.com"},
+	}
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"jdoe", "jdoe"},
+		{"JDOE2", "jdoe2"},
+		{"alice@example.com", "asmith"},
+		{"alice smith", "asmith"},
+	}
+	for _, tt := range tests {
+		user, err := ResolveUser(candidates, tt.query)
+		if err != nil {
+			t.Errorf("ResolveUser(%q) unexpected error: %v", tt.query, err)
+			continue
+		}
+		if user.Name != tt.want {
+			t.Errorf("ResolveUser(%q) = %s, want %s", tt.query, user.Name, tt.want)
+		}
+	}
+}
+
+func TestResolveUser_SingleCandidate(t *testing.T) {
+	user, err := ResolveUser([]User{{Name: "asmith", DisplayName: "Alice Smith"}}, "alice")
+	if err != nil || user.Name != "asmith" {
+		t.Errorf("expected the only candidate, got %+v (%v)", user, err)
+	}
+}
+
+func TestResolveUser_Ambiguous(t *testing.T) {
+	candidates := []User{
+		{Name: "jdoe", DisplayName: "John Doe"},
+		{Name: "jdoe2", DisplayName: "John Doe", EmailAddress: "john.doe@example.com"},
+	}
+
+	_, err := ResolveUser(candidates, "John Doe")
+	var ambiguous *AmbiguousUserError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("expected AmbiguousUserError, got %v", err)
+	}
+	if len(ambiguous.Candidates) != 2 {
+		t.Errorf("expected 2 candidates, got %d", len(ambiguous.Candidates))
+	}
+	if msg := err.Error(); !strings.Contains(msg, "John Doe (jdoe)") || !strings.Contains(msg, "John Doe (jdoe2, john.doe@example.com)") {
+		t.Errorf("unexpected message: %s", msg)
+	}
+
+	if _, err := ResolveUser(candidates, "john"); !errors.As(err, &ambiguous) {
+		t.Errorf("expected partial matches to be ambiguous, got %v", err)
+	}
+}
+
+func TestResolveUser_NotFound(t *testing.T) {
+	_, err := ResolveUser(nil, "nobody")
+	if err == nil || !strings.Contains(err.Error(), "no user matches 'nobody'") {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
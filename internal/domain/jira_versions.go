@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// JiraVersion is a project version (release) used in fixVersions and affectedVersions.
+type JiraVersion struct {
+	ID              FlexibleID `json:"id,omitempty"`
+	Name            string     `json:"name"`
+	Description     string     `json:"description,omitempty"`
+	ProjectID       int        `json:"projectId,omitempty"`
+	Archived        bool       `json:"archived"`
+	Released        bool       `json:"released"`
+	Overdue         bool       `json:"overdue,omitempty"`
+	StartDate       string     `json:"startDate,omitempty"`       // YYYY-MM-DD
+	ReleaseDate     string     `json:"releaseDate,omitempty"`     // YYYY-MM-DD
+	UserReleaseDate string     `json:"userReleaseDate,omitempty"` // Release date as displayed to the user
+}
+
+// JiraVersionRequest creates or partially updates a version; empty fields are left unchanged.
+type JiraVersionRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Project     string `json:"project,omitempty"` // Project key (create only)
+	StartDate   string `json:"startDate,omitempty"`
+	ReleaseDate string `json:"releaseDate,omitempty"`
+	Released    *bool  `json:"released,omitempty"`
+	Archived    *bool  `json:"archived,omitempty"`
+}
+
+// VersionIssueCounts summarizes the issues related to a version.
+type VersionIssueCounts struct {
+	VersionID             string `json:"versionId"`
+	IssuesFixedCount      int    `json:"issuesFixedCount"`
+	IssuesAffectedCount   int    `json:"issuesAffectedCount"`
+	IssuesUnresolvedCount int    `json:"issuesUnresolvedCount"`
+}
+
+// Component is a project component.
+type Component struct {
+	ID           FlexibleID `json:"id,omitempty"`
+	Name         string     `json:"name"`
+	Description  string     `json:"description,omitempty"`
+	Lead         *User      `json:"lead,omitempty"`
+	AssigneeType string     `json:"assigneeType,omitempty"`
+	Project      string     `json:"project,omitempty"`
+	ProjectID    int        `json:"projectId,omitempty"`
+}
+
+// ComponentRequest creates a component.
+type ComponentRequest struct {
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	Project      string `json:"project"`                // Project key
+	LeadUserName string `json:"leadUserName,omitempty"` // Username of the component lead
+	AssigneeType string `json:"assigneeType,omitempty"` // PROJECT_DEFAULT, COMPONENT_LEAD, PROJECT_LEAD or UNASSIGNED
+}
+
+// ResolveJiraVersion finds a version by ID or by name (case-insensitive).
+// The error lists the versions available in the project.
+func ResolveJiraVersion(versions []JiraVersion, projectKey, nameOrID string) (*JiraVersion, error) {
+	for i := range versions {
+		if string(versions[i].ID) == nameOrID || strings.EqualFold(versions[i].Name, nameOrID) {
+			return &versions[i], nil
+		}
+	}
+
+	names := make([]string, len(versions))
+	for i, version := range versions {
+		names[i] = version.Name
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("version '%s' does not exist in project %s (available: %s)", nameOrID, projectKey, strings.Join(names, ", "))
+}
+
+// Ways of changing the fix versions of an issue.
+const (
+	VersionChangeSet    = "set"    // Replace the fix versions
+	VersionChangeAdd    = "add"    // Add to the existing fix versions
+	VersionChangeRemove = "remove" // Remove from the existing fix versions
+)
+
+// ApplyVersionChange computes the version IDs an issue should have after adding,
+// removing or setting the given version IDs. changed is false if the result equals current.
+func ApplyVersionChange(current, versionIDs []string, mode string) (result []string, changed bool) {
+	has := make(map[string]bool, len(current))
+	for _, id := range current {
+		has[id] = true
+	}
+
+	switch mode {
+	case VersionChangeAdd:
+		result = append(result, current...)
+		for _, id := range versionIDs {
+			if !has[id] {
+				result = append(result, id)
+				has[id] = true
+			}
+		}
+	case VersionChangeRemove:
+		remove := make(map[string]bool, len(versionIDs))
+		for _, id := range versionIDs {
+			remove[id] = true
+		}
+		for _, id := range current {
+			if !remove[id] {
+				result = append(result, id)
+			}
+		}
+	default:
+		result = append(result, versionIDs...)
+	}
+
+	if result == nil {
+		result = []string{}
+	}
+	return result, !sameStringSet(current, result)
+}
+
+// sameStringSet reports whether a and b contain the same strings, ignoring order.
+func sameStringSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	count := make(map[string]int, len(a))
+	for _, s := range a {
+		count[s]++
+	}
+	for _, s := range b {
+		if count[s] == 0 {
+			return false
+		}
+		count[s]--
+	}
+	return true
+}
+
+// IssueVersionChange is the outcome of changing the fix versions of one issue.
+type IssueVersionChange struct {
+	IssueKey string   `json:"issueKey"`
+	Status   string   `json:"status"` // updated, unchanged or failed
+	Versions []string `json:"versions,omitempty"`
+	Error    string   `json:"error,omitempty"`
+}
+
+// BulkVersionResult summarizes a bulk fix version change.
+type BulkVersionResult struct {
+	Matched   int                  `json:"matched"`
+	Updated   int                  `json:"updated"`
+	Unchanged int                  `json:"unchanged"`
+	Failed    int                  `json:"failed"`
+	Truncated bool                 `json:"truncated"` // True if more issues matched than were processed
+	Issues    []IssueVersionChange `json:"issues"`
+}
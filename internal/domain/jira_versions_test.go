@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testJiraVersions = []JiraVersion{
+	{ID: "10000", Name: "1.0", Released: true},
+	{ID: "10001", Name: "1.1"},
+	{ID: "10002", Name: "2.0"},
+}
+
+func TestResolveJiraVersion(t *testing.T) {
+	version, err := ResolveJiraVersion(testJiraVersions, "TEST", "10001")
+	if err != nil || version.Name != "1.1" {
+		t.Errorf("Expected 1.1 by ID, got %+v (%v)", version, err)
+	}
+
+	version, err = ResolveJiraVersion(testJiraVersions, "TEST", "2.0")
+	if err != nil || version.ID != "10002" {
+		t.Errorf("Expected 2.0 by name, got %+v (%v)", version, err)
+	}
+
+	_, err = ResolveJiraVersion(testJiraVersions, "TEST", "3.0")
+	if err == nil || !strings.Contains(err.Error(), "available: 1.0, 1.1, 2.0") {
+		t.Errorf("Expected error listing versions, got %v", err)
+	}
+}
+
+func TestApplyVersionChange(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     []string
+		versions    []string
+		mode        string
+		want        []string
+		wantChanged bool
+	}{
+		{name: "add new", current: []string{"1"}, versions: []string{"2"}, mode: VersionChangeAdd, want: []string{"1", "2"}, wantChanged: true},
+		{name: "add existing", current: []string{"1", "2"}, versions: []string{"2"}, mode: VersionChangeAdd, want: []string{"1", "2"}},
+		{name: "remove", current: []string{"1", "2"}, versions: []string{"1"}, mode: VersionChangeRemove, want: []string{"2"}, wantChanged: true},
+		{name: "remove absent", current: []string{"2"}, versions: []string{"1"}, mode: VersionChangeRemove, want: []string{"2"}},
+		{name: "set", current: []string{"1"}, versions: []string{"2", "3"}, mode: VersionChangeSet, want: []string{"2", "3"}, wantChanged: true},
+		{name: "set same order-insensitive", current: []string{"3", "2"}, versions: []string{"2", "3"}, mode: VersionChangeSet, want: []string{"2", "3"}},
+		{name: "set empty clears", current: []string{"1"}, versions: nil, mode: VersionChangeSet, want: []string{}, wantChanged: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := ApplyVersionChange(tt.current, tt.versions, tt.mode)
+			if !reflect.DeepEqual(got, tt.want) || changed != tt.wantChanged {
+				t.Errorf("ApplyVersionChange() = %v, %v; want %v, %v", got, changed, tt.want, tt.wantChanged)
+			}
+		})
+	}
+}
+
+func TestJiraVersionRequest_OmitsUnsetFields(t *testing.T) {
+	released := true
+	data, err := json.Marshal(JiraVersionRequest{Released: &released, ReleaseDate: "2024-02-01"})
+	if err != nil {
+		t.Fatalf("Failed to marshal JiraVersionRequest: %v", err)
+	}
+	if string(data) != `{"releaseDate":"2024-02-01","released":true}` {
+		t.Errorf("Unexpected payload: %s", data)
+	}
+
+	archived := false
+	data, _ = json.Marshal(JiraVersionRequest{Archived: &archived})
+	if string(data) != `{"archived":false}` {
+		t.Errorf("Expected explicit false to be sent: %s", data)
+	}
+}
+
+func TestProject_WithVersionsAndComponents(t *testing.T) {
+	data := []byte(`{
+		"id": "10000", "key": "TEST", "name": "Test Project",
+		"lead": {"name": "jdoe"},
+		"versions": [{"id": "10001", "name": "1.1", "released": false, "archived": false}],
+		"components": [{"id": "10100", "name": "API", "lead": {"name": "asmith"}}]
+	}`)
+
+	var project Project
+	if err := json.Unmarshal(data, &project); err != nil {
+		t.Fatalf("Failed to unmarshal Project: %v", err)
+	}
+	if len(project.Versions) != 1 || project.Versions[0].Name != "1.1" {
+		t.Errorf("Unexpected versions: %+v", project.Versions)
+	}
+	if len(project.Components) != 1 || project.Components[0].Lead.Name != "asmith" {
+		t.Errorf("Unexpected components: %+v", project.Components)
+	}
+}
@@ -0,0 +1,43 @@
+package domain
+
+// Watchers lists the users watching an issue.
+type Watchers struct {
+	WatchCount int    `json:"watchCount"`
+	IsWatching bool   `json:"isWatching"` // Whether the authenticated user is watching
+	Watchers   []User `json:"watchers"`
+}
+
+// Votes lists the users who voted for an issue.
+type Votes struct {
+	Votes    int    `json:"votes"`
+	HasVoted bool   `json:"hasVoted"` // Whether the authenticated user has voted
+	Voters   []User `json:"voters"`
+}
+
+// GroupRef references a Jira group by name.
+type GroupRef struct {
+	Name string `json:"name"`
+}
+
+// NotificationRecipients selects who receives an issue notification.
+type NotificationRecipients struct {
+	Reporter bool       `json:"reporter,omitempty"`
+	Assignee bool       `json:"assignee,omitempty"`
+	Watchers bool       `json:"watchers,omitempty"`
+	Voters   bool       `json:"voters,omitempty"`
+	Users    []UserRef  `json:"users,omitempty"`
+	Groups   []GroupRef `json:"groups,omitempty"`
+}
+
+// Empty reports whether no recipient is selected.
+func (r NotificationRecipients) Empty() bool {
+	return !r.Reporter && !r.Assignee && !r.Watchers && !r.Voters && len(r.Users) == 0 && len(r.Groups) == 0
+}
+
+// Notification is an email about an issue sent through /issue/{key}/notify.
+type Notification struct {
+	Subject  string                 `json:"subject,omitempty"`
+	TextBody string                 `json:"textBody,omitempty"`
+	HTMLBody string                 `json:"htmlBody,omitempty"`
+	To       NotificationRecipients `json:"to"`
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNotificationRecipients_Empty(t *testing.T) {
+	if !(NotificationRecipients{}).Empty() {
+		t.Error("Expected no recipients to be empty")
+	}
+	if (NotificationRecipients{Watchers: true}).Empty() {
+		t.Error("Expected watchers to be a recipient")
+	}
+	if (NotificationRecipients{Groups: []GroupRef{{Name: "release-managers"}}}).Empty() {
+		t.Error("Expected a group to be a recipient")
+	}
+}
+
+func TestNotification_JSONSerialization(t *testing.T) {
+	data, err := json.Marshal(Notification{
+		Subject:  "Deployed",
+		TextBody: "TEST-1 is live",
+		To: NotificationRecipients{
+			Assignee: true,
+			Users:    []UserRef{{Name: "jdoe"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal Notification: %v", err)
+	}
+
+	want := `{"subject":"Deployed","textBody":"TEST-1 is live","to":{"assignee":true,"users":[{"name":"jdoe"}]}}`
+	if string(data) != want {
+		t.Errorf("Unexpected payload:\n got %s\nwant %s", data, want)
+	}
+}
+
+func TestWatchers_JSONDeserialization(t *testing.T) {
+	data := []byte(`{"watchCount": 2, "isWatching": true, "watchers": [{"name": "jdoe"}, {"name": "asmith"}]}`)
+
+	var watchers Watchers
+	if err := json.Unmarshal(data, &watchers); err != nil {
+		t.Fatalf("Failed to unmarshal Watchers: %v", err)
+	}
+	if watchers.WatchCount != 2 || !watchers.IsWatching || len(watchers.Watchers) != 2 {
+		t.Errorf("Unexpected watchers: %+v", watchers)
+	}
+}
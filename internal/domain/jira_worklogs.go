@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Worklog is time logged on a Jira issue.
+type Worklog struct {
+	ID               FlexibleID         `json:"id,omitempty"`
+	IssueID          FlexibleID         `json:"issueId,omitempty"`
+	Author           *User              `json:"author,omitempty"`
+	UpdateAuthor     *User              `json:"updateAuthor,omitempty"`
+	Comment          string             `json:"comment,omitempty"`
+	Started          string             `json:"started,omitempty"`   // e.g. 2024-01-15T09:00:00.000+0000
+	TimeSpent        string             `json:"timeSpent,omitempty"` // e.g. 1h 30m
+	TimeSpentSeconds int                `json:"timeSpentSeconds,omitempty"`
+	Created          string             `json:"created,omitempty"`
+	Updated          string             `json:"updated,omitempty"`
+	Visibility       *CommentVisibility `json:"visibility,omitempty"`
+}
+
+// WorklogPage is the list of worklogs on an issue.
+type WorklogPage struct {
+	StartAt    int       `json:"startAt"`
+	MaxResults int       `json:"maxResults"`
+	Total      int       `json:"total"`
+	Worklogs   []Worklog `json:"worklogs"`
+}
+
+// TimeTracking holds the estimates and logged time of an issue.
+type TimeTracking struct {
+	OriginalEstimate         string `json:"originalEstimate,omitempty"`
+	RemainingEstimate        string `json:"remainingEstimate,omitempty"`
+	TimeSpent                string `json:"timeSpent,omitempty"`
+	OriginalEstimateSeconds  int    `json:"originalEstimateSeconds,omitempty"`
+	RemainingEstimateSeconds int    `json:"remainingEstimateSeconds,omitempty"`
+	TimeSpentSeconds         int    `json:"timeSpentSeconds,omitempty"`
+}
+
+// JiraTimeLayout is the timestamp format used by the Jira REST API.
+const JiraTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// jiraDurationPattern matches Jira durations such as "3h", "1d 4h 30m" or "2w".
+var jiraDurationPattern = regexp.MustCompile(`^\s*(\d+(\.\d+)?\s*[wdhm]\s*)+$`)
+
+// ValidJiraDuration reports whether s is a Jira duration such as "1d 4h 30m".
+func ValidJiraDuration(s string) bool {
+	return jiraDurationPattern.MatchString(s)
+}
+
+// ParseJiraTime parses a timestamp in Jira's format, RFC 3339, or a plain date
+// (YYYY-MM-DD, taken as midnight UTC).
+func ParseJiraTime(s string) (time.Time, error) {
+	for _, layout := range []string{JiraTimeLayout, time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time '%s' (use YYYY-MM-DD or RFC 3339)", s)
+}
+
+// FormatWorkDuration renders seconds as hours and minutes, e.g. "10h 30m".
+func FormatWorkDuration(seconds int) string {
+	hours := seconds / 3600
+	minutes := (seconds % 3600) / 60
+	switch {
+	case hours > 0 && minutes > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	case hours > 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
+
+// IssueWorklogs pairs an issue with its worklogs for reporting.
+type IssueWorklogs struct {
+	IssueKey string
+	Summary  string
+	Worklogs []Worklog
+}
+
+// WorklogTotal is the time logged by a user or on an issue.
+type WorklogTotal struct {
+	Key     string         `json:"key"`            // Username or issue key
+	Name    string         `json:"name,omitempty"` // Display name or issue summary
+	Seconds int            `json:"seconds"`
+	Time    string         `json:"time"`
+	Issues  []WorklogTotal `json:"issues,omitempty"` // Per-issue breakdown (users only)
+}
+
+// WorklogReport aggregates logged time over a set of issues and a date range.
+type WorklogReport struct {
+	From         string         `json:"from"`
+	To           string         `json:"to"`
+	TotalSeconds int            `json:"totalSeconds"`
+	Total        string         `json:"total"`
+	IssueCount   int            `json:"issueCount"`
+	Truncated    bool           `json:"truncated"` // True if not all matching issues were included
+	ByUser       []WorklogTotal `json:"byUser"`
+	ByIssue      []WorklogTotal `json:"byIssue"`
+}
+
+// NewWorklogReport totals the worklogs started between from and to (inclusive dates,
+// YYYY-MM-DD, compared in each worklog's own time zone) per user and per issue.
+// Totals are sorted by time logged, largest first.
+func NewWorklogReport(issues []IssueWorklogs, from, to string) *WorklogReport {
+	report := &WorklogReport{
+		From:       from,
+		To:         to,
+		IssueCount: len(issues),
+		ByUser:     []WorklogTotal{},
+		ByIssue:    []WorklogTotal{},
+	}
+
+	users := make(map[string]*WorklogTotal)
+	userIssues := make(map[string]map[string]*WorklogTotal)
+	for _, issue := range issues {
+		issueSeconds := 0
+		for _, worklog := range issue.Worklogs {
+			started, err := ParseJiraTime(worklog.Started)
+			if err != nil {
+				continue
+			}
+			if day := started.Format("2006-01-02"); day < from || day > to {
+				continue
+			}
+
+			username, displayName := "unknown", ""
+			if worklog.Author != nil {
+				username, displayName = worklog.Author.Name, worklog.Author.DisplayName
+			}
+			user, ok := users[username]
+			if !ok {
+				user = &WorklogTotal{Key: username, Name: displayName}
+				users[username] = user
+				userIssues[username] = make(map[string]*WorklogTotal)
+			}
+			user.Seconds += worklog.TimeSpentSeconds
+
+			perIssue, ok := userIssues[username][issue.IssueKey]
+			if !ok {
+				perIssue = &WorklogTotal{Key: issue.IssueKey, Name: issue.Summary}
+				userIssues[username][issue.IssueKey] = perIssue
+			}
+			perIssue.Seconds += worklog.TimeSpentSeconds
+
+			issueSeconds += worklog.TimeSpentSeconds
+		}
+
+		if issueSeconds > 0 {
+			report.ByIssue = append(report.ByIssue, WorklogTotal{
+				Key:     issue.IssueKey,
+				Name:    issue.Summary,
+				Seconds: issueSeconds,
+				Time:    FormatWorkDuration(issueSeconds),
+			})
+			report.TotalSeconds += issueSeconds
+		}
+	}
+
+	for username, user := range users {
+		user.Time = FormatWorkDuration(user.Seconds)
+		for _, perIssue := range userIssues[username] {
+			perIssue.Time = FormatWorkDuration(perIssue.Seconds)
+			user.Issues = append(user.Issues, *perIssue)
+		}
+		sortWorklogTotals(user.Issues)
+		report.ByUser = append(report.ByUser, *user)
+	}
+	sortWorklogTotals(report.ByUser)
+	sortWorklogTotals(report.ByIssue)
+	report.Total = FormatWorkDuration(report.TotalSeconds)
+
+	return report
+}
+
+// sortWorklogTotals orders totals by time logged (largest first), then by key.
+func sortWorklogTotals(totals []WorklogTotal) {
+	sort.Slice(totals, func(i, j int) bool {
+		if totals[i].Seconds != totals[j].Seconds {
+			return totals[i].Seconds > totals[j].Seconds
+		}
+		return strings.Compare(totals[i].Key, totals[j].Key) < 0
+	})
+}
@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestValidJiraDuration(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"3h", true},
+		{"1d 4h 30m", true},
+		{"2w", true},
+		{"1.5h", true},
+		{"45m ", true},
+		{"", false},
+		{"3 hours", false},
+		{"90", false},
+		{"h", false},
+	}
+
+	for _, tt := range tests {
+		if got := ValidJiraDuration(tt.input); got != tt.want {
+			t.Errorf("ValidJiraDuration(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestParseJiraTime(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "2024-01-15T09:30:00.000+0100", want: "2024-01-15T09:30:00.000+0100"},
+		{input: "2024-01-15T09:30:00Z", want: "2024-01-15T09:30:00.000+0000"},
+		{input: "2024-01-15", want: "2024-01-15T00:00:00.000+0000"},
+		{input: "15/01/2024", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseJiraTime(tt.input)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseJiraTime(%q): expected error", tt.input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseJiraTime(%q): unexpected error: %v", tt.input, err)
+			continue
+		}
+		if formatted := got.Format(JiraTimeLayout); formatted != tt.want {
+			t.Errorf("ParseJiraTime(%q) = %s, want %s", tt.input, formatted, tt.want)
+		}
+	}
+}
+
+func TestFormatWorkDuration(t *testing.T) {
+	tests := []struct {
+		seconds int
+		want    string
+	}{
+		{0, "0m"},
+		{1800, "30m"},
+		{3600, "1h"},
+		{37800, "10h 30m"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatWorkDuration(tt.seconds); got != tt.want {
+			t.Errorf("FormatWorkDuration(%d) = %q, want %q", tt.seconds, got, tt.want)
+		}
+	}
+}
+
+func TestWorklog_JSONDeserialization(t *testing.T) {
+	data := []byte(`{
+		"id": 10100,
+		"issueId": "10001",
+		"author": {"name": "jdoe", "displayName": "John Doe"},
+		"comment": "Code review",
+		"started": "2024-01-15T09:00:00.000+0000",
+		"timeSpent": "1h 30m",
+		"timeSpentSeconds": 5400
+	}`)
+
+	var worklog Worklog
+	if err := json.Unmarshal(data, &worklog); err != nil {
+		t.Fatalf("Failed to unmarshal Worklog: %v", err)
+	}
+	if worklog.ID != "10100" || worklog.Author.Name != "jdoe" || worklog.TimeSpentSeconds != 5400 {
+		t.Errorf("Unexpected worklog: %+v", worklog)
+	}
+}
+
+func TestNewWorklogReport(t *testing.T) {
+	jdoe := &User{Name: "jdoe", DisplayName: "John Doe"}
+	asmith := &User{Name: "asmith", DisplayName: "Alice Smith"}
+	issues := []IssueWorklogs{
+		{IssueKey: "CONS-1", Summary: "Workshop", Worklogs: []Worklog{
+			{Author: jdoe, Started: "2024-01-15T09:00:00.000+0000", TimeSpentSeconds: 7200},
+			{Author: asmith, Started: "2024-01-31T23:30:00.000-0500", TimeSpentSeconds: 3600},
+			{Author: jdoe, Started: "2023-12-31T09:00:00.000+0000", TimeSpentSeconds: 3600}, // Before the range
+		}},
+		{IssueKey: "CONS-2", Summary: "Report", Worklogs: []Worklog{
+			{Author: jdoe, Started: "2024-01-20T09:00:00.000+0000", TimeSpentSeconds: 1800},
+			{Author: asmith, Started: "2024-02-01T00:30:00.000+0100", TimeSpentSeconds: 3600}, // After the range
+		}},
+		{IssueKey: "CONS-3", Summary: "Nothing logged"},
+	}
+
+	report := NewWorklogReport(issues, "2024-01-01", "2024-01-31")
+
+	if report.TotalSeconds != 12600 || report.Total != "3h 30m" || report.IssueCount != 3 {
+		t.Errorf("Unexpected totals: %+v", report)
+	}
+
+	if len(report.ByIssue) != 2 || report.ByIssue[0].Key != "CONS-1" || report.ByIssue[0].Seconds != 10800 || report.ByIssue[1].Time != "30m" {
+		t.Errorf("Unexpected per-issue totals: %+v", report.ByIssue)
+	}
+
+	if len(report.ByUser) != 2 {
+		t.Fatalf("Expected 2 users, got %+v", report.ByUser)
+	}
+	user := report.ByUser[0]
+	if user.Key != "jdoe" || user.Name != "John Doe" || user.Seconds != 9000 || user.Time != "2h 30m" {
+		t.Errorf("Unexpected first user: %+v", user)
+	}
+	if len(user.Issues) != 2 || user.Issues[0].Key != "CONS-1" || user.Issues[1].Seconds != 1800 {
+		t.Errorf("Unexpected per-issue breakdown: %+v", user.Issues)
+	}
+	if report.ByUser[1].Key != "asmith" || report.ByUser[1].Seconds != 3600 {
+		t.Errorf("Unexpected second user: %+v", report.ByUser[1])
+	}
+}
+
+func TestNewWorklogReport_Empty(t *testing.T) {
+	report := NewWorklogReport(nil, "2024-01-01", "2024-01-31")
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		t.Fatalf("Failed to marshal WorklogReport: %v", err)
+	}
+	var decoded map[string]interface{}
+	json.Unmarshal(data, &decoded)
+	if decoded["byUser"] == nil || decoded["byIssue"] == nil || decoded["total"] != "0m" {
+		t.Errorf("Expected empty lists and zero total: %s", data)
+	}
+}
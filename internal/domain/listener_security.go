@@ -0,0 +1,493 @@
+package domain
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256" // register SHA-256 for JWT verification
+	_ "crypto/sha512" // register SHA-384/512 for JWT verification
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// certReloader serves the listener certificate and reloads it when the
+// certificate or key file changes on disk, so renewed certificates are
+// picked up without a restart.
+type certReloader struct {
+	certFile string
+	keyFile  string
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	certMod time.Time
+	keyMod  time.Time
+}
+
+// newCertReloader loads the initial certificate.
+// Returns an error if the certificate or key cannot be loaded.
+func newCertReloader(certFile, keyFile string) (*certReloader, error) {
+	r := &certReloader{certFile: certFile, keyFile: keyFile}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// reload reads the certificate and key files and records their modification times.
+func (r *certReloader) reload() error {
+	certInfo, err := os.Stat(r.certFile)
+	if err != nil {
+		return fmt.Errorf("failed to read TLS certificate: %w", err)
+	}
+	keyInfo, err := os.Stat(r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to read TLS key: %w", err)
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+
+	r.cert = &cert
+	r.certMod = certInfo.ModTime()
+	r.keyMod = keyInfo.ModTime()
+	return nil
+}
+
+// GetCertificate implements tls.Config.GetCertificate.
+// If either file changed since the last load, the pair is reloaded; when the
+// new pair is invalid (e.g. only one file has been replaced so far) the
+// previous certificate keeps being served.
+func (r *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	certInfo, certErr := os.Stat(r.certFile)
+	keyInfo, keyErr := os.Stat(r.keyFile)
+	if certErr == nil && keyErr == nil &&
+		(!certInfo.ModTime().Equal(r.certMod) || !keyInfo.ModTime().Equal(r.keyMod)) {
+		if err := r.reload(); err != nil {
+			fmt.Printf("[HTTP] Keeping previous TLS certificate: %v\n", err)
+		} else {
+			fmt.Printf("[HTTP] Reloaded TLS certificate from %s\n", r.certFile)
+		}
+	}
+
+	return r.cert, nil
+}
+
+// requestAuthenticator verifies the credentials presented by an MCP client.
+type requestAuthenticator interface {
+	authenticate(r *http.Request) error
+}
+
+// newRequestAuthenticator creates the authenticator for the listener auth configuration.
+func newRequestAuthenticator(cfg *ListenerAuthConfig) (requestAuthenticator, error) {
+	switch cfg.Type {
+	case ListenerAuthBearer:
+		token := cfg.Token
+		if cfg.TokenEnv != "" {
+			token = os.Getenv(cfg.TokenEnv)
+			if token == "" {
+				return nil, fmt.Errorf("environment variable %s is not set", cfg.TokenEnv)
+			}
+		}
+		if token == "" {
+			return nil, fmt.Errorf("bearer token is required")
+		}
+		return &bearerAuthenticator{token: token}, nil
+
+	case ListenerAuthJWT:
+		a := &jwtAuthenticator{
+			jwksFile: cfg.JWKSFile,
+			issuer:   cfg.Issuer,
+			audience: cfg.Audience,
+			now:      time.Now,
+		}
+		if err := a.loadKeys(); err != nil {
+			return nil, err
+		}
+		return a, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported HTTP auth type: %s", cfg.Type)
+	}
+}
+
+// bearerToken extracts the token from an "Authorization: Bearer" header.
+func bearerToken(r *http.Request) (string, error) {
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return "", fmt.Errorf("missing Authorization header")
+	}
+
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", fmt.Errorf("authorization header must use the Bearer scheme")
+	}
+
+	return strings.TrimSpace(token), nil
+}
+
+// bearerAuthenticator accepts requests carrying a static shared token.
+type bearerAuthenticator struct {
+	token string
+}
+
+// authenticate compares the presented token in constant time.
+func (a *bearerAuthenticator) authenticate(r *http.Request) error {
+	token, err := bearerToken(r)
+	if err != nil {
+		return err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) != 1 {
+		return fmt.Errorf("invalid bearer token")
+	}
+
+	return nil
+}
+
+// jwtAuthenticator accepts RS* and ES* signed JWTs whose keys are published in a
+// local JWKS file. The file is re-read when it changes to support key rotation.
+type jwtAuthenticator struct {
+	jwksFile string
+	issuer   string
+	audience string
+	now      func() time.Time
+
+	mu      sync.Mutex
+	keys    map[string]crypto.PublicKey
+	modTime time.Time
+}
+
+// jsonWebKey is a single entry of a JWKS document.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// loadKeys reads the JWKS file if it changed since the last load.
+func (a *jwtAuthenticator) loadKeys() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	info, err := os.Stat(a.jwksFile)
+	if err != nil {
+		return fmt.Errorf("failed to read JWKS file: %w", err)
+	}
+	if a.keys != nil && info.ModTime().Equal(a.modTime) {
+		return nil
+	}
+
+	data, err := os.ReadFile(a.jwksFile)
+	if err != nil {
+		return fmt.Errorf("failed to read JWKS file: %w", err)
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return fmt.Errorf("invalid JWKS file: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey)
+	for _, jwk := range jwks.Keys {
+		key, err := jwk.publicKey()
+		if err != nil {
+			return fmt.Errorf("invalid JWKS key '%s': %w", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("JWKS file contains no keys: %s", a.jwksFile)
+	}
+
+	a.keys = keys
+	a.modTime = info.ModTime()
+	return nil
+}
+
+// publicKey converts an RSA or EC JSON Web Key to a public key.
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil || !e.IsInt64() {
+			return nil, fmt.Errorf("invalid exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve: %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x coordinate: %w", err)
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y coordinate: %w", err)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type: %s", k.Kty)
+	}
+}
+
+// decodeBigInt decodes a base64url-encoded unsigned big-endian integer.
+func decodeBigInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty value")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+// jwtClaims holds the registered claims checked by the authenticator.
+type jwtClaims struct {
+	Issuer    string          `json:"iss"`
+	Audience  json.RawMessage `json:"aud"`
+	ExpiresAt *float64        `json:"exp"`
+	NotBefore *float64        `json:"nbf"`
+}
+
+// authenticate verifies the JWT signature, expiry, issuer and audience.
+func (a *jwtAuthenticator) authenticate(r *http.Request) error {
+	token, err := bearerToken(r)
+	if err != nil {
+		return err
+	}
+
+	// Pick up rotated keys
+	if err := a.loadKeys(); err != nil {
+		return err
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("malformed JWT")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeJWTSegment(parts[0], &header); err != nil {
+		return fmt.Errorf("invalid JWT header: %w", err)
+	}
+
+	key, err := a.key(header.Kid)
+	if err != nil {
+		return err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return fmt.Errorf("invalid JWT signature encoding")
+	}
+	if err := verifyJWTSignature(header.Alg, key, parts[0]+"."+parts[1], signature); err != nil {
+		return err
+	}
+
+	var claims jwtClaims
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return fmt.Errorf("invalid JWT claims: %w", err)
+	}
+
+	return a.validateClaims(&claims)
+}
+
+// key returns the verification key for a key ID. Tokens without a key ID are
+// accepted only when the JWKS contains a single key.
+func (a *jwtAuthenticator) key(kid string) (crypto.PublicKey, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if key, ok := a.keys[kid]; ok {
+		return key, nil
+	}
+	if kid == "" && len(a.keys) == 1 {
+		for _, key := range a.keys {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown JWT key ID: %s", kid)
+}
+
+// validateClaims checks the time-based claims and the configured issuer and audience.
+func (a *jwtAuthenticator) validateClaims(claims *jwtClaims) error {
+	now := float64(a.now().Unix())
+
+	if claims.ExpiresAt == nil {
+		return fmt.Errorf("JWT has no expiry")
+	}
+	if now >= *claims.ExpiresAt {
+		return fmt.Errorf("JWT has expired")
+	}
+	if claims.NotBefore != nil && now < *claims.NotBefore {
+		return fmt.Errorf("JWT is not valid yet")
+	}
+
+	if a.issuer != "" && claims.Issuer != a.issuer {
+		return fmt.Errorf("JWT issuer '%s' is not accepted", claims.Issuer)
+	}
+
+	if a.audience != "" {
+		var audiences []string
+		var single string
+		if err := json.Unmarshal(claims.Audience, &single); err == nil {
+			audiences = []string{single}
+		} else if err := json.Unmarshal(claims.Audience, &audiences); err != nil {
+			return fmt.Errorf("JWT audience is missing")
+		}
+
+		found := false
+		for _, aud := range audiences {
+			if aud == a.audience {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("JWT audience does not include '%s'", a.audience)
+		}
+	}
+
+	return nil
+}
+
+// decodeJWTSegment decodes a base64url JSON segment of a JWT.
+func decodeJWTSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// verifyJWTSignature checks an RS256/384/512 or ES256/384/512 signature.
+func verifyJWTSignature(alg string, key crypto.PublicKey, signed string, signature []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "RS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384", "ES384":
+		hash = crypto.SHA384
+	case "RS512", "ES512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported JWT algorithm: %s", alg)
+	}
+
+	h := hash.New()
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(alg, "RS") {
+			return fmt.Errorf("JWT algorithm %s does not match RSA key", alg)
+		}
+		if err := rsa.VerifyPKCS1v15(k, hash, digest, signature); err != nil {
+			return fmt.Errorf("invalid JWT signature")
+		}
+	case *ecdsa.PublicKey:
+		if !strings.HasPrefix(alg, "ES") {
+			return fmt.Errorf("JWT algorithm %s does not match EC key", alg)
+		}
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return fmt.Errorf("invalid JWT signature")
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(k, digest, r, s) {
+			return fmt.Errorf("invalid JWT signature")
+		}
+	default:
+		return fmt.Errorf("unsupported JWT key type")
+	}
+
+	return nil
+}
+
+// originPolicy decides which browser origins may call the HTTP transport.
+// Requests without an Origin header (non-browser MCP clients) are always allowed.
+type originPolicy struct {
+	allowAll bool
+	allowed  map[string]bool
+}
+
+// newOriginPolicy builds a policy from the configured allow-list.
+func newOriginPolicy(origins []string) *originPolicy {
+	p := &originPolicy{allowed: make(map[string]bool)}
+	for _, origin := range origins {
+		if origin == "*" {
+			p.allowAll = true
+			continue
+		}
+		p.allowed[strings.TrimSuffix(strings.ToLower(origin), "/")] = true
+	}
+	return p
+}
+
+// allows reports whether a request from the given Origin is permitted.
+// With an empty allow-list only loopback origins are accepted, which blocks
+// DNS rebinding attacks against a server bound to localhost.
+func (p *originPolicy) allows(origin string) bool {
+	if origin == "" || p.allowAll {
+		return true
+	}
+
+	if len(p.allowed) > 0 {
+		return p.allowed[strings.ToLower(origin)]
+	}
+
+	parsed, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	host := parsed.Hostname()
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
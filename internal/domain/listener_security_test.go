@@ -0,0 +1,466 @@
+package domain
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeServerCert writes a self-signed server certificate for localhost and
+// returns the parsed certificate.
+func writeServerCert(t *testing.T, certFile, keyFile, commonName string) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatalf("failed to write certificate: %v", err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+
+	cert, _ := x509.ParseCertificate(der)
+	return cert
+}
+
+// touchLater moves the modification time of files forward so reloads are detected
+// even on filesystems with coarse timestamps.
+func touchLater(t *testing.T, offset time.Duration, paths ...string) {
+	t.Helper()
+	when := time.Now().Add(offset)
+	for _, path := range paths {
+		if err := os.Chtimes(path, when, when); err != nil {
+			t.Fatalf("failed to update mtime: %v", err)
+		}
+	}
+}
+
+func TestCertReloader_ReloadsChangedCertificate(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "server.pem")
+	keyFile := filepath.Join(dir, "server-key.pem")
+	writeServerCert(t, certFile, keyFile, "first")
+
+	reloader, err := newCertReloader(certFile, keyFile)
+	if err != nil {
+		t.Fatalf("newCertReloader() error = %v", err)
+	}
+
+	cert, _ := reloader.GetCertificate(nil)
+	if cert.Leaf == nil || cert.Leaf.Subject.CommonName != "first" {
+		t.Fatalf("initial certificate = %v, want CN first", cert.Leaf)
+	}
+
+	writeServerCert(t, certFile, keyFile, "second")
+	touchLater(t, time.Second, certFile, keyFile)
+
+	cert, _ = reloader.GetCertificate(nil)
+	if cert.Leaf.Subject.CommonName != "second" {
+		t.Errorf("certificate after change = %s, want second", cert.Leaf.Subject.CommonName)
+	}
+
+	// A broken replacement keeps the last good certificate
+	if err := os.WriteFile(certFile, []byte("garbage"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	touchLater(t, 2*time.Second, certFile)
+
+	cert, _ = reloader.GetCertificate(nil)
+	if cert == nil || cert.Leaf.Subject.CommonName != "second" {
+		t.Errorf("certificate after invalid change = %v, want second", cert)
+	}
+}
+
+func TestNewHTTPTransportFromConfig_Errors(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := NewHTTPTransportFromConfig(HTTPConfig{Host: "localhost", Port: 8080,
+		TLS: &ListenerTLSConfig{CertFile: filepath.Join(dir, "missing.pem"), KeyFile: filepath.Join(dir, "missing-key.pem")}})
+	if err == nil || !strings.Contains(err.Error(), "failed to read TLS certificate") {
+		t.Errorf("missing certificate error = %v", err)
+	}
+
+	t.Setenv("TEST_MCP_LISTENER_TOKEN", "")
+	_, err = NewHTTPTransportFromConfig(HTTPConfig{Host: "localhost", Port: 8080,
+		Auth: &ListenerAuthConfig{Type: ListenerAuthBearer, TokenEnv: "TEST_MCP_LISTENER_TOKEN"}})
+	if err == nil || !strings.Contains(err.Error(), "TEST_MCP_LISTENER_TOKEN is not set") {
+		t.Errorf("missing token env error = %v", err)
+	}
+
+	_, err = NewHTTPTransportFromConfig(HTTPConfig{Host: "localhost", Port: 8080,
+		Auth: &ListenerAuthConfig{Type: ListenerAuthJWT, JWKSFile: filepath.Join(dir, "jwks.json")}})
+	if err == nil || !strings.Contains(err.Error(), "failed to read JWKS file") {
+		t.Errorf("missing JWKS error = %v", err)
+	}
+}
+
+func TestHTTPTransport_TLSListener(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "server.pem")
+	keyFile := filepath.Join(dir, "server-key.pem")
+	cert := writeServerCert(t, certFile, keyFile, "mcp")
+
+	transport, err := NewHTTPTransportFromConfig(HTTPConfig{Host: "localhost", Port: 8790,
+		TLS: &ListenerTLSConfig{CertFile: certFile, KeyFile: keyFile}})
+	if err != nil {
+		t.Fatalf("NewHTTPTransportFromConfig() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := transport.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer transport.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: roots}}}
+
+	resp, err := client.Get("https://localhost:8790/mcp/message")
+	if err != nil {
+		t.Fatalf("HTTPS request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("status = %d, want 405 from the message endpoint", resp.StatusCode)
+	}
+
+	// Plain HTTP never reaches the endpoint
+	if resp, err := http.Get("http://localhost:8790/mcp/message"); err == nil {
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("plain HTTP status = %d, want 400 from the TLS listener", resp.StatusCode)
+		}
+	}
+}
+
+func TestHTTPTransport_BearerAuth(t *testing.T) {
+	transport, err := NewHTTPTransportFromConfig(HTTPConfig{Host: "localhost", Port: 8080,
+		Auth: &ListenerAuthConfig{Type: ListenerAuthBearer, Token: "s3cret"}})
+	if err != nil {
+		t.Fatalf("NewHTTPTransportFromConfig() error = %v", err)
+	}
+	server := httptest.NewServer(transport.handler())
+	defer server.Close()
+
+	tests := []struct {
+		name   string
+		header string
+		want   int
+	}{
+		{"missing header", "", http.StatusUnauthorized},
+		{"wrong scheme", "Basic czNjcmV0", http.StatusUnauthorized},
+		{"wrong token", "Bearer nope", http.StatusUnauthorized},
+		{"valid token", "Bearer s3cret", http.StatusBadRequest}, // reaches the handler: missing sessionId
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, server.URL+"/mcp/message", strings.NewReader("{}"))
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.want {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.want)
+			}
+			if tt.want == http.StatusUnauthorized && resp.Header.Get("WWW-Authenticate") == "" {
+				t.Error("401 response should carry WWW-Authenticate")
+			}
+		})
+	}
+}
+
+func TestHTTPTransport_BearerTokenFromEnv(t *testing.T) {
+	t.Setenv("TEST_MCP_LISTENER_TOKEN", "from-env")
+	transport, err := NewHTTPTransportFromConfig(HTTPConfig{Host: "localhost", Port: 8080,
+		Auth: &ListenerAuthConfig{Type: ListenerAuthBearer, TokenEnv: "TEST_MCP_LISTENER_TOKEN"}})
+	if err != nil {
+		t.Fatalf("NewHTTPTransportFromConfig() error = %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/mcp/message", nil)
+	req.Header.Set("Authorization", "Bearer from-env")
+	if err := transport.authenticator.authenticate(req); err != nil {
+		t.Errorf("authenticate() error = %v", err)
+	}
+}
+
+// signJWT creates a compact JWT signed with an RSA or EC private key.
+func signJWT(t *testing.T, alg, kid string, key crypto.Signer, claims map[string]interface{}) string {
+	t.Helper()
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+
+	var signature []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		sig, err := rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+		if err != nil {
+			t.Fatalf("failed to sign: %v", err)
+		}
+		signature = sig
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest[:])
+		if err != nil {
+			t.Fatalf("failed to sign: %v", err)
+		}
+		signature = make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+	}
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// writeJWKS writes the public keys to a JWKS file.
+func writeJWKS(t *testing.T, path string, rsaKey *rsa.PrivateKey, ecKey *ecdsa.PrivateKey) {
+	t.Helper()
+	enc := base64.RawURLEncoding.EncodeToString
+	ecX := make([]byte, 32)
+	ecY := make([]byte, 32)
+	ecKey.PublicKey.X.FillBytes(ecX)
+	ecKey.PublicKey.Y.FillBytes(ecY)
+
+	jwks := map[string]interface{}{
+		"keys": []map[string]string{
+			{"kty": "RSA", "kid": "rsa-1", "n": enc(rsaKey.N.Bytes()), "e": enc(big.NewInt(int64(rsaKey.E)).Bytes())},
+			{"kty": "EC", "kid": "ec-1", "crv": "P-256", "x": enc(ecX), "y": enc(ecY)},
+		},
+	}
+	data, _ := json.Marshal(jwks)
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatalf("failed to write JWKS: %v", err)
+	}
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, jwksFile, rsaKey, ecKey)
+
+	auth, err := newRequestAuthenticator(&ListenerAuthConfig{
+		Type: ListenerAuthJWT, JWKSFile: jwksFile, Issuer: "https://idp.example.com", Audience: "atlassian-mcp",
+	})
+	if err != nil {
+		t.Fatalf("newRequestAuthenticator() error = %v", err)
+	}
+
+	now := time.Now().Unix()
+	valid := map[string]interface{}{"iss": "https://idp.example.com", "aud": "atlassian-mcp", "exp": now + 300}
+
+	with := func(changes map[string]interface{}) map[string]interface{} {
+		claims := make(map[string]interface{})
+		for k, v := range valid {
+			claims[k] = v
+		}
+		for k, v := range changes {
+			if v == nil {
+				delete(claims, k)
+			} else {
+				claims[k] = v
+			}
+		}
+		return claims
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr string
+	}{
+		{"RS256", signJWT(t, "RS256", "rsa-1", rsaKey, valid), ""},
+		{"ES256", signJWT(t, "ES256", "ec-1", ecKey, valid), ""},
+		{"audience list", signJWT(t, "RS256", "rsa-1", rsaKey, with(map[string]interface{}{"aud": []string{"other", "atlassian-mcp"}})), ""},
+		{"expired", signJWT(t, "RS256", "rsa-1", rsaKey, with(map[string]interface{}{"exp": now - 10})), "expired"},
+		{"no expiry", signJWT(t, "RS256", "rsa-1", rsaKey, with(map[string]interface{}{"exp": nil})), "no expiry"},
+		{"not yet valid", signJWT(t, "RS256", "rsa-1", rsaKey, with(map[string]interface{}{"nbf": now + 600})), "not valid yet"},
+		{"wrong issuer", signJWT(t, "RS256", "rsa-1", rsaKey, with(map[string]interface{}{"iss": "https://evil.example.com"})), "issuer"},
+		{"wrong audience", signJWT(t, "RS256", "rsa-1", rsaKey, with(map[string]interface{}{"aud": "other"})), "audience"},
+		{"unknown key", signJWT(t, "RS256", "rsa-2", rsaKey, valid), "unknown JWT key ID"},
+		{"bad signature", signJWT(t, "RS256", "rsa-1", otherKey, valid), "invalid JWT signature"},
+		{"algorithm mismatch", signJWT(t, "ES256", "rsa-1", ecKey, valid), "does not match RSA key"},
+		{"malformed", "not-a-jwt", "malformed JWT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/mcp", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			err := auth.authenticate(req)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("authenticate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("authenticate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJWTAuthenticator_ReloadsRotatedKeys(t *testing.T) {
+	oldKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	newKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, jwksFile, oldKey, ecKey)
+
+	auth, err := newRequestAuthenticator(&ListenerAuthConfig{Type: ListenerAuthJWT, JWKSFile: jwksFile})
+	if err != nil {
+		t.Fatalf("newRequestAuthenticator() error = %v", err)
+	}
+
+	token := signJWT(t, "RS256", "rsa-1", newKey, map[string]interface{}{"exp": time.Now().Unix() + 300})
+	req := httptest.NewRequest(http.MethodGet, "/mcp", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	if err := auth.authenticate(req); err == nil {
+		t.Fatal("token signed with the new key should fail before rotation")
+	}
+
+	writeJWKS(t, jwksFile, newKey, ecKey)
+	touchLater(t, time.Second, jwksFile)
+
+	if err := auth.authenticate(req); err != nil {
+		t.Errorf("authenticate() after rotation error = %v", err)
+	}
+}
+
+func TestHTTPTransport_OriginValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		allowed    []string
+		origin     string
+		wantStatus int
+	}{
+		{"no origin", nil, "", http.StatusBadRequest},
+		{"localhost by default", nil, "http://localhost:6274", http.StatusBadRequest},
+		{"loopback IP by default", nil, "http://127.0.0.1:3000", http.StatusBadRequest},
+		{"remote origin by default", nil, "http://attacker.example.com", http.StatusForbidden},
+		{"allow-listed origin", []string{"https://app.example.com"}, "https://app.example.com", http.StatusBadRequest},
+		{"localhost not in allow-list", []string{"https://app.example.com"}, "http://localhost:3000", http.StatusForbidden},
+		{"wildcard", []string{"*"}, "https://anything.example.com", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport, err := NewHTTPTransportFromConfig(HTTPConfig{Host: "localhost", Port: 8080, AllowedOrigins: tt.allowed})
+			if err != nil {
+				t.Fatalf("NewHTTPTransportFromConfig() error = %v", err)
+			}
+			server := httptest.NewServer(transport.handler())
+			defer server.Close()
+
+			req, _ := http.NewRequest(http.MethodPost, server.URL+"/mcp/message", strings.NewReader("{}"))
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			allowOrigin := resp.Header.Get("Access-Control-Allow-Origin")
+			if tt.wantStatus != http.StatusForbidden && tt.origin != "" && allowOrigin != tt.origin {
+				t.Errorf("Access-Control-Allow-Origin = %q, want %q", allowOrigin, tt.origin)
+			}
+			if allowOrigin == "*" {
+				t.Error("Access-Control-Allow-Origin must not be a wildcard")
+			}
+		})
+	}
+}
+
+func TestHTTPTransport_CORSPreflightSkipsAuth(t *testing.T) {
+	transport, err := NewHTTPTransportFromConfig(HTTPConfig{Host: "localhost", Port: 8080,
+		AllowedOrigins: []string{"https://app.example.com"},
+		Auth:           &ListenerAuthConfig{Type: ListenerAuthBearer, Token: "s3cret"}})
+	if err != nil {
+		t.Fatalf("NewHTTPTransportFromConfig() error = %v", err)
+	}
+	server := httptest.NewServer(transport.handler())
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodOptions, server.URL+"/mcp/message", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("status = %d, want 204", resp.StatusCode)
+	}
+	if !strings.Contains(resp.Header.Get("Access-Control-Allow-Headers"), "Authorization") {
+		t.Errorf("Access-Control-Allow-Headers = %q, want Authorization", resp.Header.Get("Access-Control-Allow-Headers"))
+	}
+}
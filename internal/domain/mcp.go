@@ -31,10 +31,12 @@ type ContentBlock struct {
 }
 
 // Resource represents a resource reference in MCP.
+// Text resources carry their content in Text; binary resources carry base64 data in Blob.
 type Resource struct {
 	URI      string `json:"uri"`
 	MimeType string `json:"mimeType,omitempty"`
 	Text     string `json:"text,omitempty"`
+	Blob     string `json:"blob,omitempty"`
 }
 
 // JSONSchema represents a JSON Schema for tool input validation.
@@ -646,3 +646,24 @@ func TestOmitEmptyBehaviorMCP(t *testing.T) {
 		t.Error("JSONSchema should omit 'required' when nil")
 	}
 }
+
+func TestResource_BlobSerialization(t *testing.T) {
+	block := ContentBlock{
+		Type: "resource",
+		Resource: &Resource{
+			URI:      "https://jira.example.com/secure/attachment/1/image.png",
+			MimeType: "image/png",
+			Blob:     "iVBORw0KGgo=",
+		},
+	}
+
+	data, err := json.Marshal(block)
+	if err != nil {
+		t.Fatalf("Failed to marshal ContentBlock: %v", err)
+	}
+
+	expected := `{"type":"resource","resource":{"uri":"https://jira.example.com/secure/attachment/1/image.png","mimeType":"image/png","blob":"iVBORw0KGgo="}}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+}
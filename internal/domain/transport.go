@@ -3,6 +3,7 @@ package domain
 import (
 	"bufio"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -226,6 +227,7 @@ func (t *StdioTransport) sendInvalidRequest(id interface{}, reason string) {
 // It exposes two endpoints:
 // 1. SSE endpoint (GET) for server-to-client messages
 // 2. HTTP POST endpoint for client-to-server messages
+// Both endpoints enforce the Origin policy and, when configured, client authentication.
 type HTTPTransport struct {
 	host    string
 	port    int
@@ -233,6 +235,11 @@ type HTTPTransport struct {
 	reqChan chan *Request
 	mu      sync.Mutex
 	closed  bool
+	// Listener security
+	certs         *certReloader
+	minTLSVersion uint16
+	authenticator requestAuthenticator
+	origins       *originPolicy
 	// Session management for SSE connections
 	sessions   map[string]*sseSession
 	sessionsMu sync.RWMutex
@@ -248,15 +255,48 @@ type sseSession struct {
 }
 
 // NewHTTPTransport creates a new HTTPTransport instance.
+// The transport serves plain HTTP without client authentication and only
+// accepts browser requests from localhost origins.
 func NewHTTPTransport(host string, port int) *HTTPTransport {
 	return &HTTPTransport{
 		host:     host,
 		port:     port,
 		reqChan:  make(chan *Request, 10),
 		sessions: make(map[string]*sseSession),
+		origins:  newOriginPolicy(nil),
 	}
 }
 
+// NewHTTPTransportFromConfig creates an HTTPTransport with the TLS, authentication
+// and origin settings from the configuration.
+// Returns an error if the certificate, token or JWKS file cannot be loaded.
+func NewHTTPTransportFromConfig(cfg HTTPConfig) (*HTTPTransport, error) {
+	t := NewHTTPTransport(cfg.Host, cfg.Port)
+	t.origins = newOriginPolicy(cfg.AllowedOrigins)
+
+	if cfg.TLS != nil {
+		certs, err := newCertReloader(cfg.TLS.CertFile, cfg.TLS.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		t.certs = certs
+		t.minTLSVersion = tls.VersionTLS12
+		if cfg.TLS.MinVersion == "1.3" {
+			t.minTLSVersion = tls.VersionTLS13
+		}
+	}
+
+	if cfg.Auth != nil {
+		authenticator, err := newRequestAuthenticator(cfg.Auth)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure HTTP authentication: %w", err)
+		}
+		t.authenticator = authenticator
+	}
+
+	return t, nil
+}
+
 // Start begins the HTTP server and starts listening for incoming requests.
 func (t *HTTPTransport) Start(ctx context.Context) error {
 	t.mu.Lock()
@@ -266,20 +306,29 @@ func (t *HTTPTransport) Start(ctx context.Context) error {
 	}
 	t.mu.Unlock()
 
-	// Create HTTP server with handlers
-	mux := http.NewServeMux()
-	mux.HandleFunc("/mcp", t.handleSSE)             // SSE endpoint for server-to-client
-	mux.HandleFunc("/mcp/message", t.handleMessage) // POST endpoint for client-to-server
-
 	addr := fmt.Sprintf("%s:%d", t.host, t.port)
 	t.server = &http.Server{
 		Addr:    addr,
-		Handler: mux,
+		Handler: t.handler(),
+	}
+
+	// Serve the reloadable certificate when TLS is configured
+	if t.certs != nil {
+		t.server.TLSConfig = &tls.Config{
+			MinVersion:     t.minTLSVersion,
+			GetCertificate: t.certs.GetCertificate,
+		}
 	}
 
 	// Start server in a goroutine
 	go func() {
-		if err := t.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if t.certs != nil {
+			err = t.server.ListenAndServeTLS("", "")
+		} else {
+			err = t.server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			// Log error but don't fail - server might be stopped gracefully
 		}
 	}()
@@ -293,6 +342,52 @@ func (t *HTTPTransport) Start(ctx context.Context) error {
 	return nil
 }
 
+// handler returns the HTTP handler with the MCP endpoints behind the security checks.
+func (t *HTTPTransport) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mcp", t.secure(t.handleSSE))             // SSE endpoint for server-to-client
+	mux.HandleFunc("/mcp/message", t.secure(t.handleMessage)) // POST endpoint for client-to-server
+	return mux
+}
+
+// secure wraps an endpoint with Origin validation, CORS headers and client authentication.
+// CORS preflight requests are answered without authentication, as browsers send them
+// without credentials.
+func (t *HTTPTransport) secure(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if !t.origins.allows(origin) {
+			fmt.Printf("[HTTP] Rejected request from origin %s\n", origin)
+			http.Error(w, "Origin not allowed", http.StatusForbidden)
+			return
+		}
+
+		if origin != "" {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Add("Vary", "Origin")
+		}
+
+		if r.Method == http.MethodOptions {
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+			w.Header().Set("Access-Control-Max-Age", "600")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		if t.authenticator != nil {
+			if err := t.authenticator.authenticate(r); err != nil {
+				fmt.Printf("[HTTP] Authentication failed for %s: %v\n", r.RemoteAddr, err)
+				w.Header().Set("WWW-Authenticate", `Bearer realm="atlassian-mcp-server"`)
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+		}
+
+		next(w, r)
+	}
+}
+
 // handleSSE handles SSE connections (GET requests) for server-to-client messages.
 func (t *HTTPTransport) handleSSE(w http.ResponseWriter, r *http.Request) {
 	fmt.Printf("[HTTP] %s %s from %s\n", r.Method, r.URL.Path, r.RemoteAddr)
@@ -307,7 +402,6 @@ func (t *HTTPTransport) handleSSE(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "text/event-stream")
 	w.Header().Set("Cache-Control", "no-cache")
 	w.Header().Set("Connection", "keep-alive")
-	w.Header().Set("Access-Control-Allow-Origin", "*")
 
 	// Get flusher for streaming
 	flusher, ok := w.(http.Flusher)
@@ -357,3 +357,53 @@ func (c *BambooClient) GetDeploymentResult(deploymentResultID int) (*domain.Depl
 
 	return &result, nil
 }
+
+// bambooCurrentUser represents the response from the current user API.
+type bambooCurrentUser struct {
+	Name     string `json:"name"`
+	FullName string `json:"fullName"`
+	Email    string `json:"email"`
+}
+
+// WhoAmI resolves the identity of the authenticated user.
+func (c *BambooClient) WhoAmI() (*domain.Identity, error) {
+	// Construct the API endpoint
+	// Bamboo REST API: /rest/api/latest/currentUser
+	endpoint := fmt.Sprintf("%s/rest/api/latest/currentUser", c.baseURL)
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var user bambooCurrentUser
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if user.Name == "" {
+		return nil, fmt.Errorf("API error (status %d): %s", http.StatusUnauthorized, "request was treated as anonymous")
+	}
+
+	return &domain.Identity{
+		Tool:        "bamboo",
+		Username:    user.Name,
+		DisplayName: user.FullName,
+		Email:       user.Email,
+	}, nil
+}
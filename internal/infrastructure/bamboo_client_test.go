@@ -584,3 +584,30 @@ func TestBambooClient_Do(t *testing.T) {
 		t.Error("Expected Accept header to be set")
 	}
 }
+
+func TestBambooClient_WhoAmI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/latest/currentUser" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("Authorization") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"name":"ci-bot","fullName":"CI Bot","email":"ci@example.com"}`))
+	}))
+	defer server.Close()
+
+	identity, err := NewBambooClient(server.URL, getAuthenticatedClient()).WhoAmI()
+	if err != nil {
+		t.Fatalf("WhoAmI() error = %v", err)
+	}
+	if identity.Tool != "bamboo" || identity.Username != "ci-bot" || identity.DisplayName != "CI Bot" || identity.Email != "ci@example.com" {
+		t.Errorf("unexpected identity: %+v", identity)
+	}
+
+	if _, err := NewBambooClient(server.URL, http.DefaultClient).WhoAmI(); err == nil || !contains(err.Error(), "status 401") {
+		t.Errorf("WhoAmI() without credentials error = %v, want status 401", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"atlassian-mcp-server/internal/domain"
 )
@@ -434,3 +435,90 @@ func (c *BitbucketClient) GetFileContent(project, repo, path, ref string) (strin
 
 	return content, nil
 }
+
+// bitbucketUsersResponse represents the paginated response from the users API.
+type bitbucketUsersResponse struct {
+	Values []struct {
+		Name         string `json:"name"`
+		Slug         string `json:"slug"`
+		DisplayName  string `json:"displayName"`
+		EmailAddress string `json:"emailAddress"`
+	} `json:"values"`
+}
+
+// WhoAmI resolves the identity of the authenticated user.
+// Bitbucket has no current-user REST resource, so the username is read from
+// the application links whoami servlet and the profile from the users API.
+func (c *BitbucketClient) WhoAmI() (*domain.Identity, error) {
+	// Construct the whoami endpoint
+	endpoint := fmt.Sprintf("%s/plugins/servlet/applinks/whoami", c.baseURL)
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// The servlet returns the plain username, or nothing for anonymous requests
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	username := strings.TrimSpace(string(body))
+	if username == "" {
+		return nil, fmt.Errorf("API error (status %d): %s", http.StatusUnauthorized, "request was treated as anonymous")
+	}
+
+	identity := &domain.Identity{
+		Tool:     "bitbucket",
+		Username: username,
+	}
+
+	// Look up the profile; failure here does not invalidate the credentials
+	params := url.Values{}
+	params.Set("filter", username)
+	usersEndpoint := fmt.Sprintf("%s/rest/api/1.0/users?%s", c.baseURL, params.Encode())
+
+	usersReq, err := http.NewRequest("GET", usersEndpoint, nil)
+	if err != nil {
+		return identity, nil
+	}
+
+	usersResp, err := c.Do(usersReq)
+	if err != nil {
+		return identity, nil
+	}
+	defer usersResp.Body.Close()
+
+	if usersResp.StatusCode != http.StatusOK {
+		return identity, nil
+	}
+
+	var users bitbucketUsersResponse
+	if err := json.NewDecoder(usersResp.Body).Decode(&users); err != nil {
+		return identity, nil
+	}
+
+	for _, user := range users.Values {
+		if user.Name == username {
+			identity.DisplayName = user.DisplayName
+			identity.Email = user.EmailAddress
+			break
+		}
+	}
+
+	return identity, nil
+}
@@ -475,3 +475,37 @@ func TestBitbucketClientAuthenticationHeaderInclusion(t *testing.T) {
 		t.Error("Expected Authorization header to be included in request")
 	}
 }
+
+func TestBitbucketClient_WhoAmI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/plugins/servlet/applinks/whoami":
+			if r.Header.Get("Authorization") != "" {
+				w.Write([]byte("jdoe\n"))
+			}
+		case "/rest/api/1.0/users":
+			if r.URL.Query().Get("filter") != "jdoe" {
+				t.Errorf("unexpected users filter: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"values":[
+				{"name":"jdoe2","slug":"jdoe2","displayName":"Other"},
+				{"name":"jdoe","slug":"jdoe","displayName":"Jane Doe","emailAddress":"jdoe@example.com"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	identity, err := NewBitbucketClient(server.URL, getAuthenticatedClient()).WhoAmI()
+	if err != nil {
+		t.Fatalf("WhoAmI() error = %v", err)
+	}
+	if identity.Tool != "bitbucket" || identity.Username != "jdoe" || identity.DisplayName != "Jane Doe" || identity.Email != "jdoe@example.com" {
+		t.Errorf("unexpected identity: %+v", identity)
+	}
+
+	// The whoami servlet returns an empty body for anonymous requests
+	if _, err := NewBitbucketClient(server.URL, http.DefaultClient).WhoAmI(); err == nil || !contains(err.Error(), "anonymous") {
+		t.Errorf("WhoAmI() for anonymous user error = %v, want anonymous error", err)
+	}
+}
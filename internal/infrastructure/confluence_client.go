@@ -343,3 +343,54 @@ func (c *ConfluenceClient) GetPageHistory(pageID string) (*domain.PageHistory, e
 
 	return &history, nil
 }
+
+// confluenceCurrentUser represents the response from the current user API.
+type confluenceCurrentUser struct {
+	Type        string `json:"type"` // "known" or "anonymous"
+	Username    string `json:"username"`
+	UserKey     string `json:"userKey"`
+	DisplayName string `json:"displayName"`
+}
+
+// WhoAmI resolves the identity of the authenticated user.
+// Returns an error if Confluence treats the request as anonymous.
+func (c *ConfluenceClient) WhoAmI() (*domain.Identity, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/user/current", c.baseURL)
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var user confluenceCurrentUser
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	// Confluence answers unauthenticated requests with an anonymous user
+	if user.Type == "anonymous" || user.Username == "" {
+		return nil, fmt.Errorf("API error (status %d): %s", http.StatusUnauthorized, "request was treated as anonymous")
+	}
+
+	return &domain.Identity{
+		Tool:        "confluence",
+		Username:    user.Username,
+		DisplayName: user.DisplayName,
+	}, nil
+}
@@ -955,3 +955,31 @@ func TestConfluenceClient_ContentTypeHeaders(t *testing.T) {
 		})
 	}
 }
+
+func TestConfluenceClient_WhoAmI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/user/current" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("Authorization") == "" {
+			w.Write([]byte(`{"type":"anonymous","displayName":"Anonymous"}`))
+			return
+		}
+		w.Write([]byte(`{"type":"known","username":"jdoe","userKey":"abc","displayName":"Jane Doe"}`))
+	}))
+	defer server.Close()
+
+	identity, err := NewConfluenceClient(server.URL, getAuthenticatedClient()).WhoAmI()
+	if err != nil {
+		t.Fatalf("WhoAmI() error = %v", err)
+	}
+	if identity.Tool != "confluence" || identity.Username != "jdoe" || identity.DisplayName != "Jane Doe" {
+		t.Errorf("unexpected identity: %+v", identity)
+	}
+
+	// Confluence answers with an anonymous user instead of 401
+	if _, err := NewConfluenceClient(server.URL, http.DefaultClient).WhoAmI(); err == nil || !contains(err.Error(), "anonymous") {
+		t.Errorf("WhoAmI() for anonymous user error = %v, want anonymous error", err)
+	}
+}
@@ -0,0 +1,401 @@
+package infrastructure
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// NewCredentialStore creates the credential store selected by the configuration.
+// Returns an error if the backend is unknown or cannot be initialized.
+func NewCredentialStore(config domain.CredentialStoreConfig) (domain.CredentialStore, error) {
+	switch config.Backend {
+	case "file":
+		path := config.Path
+		if path == "" {
+			path = domain.DefaultCredentialsFile
+		}
+		envName := config.PassphraseEnv
+		if envName == "" {
+			envName = domain.DefaultCredentialsPassphraseEnv
+		}
+		passphrase := os.Getenv(envName)
+		if passphrase == "" {
+			return nil, fmt.Errorf("credential store passphrase not set: environment variable %s is empty", envName)
+		}
+		return NewFileCredentialStore(path, passphrase), nil
+	case "keyring":
+		service := config.Service
+		if service == "" {
+			service = domain.DefaultCredentialsService
+		}
+		return NewKeyringCredentialStore(service), nil
+	case "":
+		return nil, fmt.Errorf("no credentials backend configured")
+	default:
+		return nil, fmt.Errorf("unsupported credentials backend: %s", config.Backend)
+	}
+}
+
+// Parameters for the encrypted credentials file format.
+const (
+	credentialFileVersion    = 1
+	credentialFileKDF        = "pbkdf2-sha256"
+	credentialFileIterations = 600000
+	credentialFileSaltSize   = 16
+	credentialFileKeySize    = 32 // AES-256
+)
+
+// credentialFile is the on-disk envelope of the encrypted credentials file.
+// The plaintext is a JSON object mapping tool names to stored credentials.
+type credentialFile struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Iterations int    `json:"iterations"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// FileCredentialStore keeps credentials in a local file encrypted with AES-256-GCM.
+// The key is derived from a passphrase with PBKDF2-SHA256 and a random salt
+// that is regenerated on every write.
+type FileCredentialStore struct {
+	path       string
+	passphrase string
+	iterations int
+	mu         sync.Mutex
+}
+
+// NewFileCredentialStore creates a store backed by the encrypted file at path.
+// The file does not need to exist until the first Set.
+func NewFileCredentialStore(path, passphrase string) *FileCredentialStore {
+	return &FileCredentialStore{
+		path:       path,
+		passphrase: passphrase,
+		iterations: credentialFileIterations,
+	}
+}
+
+// Get returns the stored credentials for a tool.
+func (s *FileCredentialStore) Get(tool string) (*domain.Credentials, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	raw, ok := entries[tool]
+	if !ok {
+		return nil, domain.ErrCredentialNotFound
+	}
+
+	return domain.UnmarshalCredentials(raw)
+}
+
+// Set stores credentials for a tool, replacing any existing entry.
+func (s *FileCredentialStore) Set(tool string, creds *domain.Credentials) error {
+	data, err := domain.MarshalCredentials(creds)
+	if err != nil {
+		return fmt.Errorf("invalid credentials: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	entries[tool] = data
+	return s.save(entries)
+}
+
+// Remove deletes the credentials stored for a tool.
+func (s *FileCredentialStore) Remove(tool string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := entries[tool]; !ok {
+		return domain.ErrCredentialNotFound
+	}
+
+	delete(entries, tool)
+	return s.save(entries)
+}
+
+// List returns the names of all tools with stored credentials.
+func (s *FileCredentialStore) List() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	tools := make([]string, 0, len(entries))
+	for tool := range entries {
+		tools = append(tools, tool)
+	}
+	sort.Strings(tools)
+
+	return tools, nil
+}
+
+// load reads and decrypts the credentials file.
+// A missing file is treated as an empty store.
+func (s *FileCredentialStore) load() (map[string]json.RawMessage, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return make(map[string]json.RawMessage), nil
+		}
+		return nil, fmt.Errorf("failed to read credentials file: %w", err)
+	}
+
+	var envelope credentialFile
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("invalid credentials file format: %w", err)
+	}
+
+	if envelope.Version != credentialFileVersion || envelope.KDF != credentialFileKDF {
+		return nil, fmt.Errorf("unsupported credentials file version %d (%s)", envelope.Version, envelope.KDF)
+	}
+
+	gcm, err := s.aead(envelope.Salt, envelope.Iterations)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := gcm.Open(nil, envelope.Nonce, envelope.Ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt credentials file: wrong passphrase or corrupted file")
+	}
+
+	entries := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(plaintext, &entries); err != nil {
+		return nil, fmt.Errorf("invalid credentials file contents: %w", err)
+	}
+
+	return entries, nil
+}
+
+// save encrypts the entries and atomically replaces the credentials file.
+func (s *FileCredentialStore) save(entries map[string]json.RawMessage) error {
+	plaintext, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("failed to marshal credentials: %w", err)
+	}
+
+	salt := make([]byte, credentialFileSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	gcm, err := s.aead(salt, s.iterations)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	data, err := json.MarshalIndent(credentialFile{
+		Version:    credentialFileVersion,
+		KDF:        credentialFileKDF,
+		Iterations: s.iterations,
+		Salt:       salt,
+		Nonce:      nonce,
+		Ciphertext: gcm.Seal(nil, nonce, plaintext, nil),
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal credentials file: %w", err)
+	}
+
+	// Write to a temporary file in the same directory, then rename over the original
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), ".credentials-*")
+	if err != nil {
+		return fmt.Errorf("failed to create credentials file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to set credentials file permissions: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write credentials file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write credentials file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to replace credentials file: %w", err)
+	}
+
+	return nil
+}
+
+// aead derives the file key from the passphrase and returns an AES-GCM AEAD.
+func (s *FileCredentialStore) aead(salt []byte, iterations int) (cipher.AEAD, error) {
+	if iterations <= 0 {
+		return nil, fmt.Errorf("invalid key derivation iterations: %d", iterations)
+	}
+
+	key, err := pbkdf2.Key(sha256.New, s.passphrase, salt, iterations, credentialFileKeySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// KeyringCredentialStore keeps credentials in the desktop keyring through the
+// freedesktop.org Secret Service D-Bus API, which needs a Secret Service provider
+// (GNOME Keyring, KWallet, KeePassXC, ...) on the session bus.
+// Each tool is one keyring item with attributes service=<service> and tool=<name>.
+type KeyringCredentialStore struct {
+	service string
+	connect func() (secretService, error)
+}
+
+// NewKeyringCredentialStore creates a store that keeps items under the given service attribute.
+func NewKeyringCredentialStore(service string) *KeyringCredentialStore {
+	return &KeyringCredentialStore{
+		service: service,
+		connect: connectSecretService,
+	}
+}
+
+// attributes returns the keyring item attributes identifying a tool's credentials.
+func (s *KeyringCredentialStore) attributes(tool string) map[string]string {
+	return map[string]string{"service": s.service, "tool": tool}
+}
+
+// Get returns the stored credentials for a tool.
+func (s *KeyringCredentialStore) Get(tool string) (*domain.Credentials, error) {
+	secrets, err := s.connect()
+	if err != nil {
+		return nil, err
+	}
+	defer secrets.close()
+
+	items, err := secrets.search(s.attributes(tool))
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, domain.ErrCredentialNotFound
+	}
+
+	data, err := secrets.secret(items[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.UnmarshalCredentials(bytes.TrimSpace(data))
+}
+
+// Set stores credentials for a tool, replacing any existing entry.
+func (s *KeyringCredentialStore) Set(tool string, creds *domain.Credentials) error {
+	data, err := domain.MarshalCredentials(creds)
+	if err != nil {
+		return fmt.Errorf("invalid credentials: %w", err)
+	}
+
+	secrets, err := s.connect()
+	if err != nil {
+		return err
+	}
+	defer secrets.close()
+
+	label := fmt.Sprintf("%s credentials for %s", s.service, tool)
+	return secrets.store(label, s.attributes(tool), data)
+}
+
+// Remove deletes the credentials stored for a tool.
+func (s *KeyringCredentialStore) Remove(tool string) error {
+	secrets, err := s.connect()
+	if err != nil {
+		return err
+	}
+	defer secrets.close()
+
+	items, err := secrets.search(s.attributes(tool))
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return domain.ErrCredentialNotFound
+	}
+
+	for _, item := range items {
+		if err := secrets.delete(item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// List returns the names of all tools with stored credentials.
+func (s *KeyringCredentialStore) List() ([]string, error) {
+	secrets, err := s.connect()
+	if err != nil {
+		return nil, err
+	}
+	defer secrets.close()
+
+	items, err := secrets.search(map[string]string{"service": s.service})
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	for _, item := range items {
+		attrs, err := secrets.attributes(item)
+		if err != nil {
+			return nil, err
+		}
+		if tool := attrs["tool"]; tool != "" {
+			seen[tool] = true
+		}
+	}
+
+	tools := make([]string, 0, len(seen))
+	for tool := range seen {
+		tools = append(tools, tool)
+	}
+	sort.Strings(tools)
+
+	return tools, nil
+}
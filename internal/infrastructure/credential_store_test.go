@@ -0,0 +1,307 @@
+package infrastructure
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+
+	"atlassian-mcp-server/internal/domain"
+)
+
+// newTestFileStore creates a file store with a cheap key derivation for fast tests.
+func newTestFileStore(t *testing.T, passphrase string) *FileCredentialStore {
+	t.Helper()
+	store := NewFileCredentialStore(filepath.Join(t.TempDir(), "credentials.enc"), passphrase)
+	store.iterations = 1000
+	return store
+}
+
+func TestFileCredentialStore_SetGetListRemove(t *testing.T) {
+	store := newTestFileStore(t, "correct horse")
+
+	if err := store.Set("jira", &domain.Credentials{Type: domain.TokenAuth, Token: "jira-pat"}); err != nil {
+		t.Fatalf("Set(jira) error = %v", err)
+	}
+	if err := store.Set("bamboo", &domain.Credentials{Type: domain.BasicAuth, Username: "ci", Password: "secret"}); err != nil {
+		t.Fatalf("Set(bamboo) error = %v", err)
+	}
+
+	creds, err := store.Get("jira")
+	if err != nil {
+		t.Fatalf("Get(jira) error = %v", err)
+	}
+	if creds.Type != domain.TokenAuth || creds.Token != "jira-pat" {
+		t.Errorf("Get(jira) = %+v, want token jira-pat", creds)
+	}
+
+	creds, err = store.Get("bamboo")
+	if err != nil {
+		t.Fatalf("Get(bamboo) error = %v", err)
+	}
+	if creds.Type != domain.BasicAuth || creds.Username != "ci" || creds.Password != "secret" {
+		t.Errorf("Get(bamboo) = %+v, want basic ci/secret", creds)
+	}
+
+	tools, err := store.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if strings.Join(tools, ",") != "bamboo,jira" {
+		t.Errorf("List() = %v, want [bamboo jira]", tools)
+	}
+
+	if err := store.Remove("jira"); err != nil {
+		t.Fatalf("Remove(jira) error = %v", err)
+	}
+	if _, err := store.Get("jira"); !errors.Is(err, domain.ErrCredentialNotFound) {
+		t.Errorf("Get(jira) after remove error = %v, want ErrCredentialNotFound", err)
+	}
+	if err := store.Remove("jira"); !errors.Is(err, domain.ErrCredentialNotFound) {
+		t.Errorf("second Remove(jira) error = %v, want ErrCredentialNotFound", err)
+	}
+}
+
+func TestFileCredentialStore_FileIsEncrypted(t *testing.T) {
+	store := newTestFileStore(t, "passphrase")
+
+	if err := store.Set("confluence", &domain.Credentials{Type: domain.TokenAuth, Token: "super-secret-token"}); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	data, err := os.ReadFile(store.path)
+	if err != nil {
+		t.Fatalf("failed to read credentials file: %v", err)
+	}
+	if bytes.Contains(data, []byte("super-secret-token")) || bytes.Contains(data, []byte("confluence")) {
+		t.Error("credentials file contains plaintext secrets")
+	}
+
+	info, err := os.Stat(store.path)
+	if err != nil {
+		t.Fatalf("failed to stat credentials file: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("credentials file mode = %v, want 0600", info.Mode().Perm())
+	}
+}
+
+func TestFileCredentialStore_WrongPassphrase(t *testing.T) {
+	store := newTestFileStore(t, "right")
+	if err := store.Set("jira", &domain.Credentials{Type: domain.TokenAuth, Token: "t"}); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	other := NewFileCredentialStore(store.path, "wrong")
+	if _, err := other.Get("jira"); err == nil || !strings.Contains(err.Error(), "wrong passphrase") {
+		t.Errorf("Get() with wrong passphrase error = %v, want decryption failure", err)
+	}
+}
+
+func TestFileCredentialStore_MissingFileIsEmpty(t *testing.T) {
+	store := newTestFileStore(t, "pass")
+
+	tools, err := store.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(tools) != 0 {
+		t.Errorf("List() = %v, want empty", tools)
+	}
+	if _, err := store.Get("jira"); !errors.Is(err, domain.ErrCredentialNotFound) {
+		t.Errorf("Get() error = %v, want ErrCredentialNotFound", err)
+	}
+}
+
+func TestFileCredentialStore_RejectsInvalidCredentials(t *testing.T) {
+	store := newTestFileStore(t, "pass")
+
+	if err := store.Set("jira", &domain.Credentials{Type: domain.TokenAuth}); err == nil {
+		t.Error("expected error storing token credentials without a token")
+	}
+}
+
+// fakeSecretService simulates a Secret Service provider with an in-memory keyring.
+type fakeSecretService struct {
+	items  map[dbus.ObjectPath]*fakeSecretItem
+	next   int
+	opened int
+	closed int
+}
+
+// fakeSecretItem is an item of a fakeSecretService.
+type fakeSecretItem struct {
+	label  string
+	attrs  map[string]string
+	secret []byte
+}
+
+func (f *fakeSecretService) connect() (secretService, error) {
+	f.opened++
+	return f, nil
+}
+
+func (f *fakeSecretService) search(attrs map[string]string) ([]dbus.ObjectPath, error) {
+	var found []dbus.ObjectPath
+	for path, item := range f.items {
+		matches := true
+		for key, value := range attrs {
+			matches = matches && item.attrs[key] == value
+		}
+		if matches {
+			found = append(found, path)
+		}
+	}
+	return found, nil
+}
+
+func (f *fakeSecretService) secret(item dbus.ObjectPath) ([]byte, error) {
+	return f.items[item].secret, nil
+}
+
+func (f *fakeSecretService) attributes(item dbus.ObjectPath) (map[string]string, error) {
+	return f.items[item].attrs, nil
+}
+
+func (f *fakeSecretService) store(label string, attrs map[string]string, secret []byte) error {
+	for _, item := range f.items {
+		if reflect.DeepEqual(item.attrs, attrs) {
+			item.label, item.secret = label, secret
+			return nil
+		}
+	}
+	f.next++
+	path := dbus.ObjectPath(fmt.Sprintf("/org/freedesktop/secrets/collection/login/%d", f.next))
+	f.items[path] = &fakeSecretItem{label: label, attrs: attrs, secret: secret}
+	return nil
+}
+
+func (f *fakeSecretService) delete(item dbus.ObjectPath) error {
+	delete(f.items, item)
+	return nil
+}
+
+func (f *fakeSecretService) close() {
+	f.closed++
+}
+
+func TestKeyringCredentialStore(t *testing.T) {
+	fake := &fakeSecretService{items: make(map[dbus.ObjectPath]*fakeSecretItem)}
+	store := NewKeyringCredentialStore("atlassian-mcp-test")
+	store.connect = fake.connect
+
+	tools, err := store.List()
+	if err != nil {
+		t.Fatalf("List() on empty keyring error = %v", err)
+	}
+	if len(tools) != 0 {
+		t.Errorf("List() = %v, want empty", tools)
+	}
+
+	if err := store.Set("bitbucket", &domain.Credentials{Type: domain.TokenAuth, Token: "bb-token"}); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if err := store.Set("bitbucket", &domain.Credentials{Type: domain.TokenAuth, Token: "new-token"}); err != nil {
+		t.Fatalf("Set() replacing error = %v", err)
+	}
+	if len(fake.items) != 1 {
+		t.Fatalf("expected one keyring item, got %d", len(fake.items))
+	}
+	for _, item := range fake.items {
+		want := map[string]string{"service": "atlassian-mcp-test", "tool": "bitbucket"}
+		if !reflect.DeepEqual(item.attrs, want) || item.label != "atlassian-mcp-test credentials for bitbucket" {
+			t.Errorf("unexpected keyring item: %q %v", item.label, item.attrs)
+		}
+	}
+
+	creds, err := store.Get("bitbucket")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if creds.Token != "new-token" {
+		t.Errorf("Get() token = %q, want new-token", creds.Token)
+	}
+
+	tools, err = store.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(tools) != 1 || tools[0] != "bitbucket" {
+		t.Errorf("List() = %v, want [bitbucket]", tools)
+	}
+
+	if err := store.Remove("bitbucket"); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	if _, err := store.Get("bitbucket"); !errors.Is(err, domain.ErrCredentialNotFound) {
+		t.Errorf("Get() after remove error = %v, want ErrCredentialNotFound", err)
+	}
+	if err := store.Remove("bitbucket"); !errors.Is(err, domain.ErrCredentialNotFound) {
+		t.Errorf("Remove() of missing item error = %v, want ErrCredentialNotFound", err)
+	}
+
+	if fake.opened != fake.closed {
+		t.Errorf("opened %d Secret Service sessions but closed %d", fake.opened, fake.closed)
+	}
+}
+
+func TestKeyringCredentialStore_Unavailable(t *testing.T) {
+	store := NewKeyringCredentialStore("atlassian-mcp-test")
+	store.connect = func() (secretService, error) {
+		return nil, errors.New("keyring unavailable: no Secret Service provider")
+	}
+
+	if _, err := store.Get("jira"); err == nil || !strings.Contains(err.Error(), "keyring unavailable") {
+		t.Errorf("Get() error = %v, want keyring unavailable", err)
+	}
+	if err := store.Set("jira", &domain.Credentials{Type: domain.TokenAuth, Token: "t"}); err == nil {
+		t.Error("expected Set() to fail without a Secret Service")
+	}
+}
+
+func TestNewCredentialStore(t *testing.T) {
+	t.Run("file requires passphrase", func(t *testing.T) {
+		t.Setenv("TEST_MCP_PASSPHRASE", "")
+		_, err := NewCredentialStore(domain.CredentialStoreConfig{Backend: "file", PassphraseEnv: "TEST_MCP_PASSPHRASE"})
+		if err == nil || !strings.Contains(err.Error(), "TEST_MCP_PASSPHRASE") {
+			t.Errorf("error = %v, want missing passphrase error", err)
+		}
+	})
+
+	t.Run("file with passphrase", func(t *testing.T) {
+		t.Setenv("TEST_MCP_PASSPHRASE", "pass")
+		store, err := NewCredentialStore(domain.CredentialStoreConfig{Backend: "file", Path: filepath.Join(t.TempDir(), "c.enc"), PassphraseEnv: "TEST_MCP_PASSPHRASE"})
+		if err != nil {
+			t.Fatalf("error = %v", err)
+		}
+		if _, ok := store.(*FileCredentialStore); !ok {
+			t.Errorf("store type = %T, want *FileCredentialStore", store)
+		}
+	})
+
+	t.Run("keyring", func(t *testing.T) {
+		store, err := NewCredentialStore(domain.CredentialStoreConfig{Backend: "keyring"})
+		if err != nil {
+			t.Fatalf("error = %v", err)
+		}
+		keyring, ok := store.(*KeyringCredentialStore)
+		if !ok {
+			t.Fatalf("store type = %T, want *KeyringCredentialStore", store)
+		}
+		if keyring.service != domain.DefaultCredentialsService {
+			t.Errorf("service = %q, want %q", keyring.service, domain.DefaultCredentialsService)
+		}
+	})
+
+	t.Run("unknown backend", func(t *testing.T) {
+		if _, err := NewCredentialStore(domain.CredentialStoreConfig{Backend: "vault"}); err == nil {
+			t.Error("expected error for unknown backend")
+		}
+	})
+}
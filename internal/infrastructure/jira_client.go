@@ -5,8 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync"
 
 	"atlassian-mcp-server/internal/domain"
 )
@@ -17,6 +24,10 @@ import (
 type JiraClient struct {
 	baseURL    string
 	httpClient *http.Client
+
+	// Field metadata cache used to resolve field names and value types
+	fieldsMu sync.Mutex
+	fields   []domain.JiraField
 }
 
 // NewJiraClient creates a new Jira API client.
@@ -37,19 +48,27 @@ func (c *JiraClient) BaseURL() string {
 // Do executes an HTTP request with authentication.
 // This method is part of the AtlassianClient interface.
 func (c *JiraClient) Do(req *http.Request) (*http.Response, error) {
-	// Set common headers
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	// Set common headers unless the caller chose its own (e.g. multipart uploads)
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if req.Header.Get("Accept") == "" {
+		req.Header.Set("Accept", "application/json")
+	}
 
 	// Execute the request using the authenticated HTTP client
 	return c.httpClient.Do(req)
 }
 
 // GetIssue retrieves a Jira issue by its key (e.g., "TEST-123").
+// Optional expand values (e.g. "renderedFields") request additional data.
 // Returns the issue details or an error if the issue doesn't exist or cannot be retrieved.
-func (c *JiraClient) GetIssue(issueKey string) (*domain.JiraIssue, error) {
+func (c *JiraClient) GetIssue(issueKey string, expand ...string) (*domain.JiraIssue, error) {
 	// Construct the API endpoint
 	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s", c.baseURL, issueKey)
+	if len(expand) > 0 {
+		endpoint += "?expand=" + url.QueryEscape(strings.Join(expand, ","))
+	}
 
 	// Create the HTTP request
 	req, err := http.NewRequest("GET", endpoint, nil)
@@ -189,6 +208,7 @@ type SearchOptions struct {
 	StartAt    int      // The index of the first issue to return (0-based)
 	MaxResults int      // The maximum number of issues to return
 	Fields     []string // The fields to include in the response (optional)
+	Expand     []string // Entities to expand, e.g. "names" or "changelog" (optional)
 }
 
 // SearchJQL performs a JQL (Jira Query Language) search.
@@ -213,6 +233,9 @@ func (c *JiraClient) SearchJQL(jql string, options *SearchOptions) (*domain.Sear
 				params.Add("fields", field)
 			}
 		}
+		if len(options.Expand) > 0 {
+			params.Set("expand", strings.Join(options.Expand, ","))
+		}
 	}
 
 	// Add query parameters to endpoint
@@ -283,6 +306,45 @@ func (c *JiraClient) TransitionIssue(issueKey string, transition *domain.IssueTr
 	return nil
 }
 
+// JiraTransitionsResponse represents the response from the transitions API.
+type JiraTransitionsResponse struct {
+	Transitions []domain.Transition `json:"transitions"`
+}
+
+// GetTransitions retrieves the workflow transitions available on an issue for the current
+// user, including each transition's target status and screen fields.
+func (c *JiraClient) GetTransitions(issueKey string) ([]domain.Transition, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s/transitions?expand=transitions.fields", c.baseURL, url.PathEscape(issueKey))
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var response JiraTransitionsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return response.Transitions, nil
+}
+
 // AddComment adds a comment to a Jira issue.
 // The issueKey identifies the issue (e.g., "TEST-123").
 // Returns an error if the comment cannot be added.
@@ -318,6 +380,137 @@ func (c *JiraClient) AddComment(issueKey string, comment *domain.Comment) error
 	return nil
 }
 
+// CommentOptions contains options for listing comments.
+type CommentOptions struct {
+	StartAt    int    // The index of the first comment to return (0-based)
+	MaxResults int    // The maximum number of comments to return
+	OrderBy    string // "created" (oldest first) or "-created" (newest first)
+	Expand     string // Additional data to include, e.g. "renderedBody"
+}
+
+// GetComments retrieves a page of comments on a Jira issue.
+// The issueKey identifies the issue (e.g., "TEST-123").
+func (c *JiraClient) GetComments(issueKey string, options *CommentOptions) (*domain.CommentPage, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s/comment", c.baseURL, url.PathEscape(issueKey))
+
+	// Build query parameters
+	params := url.Values{}
+	if options != nil {
+		if options.StartAt > 0 {
+			params.Set("startAt", fmt.Sprintf("%d", options.StartAt))
+		}
+		if options.MaxResults > 0 {
+			params.Set("maxResults", fmt.Sprintf("%d", options.MaxResults))
+		}
+		if options.OrderBy != "" {
+			params.Set("orderBy", options.OrderBy)
+		}
+		if options.Expand != "" {
+			params.Set("expand", options.Expand)
+		}
+	}
+
+	// Add query parameters to endpoint
+	if len(params) > 0 {
+		endpoint = endpoint + "?" + params.Encode()
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var page domain.CommentPage
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &page, nil
+}
+
+// UpdateComment replaces the body and visibility of a comment.
+// Returns the updated comment.
+func (c *JiraClient) UpdateComment(issueKey, commentID string, comment *domain.Comment) (*domain.Comment, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s/comment/%s", c.baseURL, url.PathEscape(issueKey), url.PathEscape(commentID))
+
+	// Marshal the comment to JSON
+	body, err := json.Marshal(comment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal comment: %w", err)
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("PUT", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var updated domain.Comment
+	if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteComment deletes a comment from a Jira issue.
+func (c *JiraClient) DeleteComment(issueKey, commentID string) error {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s/comment/%s", c.baseURL, url.PathEscape(issueKey), url.PathEscape(commentID))
+
+	// Create the HTTP request
+	req, err := http.NewRequest("DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
 // GetProjects retrieves all projects accessible to the authenticated user.
 // Returns a list of projects or an error if the request fails.
 func (c *JiraClient) GetProjects() ([]domain.Project, error) {
@@ -351,3 +544,2687 @@ func (c *JiraClient) GetProjects() ([]domain.Project, error) {
 
 	return projects, nil
 }
+
+// jiraIdentityPermissions lists the permissions reported by WhoAmI.
+var jiraIdentityPermissions = []string{
+	"BROWSE_PROJECTS",
+	"CREATE_ISSUES",
+	"EDIT_ISSUES",
+	"TRANSITION_ISSUES",
+	"ASSIGN_ISSUES",
+	"ADD_COMMENTS",
+	"DELETE_ISSUES",
+	"ADMINISTER",
+}
+
+// JiraPermissionsResponse represents the response from the mypermissions API.
+type JiraPermissionsResponse struct {
+	Permissions map[string]struct {
+		Key            string `json:"key"`
+		Name           string `json:"name"`
+		HavePermission bool   `json:"havePermission"`
+	} `json:"permissions"`
+}
+
+// GetMyself retrieves the user the client is authenticated as.
+// Returns an error if the credentials are rejected.
+func (c *JiraClient) GetMyself() (*domain.User, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/myself", c.baseURL)
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var user domain.User
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &user, nil
+}
+
+// GetMyPermissions retrieves the given global permissions for the authenticated user.
+// Returns the keys of the permissions the user holds.
+func (c *JiraClient) GetMyPermissions(permissions []string) ([]string, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/mypermissions", c.baseURL)
+	if len(permissions) > 0 {
+		params := url.Values{}
+		params.Set("permissions", strings.Join(permissions, ","))
+		endpoint = endpoint + "?" + params.Encode()
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var response JiraPermissionsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	granted := []string{}
+	for key, permission := range response.Permissions {
+		if permission.HavePermission {
+			granted = append(granted, key)
+		}
+	}
+	sort.Strings(granted)
+
+	return granted, nil
+}
+
+// WhoAmI resolves the identity and permissions of the authenticated user.
+// The permissions lookup is best effort: if it fails the identity is returned
+// without permissions, so a restricted /mypermissions does not fail the check.
+func (c *JiraClient) WhoAmI() (*domain.Identity, error) {
+	user, err := c.GetMyself()
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := c.GetMyPermissions(jiraIdentityPermissions)
+	if err != nil {
+		permissions = nil
+	}
+
+	return &domain.Identity{
+		Tool:        "jira",
+		Username:    user.Name,
+		DisplayName: user.DisplayName,
+		Email:       user.EmailAddress,
+		Permissions: permissions,
+	}, nil
+}
+
+// GetFields retrieves all system and custom fields with their value schemas.
+// The result refreshes the cache used by CachedFields.
+func (c *JiraClient) GetFields() ([]domain.JiraField, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/field", c.baseURL)
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var fields []domain.JiraField
+	if err := json.NewDecoder(resp.Body).Decode(&fields); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	c.fieldsMu.Lock()
+	c.fields = fields
+	c.fieldsMu.Unlock()
+
+	return fields, nil
+}
+
+// CachedFields returns the field metadata from the last GetFields call,
+// fetching it on first use. Field definitions rarely change, so resolving
+// names for every issue does not cost an extra request.
+func (c *JiraClient) CachedFields() ([]domain.JiraField, error) {
+	c.fieldsMu.Lock()
+	fields := c.fields
+	c.fieldsMu.Unlock()
+
+	if fields != nil {
+		return fields, nil
+	}
+
+	return c.GetFields()
+}
+
+// JiraCreateMetaIssueTypesResponse represents a page of issue types from the createmeta API.
+type JiraCreateMetaIssueTypesResponse struct {
+	StartAt    int                          `json:"startAt"`
+	MaxResults int                          `json:"maxResults"`
+	Total      int                          `json:"total"`
+	IsLast     bool                         `json:"isLast"`
+	Values     []domain.CreateMetaIssueType `json:"values"`
+}
+
+// JiraCreateMetaFieldsResponse represents a page of fields from the createmeta API.
+type JiraCreateMetaFieldsResponse struct {
+	StartAt    int                      `json:"startAt"`
+	MaxResults int                      `json:"maxResults"`
+	Total      int                      `json:"total"`
+	IsLast     bool                     `json:"isLast"`
+	Values     []domain.CreateMetaField `json:"values"`
+}
+
+// createMetaPageSize is the page size requested from the createmeta API.
+const createMetaPageSize = 100
+
+// GetCreateMetaIssueTypes retrieves the issue types the user can create in a project.
+// All pages are fetched.
+func (c *JiraClient) GetCreateMetaIssueTypes(projectKey string) ([]domain.CreateMetaIssueType, error) {
+	issueTypes := []domain.CreateMetaIssueType{}
+	for startAt := 0; ; {
+		// Construct the API endpoint
+		endpoint := fmt.Sprintf("%s/rest/api/2/issue/createmeta/%s/issuetypes?startAt=%d&maxResults=%d",
+			c.baseURL, url.PathEscape(projectKey), startAt, createMetaPageSize)
+
+		// Create the HTTP request
+		req, err := http.NewRequest("GET", endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		// Execute the request
+		resp, err := c.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute request: %w", err)
+		}
+
+		// Check for error status codes
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+		}
+
+		// Parse the response
+		var page JiraCreateMetaIssueTypesResponse
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		issueTypes = append(issueTypes, page.Values...)
+		startAt += len(page.Values)
+		if page.IsLast || len(page.Values) == 0 || (page.Total > 0 && startAt >= page.Total) {
+			return issueTypes, nil
+		}
+	}
+}
+
+// GetCreateMetaFields retrieves the create-screen fields of an issue type in a project,
+// including whether they are required and their allowed values. All pages are fetched.
+func (c *JiraClient) GetCreateMetaFields(projectKey, issueTypeID string) ([]domain.CreateMetaField, error) {
+	fields := []domain.CreateMetaField{}
+	for startAt := 0; ; {
+		// Construct the API endpoint
+		endpoint := fmt.Sprintf("%s/rest/api/2/issue/createmeta/%s/issuetypes/%s?startAt=%d&maxResults=%d",
+			c.baseURL, url.PathEscape(projectKey), url.PathEscape(issueTypeID), startAt, createMetaPageSize)
+
+		// Create the HTTP request
+		req, err := http.NewRequest("GET", endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		// Execute the request
+		resp, err := c.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute request: %w", err)
+		}
+
+		// Check for error status codes
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+		}
+
+		// Parse the response
+		var page JiraCreateMetaFieldsResponse
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		fields = append(fields, page.Values...)
+		startAt += len(page.Values)
+		if page.IsLast || len(page.Values) == 0 || (page.Total > 0 && startAt >= page.Total) {
+			return fields, nil
+		}
+	}
+}
+
+// JiraIssueLinkTypesResponse represents the response from the issueLinkType API.
+type JiraIssueLinkTypesResponse struct {
+	IssueLinkTypes []domain.IssueLinkType `json:"issueLinkTypes"`
+}
+
+// GetIssueLinkTypes retrieves the issue link types configured in Jira.
+func (c *JiraClient) GetIssueLinkTypes() ([]domain.IssueLinkType, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issueLinkType", c.baseURL)
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var response JiraIssueLinkTypesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return response.IssueLinkTypes, nil
+}
+
+// CreateIssueLink links two issues.
+func (c *JiraClient) CreateIssueLink(link *domain.IssueLinkRequest) error {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issueLink", c.baseURL)
+
+	// Marshal the link to JSON
+	body, err := json.Marshal(link)
+	if err != nil {
+		return fmt.Errorf("failed to marshal issue link: %w", err)
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// DeleteIssueLink deletes an issue link by its ID.
+func (c *JiraClient) DeleteIssueLink(linkID string) error {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issueLink/%s", c.baseURL, url.PathEscape(linkID))
+
+	// Create the HTTP request
+	req, err := http.NewRequest("DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// JiraIssueAttachmentsResponse represents an issue fetched with only its attachment field.
+type JiraIssueAttachmentsResponse struct {
+	Fields struct {
+		Attachment []domain.Attachment `json:"attachment"`
+	} `json:"fields"`
+}
+
+// GetAttachments retrieves the metadata of all attachments on an issue.
+func (c *JiraClient) GetAttachments(issueKey string) ([]domain.Attachment, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s?fields=attachment", c.baseURL, url.PathEscape(issueKey))
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var response JiraIssueAttachmentsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if response.Fields.Attachment == nil {
+		return []domain.Attachment{}, nil
+	}
+	return response.Fields.Attachment, nil
+}
+
+// GetAttachment retrieves the metadata of an attachment by its ID.
+func (c *JiraClient) GetAttachment(attachmentID string) (*domain.Attachment, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/attachment/%s", c.baseURL, url.PathEscape(attachmentID))
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var attachment domain.Attachment
+	if err := json.NewDecoder(resp.Body).Decode(&attachment); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &attachment, nil
+}
+
+// DownloadAttachment retrieves the content of an attachment.
+// The download URL is built from the client's base URL rather than the attachment's
+// content link, so credentials are never sent to another host.
+// Returns an error if the content is larger than maxBytes.
+func (c *JiraClient) DownloadAttachment(attachment *domain.Attachment, maxBytes int64) ([]byte, error) {
+	// Construct the download URL
+	endpoint := fmt.Sprintf("%s/secure/attachment/%s/%s", c.baseURL, url.PathEscape(string(attachment.ID)), url.PathEscape(attachment.Filename))
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "*/*")
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Read the content, stopping one byte past the limit
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+	if int64(len(content)) > maxBytes {
+		return nil, fmt.Errorf("attachment %s is larger than %d bytes", attachment.Filename, maxBytes)
+	}
+
+	return content, nil
+}
+
+// AddAttachment uploads a file to an issue as a multipart request.
+// Returns the metadata of the created attachment.
+func (c *JiraClient) AddAttachment(issueKey, filename string, content []byte) ([]domain.Attachment, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s/attachments", c.baseURL, url.PathEscape(issueKey))
+
+	// Build the multipart body
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(filename)))
+	contentType := mime.TypeByExtension(path.Ext(filename))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	header.Set("Content-Type", contentType)
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create multipart body: %w", err)
+	}
+	if _, err := part.Write(content); err != nil {
+		return nil, fmt.Errorf("failed to create multipart body: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to create multipart body: %w", err)
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("POST", endpoint, &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("X-Atlassian-Token", "no-check")
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var attachments []domain.Attachment
+	if err := json.NewDecoder(resp.Body).Decode(&attachments); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return attachments, nil
+}
+
+// WorklogOptions controls how adding, updating or deleting a worklog adjusts
+// the remaining estimate of the issue.
+type WorklogOptions struct {
+	AdjustEstimate string // "auto" (default), "new", "leave" or "manual"
+	NewEstimate    string // Remaining estimate to set when AdjustEstimate is "new"
+	ReduceBy       string // Amount to reduce the estimate by when adding with "manual"
+	IncreaseBy     string // Amount to increase the estimate by when deleting with "manual"
+}
+
+// query encodes the options as worklog query parameters.
+func (o *WorklogOptions) query() string {
+	if o == nil || o.AdjustEstimate == "" {
+		return ""
+	}
+
+	params := url.Values{}
+	params.Set("adjustEstimate", o.AdjustEstimate)
+	if o.NewEstimate != "" {
+		params.Set("newEstimate", o.NewEstimate)
+	}
+	if o.ReduceBy != "" {
+		params.Set("reduceBy", o.ReduceBy)
+	}
+	if o.IncreaseBy != "" {
+		params.Set("increaseBy", o.IncreaseBy)
+	}
+	return "?" + params.Encode()
+}
+
+// GetWorklogs retrieves the worklogs of a Jira issue.
+func (c *JiraClient) GetWorklogs(issueKey string) (*domain.WorklogPage, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s/worklog", c.baseURL, url.PathEscape(issueKey))
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var page domain.WorklogPage
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if page.Worklogs == nil {
+		page.Worklogs = []domain.Worklog{}
+	}
+	return &page, nil
+}
+
+// AddWorklog logs time on a Jira issue.
+// Returns the created worklog.
+func (c *JiraClient) AddWorklog(issueKey string, worklog *domain.Worklog, options *WorklogOptions) (*domain.Worklog, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s/worklog%s", c.baseURL, url.PathEscape(issueKey), options.query())
+
+	// Marshal the worklog to JSON
+	body, err := json.Marshal(worklog)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal worklog: %w", err)
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var created domain.Worklog
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// UpdateWorklog changes the time spent, start time or comment of a worklog.
+// Returns the updated worklog.
+func (c *JiraClient) UpdateWorklog(issueKey, worklogID string, worklog *domain.Worklog, options *WorklogOptions) (*domain.Worklog, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s/worklog/%s%s", c.baseURL, url.PathEscape(issueKey), url.PathEscape(worklogID), options.query())
+
+	// Marshal the worklog to JSON
+	body, err := json.Marshal(worklog)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal worklog: %w", err)
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("PUT", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var updated domain.Worklog
+	if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteWorklog deletes a worklog from a Jira issue.
+func (c *JiraClient) DeleteWorklog(issueKey, worklogID string, options *WorklogOptions) error {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s/worklog/%s%s", c.baseURL, url.PathEscape(issueKey), url.PathEscape(worklogID), options.query())
+
+	// Create the HTTP request
+	req, err := http.NewRequest("DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// JiraIssueTimeTrackingResponse represents an issue fetched with only the timetracking field.
+type JiraIssueTimeTrackingResponse struct {
+	Fields struct {
+		TimeTracking *domain.TimeTracking `json:"timetracking"`
+	} `json:"fields"`
+}
+
+// GetTimeTracking retrieves the estimates and time spent on a Jira issue.
+func (c *JiraClient) GetTimeTracking(issueKey string) (*domain.TimeTracking, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s?fields=timetracking", c.baseURL, url.PathEscape(issueKey))
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var response JiraIssueTimeTrackingResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if response.Fields.TimeTracking == nil {
+		return &domain.TimeTracking{}, nil
+	}
+	return response.Fields.TimeTracking, nil
+}
+
+// agileBatchSize is the maximum number of issues the Agile API accepts per
+// move or rank request.
+const agileBatchSize = 50
+
+// BoardOptions contains options for listing boards.
+type BoardOptions struct {
+	StartAt        int    // The index of the first board to return (0-based)
+	MaxResults     int    // The maximum number of boards to return
+	Type           string // "scrum" or "kanban" (optional)
+	Name           string // Only boards whose name contains this text (optional)
+	ProjectKeyOrID string // Only boards of this project (optional)
+}
+
+// GetBoards retrieves the Jira Software boards visible to the user.
+func (c *JiraClient) GetBoards(options *BoardOptions) (*domain.BoardPage, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/agile/1.0/board", c.baseURL)
+
+	// Build query parameters
+	params := url.Values{}
+	if options != nil {
+		if options.StartAt > 0 {
+			params.Set("startAt", fmt.Sprintf("%d", options.StartAt))
+		}
+		if options.MaxResults > 0 {
+			params.Set("maxResults", fmt.Sprintf("%d", options.MaxResults))
+		}
+		if options.Type != "" {
+			params.Set("type", options.Type)
+		}
+		if options.Name != "" {
+			params.Set("name", options.Name)
+		}
+		if options.ProjectKeyOrID != "" {
+			params.Set("projectKeyOrId", options.ProjectKeyOrID)
+		}
+	}
+
+	// Add query parameters to endpoint
+	if len(params) > 0 {
+		endpoint = endpoint + "?" + params.Encode()
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var page domain.BoardPage
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if page.Values == nil {
+		page.Values = []domain.Board{}
+	}
+	return &page, nil
+}
+
+// SprintOptions contains options for listing the sprints of a board.
+type SprintOptions struct {
+	StartAt    int      // The index of the first sprint to return (0-based)
+	MaxResults int      // The maximum number of sprints to return
+	States     []string // Only sprints in these states: future, active, closed (optional)
+}
+
+// GetSprints retrieves the sprints of a scrum board.
+func (c *JiraClient) GetSprints(boardID int, options *SprintOptions) (*domain.SprintPage, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/agile/1.0/board/%d/sprint", c.baseURL, boardID)
+
+	// Build query parameters
+	params := url.Values{}
+	if options != nil {
+		if options.StartAt > 0 {
+			params.Set("startAt", fmt.Sprintf("%d", options.StartAt))
+		}
+		if options.MaxResults > 0 {
+			params.Set("maxResults", fmt.Sprintf("%d", options.MaxResults))
+		}
+		if len(options.States) > 0 {
+			params.Set("state", strings.Join(options.States, ","))
+		}
+	}
+
+	// Add query parameters to endpoint
+	if len(params) > 0 {
+		endpoint = endpoint + "?" + params.Encode()
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var page domain.SprintPage
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if page.Values == nil {
+		page.Values = []domain.Sprint{}
+	}
+	return &page, nil
+}
+
+// GetSprint retrieves a sprint by its ID.
+func (c *JiraClient) GetSprint(sprintID int) (*domain.Sprint, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/agile/1.0/sprint/%d", c.baseURL, sprintID)
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var sprint domain.Sprint
+	if err := json.NewDecoder(resp.Body).Decode(&sprint); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &sprint, nil
+}
+
+// UpdateSprint partially updates a sprint. Setting the state to active starts
+// the sprint; setting it to closed completes it.
+// Returns the updated sprint.
+func (c *JiraClient) UpdateSprint(sprintID int, update *domain.SprintUpdate) (*domain.Sprint, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/agile/1.0/sprint/%d", c.baseURL, sprintID)
+
+	// Marshal the update to JSON
+	body, err := json.Marshal(update)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sprint: %w", err)
+	}
+
+	// Create the HTTP request (POST performs a partial update)
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var sprint domain.Sprint
+	if err := json.NewDecoder(resp.Body).Decode(&sprint); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &sprint, nil
+}
+
+// GetSprintIssues retrieves the issues in a sprint, optionally filtered by JQL.
+func (c *JiraClient) GetSprintIssues(sprintID int, options *SearchOptions) (*domain.SearchResults, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/agile/1.0/sprint/%d/issue", c.baseURL, sprintID)
+
+	// Build query parameters
+	params := url.Values{}
+	if options != nil {
+		if options.JQL != "" {
+			params.Set("jql", options.JQL)
+		}
+		if options.StartAt > 0 {
+			params.Set("startAt", fmt.Sprintf("%d", options.StartAt))
+		}
+		if options.MaxResults > 0 {
+			params.Set("maxResults", fmt.Sprintf("%d", options.MaxResults))
+		}
+		if len(options.Fields) > 0 {
+			params.Set("fields", strings.Join(options.Fields, ","))
+		}
+	}
+
+	// Add query parameters to endpoint
+	if len(params) > 0 {
+		endpoint = endpoint + "?" + params.Encode()
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var results domain.SearchResults
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &results, nil
+}
+
+// MoveIssuesToSprint moves issues into a sprint, in batches of agileBatchSize.
+func (c *JiraClient) MoveIssuesToSprint(sprintID int, issueKeys []string) error {
+	return c.moveIssues(fmt.Sprintf("%s/rest/agile/1.0/sprint/%d/issue", c.baseURL, sprintID), issueKeys)
+}
+
+// MoveIssuesToBacklog removes issues from their sprints, in batches of agileBatchSize.
+func (c *JiraClient) MoveIssuesToBacklog(issueKeys []string) error {
+	return c.moveIssues(fmt.Sprintf("%s/rest/agile/1.0/backlog/issue", c.baseURL), issueKeys)
+}
+
+// moveIssues posts issue keys to an Agile move endpoint in batches.
+func (c *JiraClient) moveIssues(endpoint string, issueKeys []string) error {
+	for start := 0; start < len(issueKeys); start += agileBatchSize {
+		end := start + agileBatchSize
+		if end > len(issueKeys) {
+			end = len(issueKeys)
+		}
+
+		// Marshal the batch to JSON
+		body, err := json.Marshal(map[string][]string{"issues": issueKeys[start:end]})
+		if err != nil {
+			return fmt.Errorf("failed to marshal issues: %w", err)
+		}
+
+		// Create the HTTP request
+		req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+
+		// Execute the request
+		resp, err := c.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to execute request: %w", err)
+		}
+
+		// Check for error status codes
+		if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+		}
+		resp.Body.Close()
+	}
+
+	return nil
+}
+
+// JiraRankResponse represents the partial-success response of the rank endpoint.
+type JiraRankResponse struct {
+	Entries []domain.RankResult `json:"entries"`
+}
+
+// RankIssues moves issues before or after another issue in the board ranking.
+// Issues are ranked in batches of agileBatchSize, each batch placed after the
+// previous one so the given order is preserved. Returns an error naming the
+// issues Jira could not rank.
+func (c *JiraClient) RankIssues(rank *domain.RankRequest) error {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/agile/1.0/issue/rank", c.baseURL)
+
+	batch := *rank
+	for start := 0; start < len(rank.Issues); start += agileBatchSize {
+		end := start + agileBatchSize
+		if end > len(rank.Issues) {
+			end = len(rank.Issues)
+		}
+		batch.Issues = rank.Issues[start:end]
+
+		// Marshal the rank request to JSON
+		body, err := json.Marshal(batch)
+		if err != nil {
+			return fmt.Errorf("failed to marshal rank request: %w", err)
+		}
+
+		// Create the HTTP request
+		req, err := http.NewRequest("PUT", endpoint, bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+
+		// Execute the request
+		resp, err := c.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to execute request: %w", err)
+		}
+
+		// Check for error status codes (207 means some issues failed)
+		switch resp.StatusCode {
+		case http.StatusNoContent, http.StatusOK:
+			resp.Body.Close()
+		case http.StatusMultiStatus:
+			var response JiraRankResponse
+			err := json.NewDecoder(resp.Body).Decode(&response)
+			resp.Body.Close()
+			if err != nil {
+				return fmt.Errorf("failed to decode response: %w", err)
+			}
+			var failures []string
+			for _, entry := range response.Entries {
+				if entry.Status >= 300 {
+					failures = append(failures, fmt.Sprintf("%s: %s", entry.IssueKey, strings.Join(entry.Errors, "; ")))
+				}
+			}
+			if len(failures) > 0 {
+				return fmt.Errorf("failed to rank issues: %s", strings.Join(failures, ", "))
+			}
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+		}
+
+		// The next batch follows the last issue of this one
+		batch.RankBeforeIssue = ""
+		batch.RankAfterIssue = batch.Issues[len(batch.Issues)-1]
+	}
+
+	return nil
+}
+
+// GetProject retrieves a project by key, including its versions and components.
+func (c *JiraClient) GetProject(projectKey string) (*domain.Project, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/project/%s", c.baseURL, url.PathEscape(projectKey))
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var project domain.Project
+	if err := json.NewDecoder(resp.Body).Decode(&project); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &project, nil
+}
+
+// GetProjectVersions retrieves all versions of a project.
+func (c *JiraClient) GetProjectVersions(projectKey string) ([]domain.JiraVersion, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/project/%s/versions", c.baseURL, url.PathEscape(projectKey))
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var versions []domain.JiraVersion
+	if err := json.NewDecoder(resp.Body).Decode(&versions); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if versions == nil {
+		versions = []domain.JiraVersion{}
+	}
+	return versions, nil
+}
+
+// CreateVersion creates a version in the project named by version.Project.
+// Returns the created version.
+func (c *JiraClient) CreateVersion(version *domain.JiraVersionRequest) (*domain.JiraVersion, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/version", c.baseURL)
+
+	// Marshal the version to JSON
+	body, err := json.Marshal(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal version: %w", err)
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var created domain.JiraVersion
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// UpdateVersion partially updates a version; this also releases and archives versions.
+// Returns the updated version.
+func (c *JiraClient) UpdateVersion(versionID string, version *domain.JiraVersionRequest) (*domain.JiraVersion, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/version/%s", c.baseURL, url.PathEscape(versionID))
+
+	// Marshal the version to JSON
+	body, err := json.Marshal(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal version: %w", err)
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("PUT", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var updated domain.JiraVersion
+	if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// GetVersionIssueCounts retrieves the number of issues fixed in, affected by and
+// still unresolved for a version.
+func (c *JiraClient) GetVersionIssueCounts(versionID string) (*domain.VersionIssueCounts, error) {
+	counts := &domain.VersionIssueCounts{VersionID: versionID}
+	for _, resource := range []string{"relatedIssueCounts", "unresolvedIssueCount"} {
+		// Construct the API endpoint
+		endpoint := fmt.Sprintf("%s/rest/api/2/version/%s/%s", c.baseURL, url.PathEscape(versionID), resource)
+
+		// Create the HTTP request
+		req, err := http.NewRequest("GET", endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		// Execute the request
+		resp, err := c.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute request: %w", err)
+		}
+
+		// Check for error status codes
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+		}
+
+		// Parse the response; both resources decode into the same counts
+		err = json.NewDecoder(resp.Body).Decode(counts)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+
+	return counts, nil
+}
+
+// GetProjectComponents retrieves all components of a project.
+func (c *JiraClient) GetProjectComponents(projectKey string) ([]domain.Component, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/project/%s/components", c.baseURL, url.PathEscape(projectKey))
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var components []domain.Component
+	if err := json.NewDecoder(resp.Body).Decode(&components); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if components == nil {
+		components = []domain.Component{}
+	}
+	return components, nil
+}
+
+// CreateComponent creates a component in the project named by component.Project.
+// Returns the created component.
+func (c *JiraClient) CreateComponent(component *domain.ComponentRequest) (*domain.Component, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/component", c.baseURL)
+
+	// Marshal the component to JSON
+	body, err := json.Marshal(component)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal component: %w", err)
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var created domain.Component
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// JiraChangelogPageResponse represents a page from /rest/api/2/issue/{key}/changelog.
+type JiraChangelogPageResponse struct {
+	StartAt    int                    `json:"startAt"`
+	MaxResults int                    `json:"maxResults"`
+	Total      int                    `json:"total"`
+	Values     []domain.ChangeHistory `json:"values"`
+}
+
+// JiraIssueChangelogResponse represents an issue fetched with expand=changelog.
+type JiraIssueChangelogResponse struct {
+	Changelog domain.Changelog `json:"changelog"`
+}
+
+// GetChangelog retrieves a page of the change history of an issue, oldest first.
+// Jira versions without the paginated changelog resource return the full history
+// with expand=changelog, which is then paged locally.
+func (c *JiraClient) GetChangelog(issueKey string, startAt, maxResults int) (*domain.Changelog, error) {
+	// Construct the API endpoint
+	params := url.Values{}
+	params.Set("startAt", fmt.Sprintf("%d", startAt))
+	if maxResults > 0 {
+		params.Set("maxResults", fmt.Sprintf("%d", maxResults))
+	}
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s/changelog?%s", c.baseURL, url.PathEscape(issueKey), params.Encode())
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Fall back to expand=changelog if the paginated resource does not exist
+	if resp.StatusCode == http.StatusNotFound {
+		return c.getExpandedChangelog(issueKey, startAt, maxResults)
+	}
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var page JiraChangelogPageResponse
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	changelog := &domain.Changelog{
+		StartAt:    page.StartAt,
+		MaxResults: page.MaxResults,
+		Total:      page.Total,
+		Histories:  page.Values,
+	}
+	if changelog.Histories == nil {
+		changelog.Histories = []domain.ChangeHistory{}
+	}
+	return changelog, nil
+}
+
+// getExpandedChangelog retrieves the full change history with expand=changelog
+// and returns the requested page of it.
+func (c *JiraClient) getExpandedChangelog(issueKey string, startAt, maxResults int) (*domain.Changelog, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s?expand=changelog&fields=created", c.baseURL, url.PathEscape(issueKey))
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var response JiraIssueChangelogResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	// Page the histories locally
+	histories := response.Changelog.Histories
+	total := len(histories)
+	if startAt > total {
+		startAt = total
+	}
+	end := total
+	if maxResults > 0 && startAt+maxResults < total {
+		end = startAt + maxResults
+	}
+
+	changelog := &domain.Changelog{
+		StartAt:    startAt,
+		MaxResults: end - startAt,
+		Total:      total,
+		Histories:  histories[startAt:end],
+	}
+	if changelog.Histories == nil {
+		changelog.Histories = []domain.ChangeHistory{}
+	}
+	return changelog, nil
+}
+
+// GetWatchers retrieves the users watching an issue.
+func (c *JiraClient) GetWatchers(issueKey string) (*domain.Watchers, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s/watchers", c.baseURL, url.PathEscape(issueKey))
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var watchers domain.Watchers
+	if err := json.NewDecoder(resp.Body).Decode(&watchers); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if watchers.Watchers == nil {
+		watchers.Watchers = []domain.User{}
+	}
+	return &watchers, nil
+}
+
+// AddWatcher adds a user to the watchers of an issue.
+func (c *JiraClient) AddWatcher(issueKey, username string) error {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s/watchers", c.baseURL, url.PathEscape(issueKey))
+
+	// The request body is the username as a JSON string
+	body, err := json.Marshal(username)
+	if err != nil {
+		return fmt.Errorf("failed to marshal username: %w", err)
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// RemoveWatcher removes a user from the watchers of an issue.
+func (c *JiraClient) RemoveWatcher(issueKey, username string) error {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s/watchers?username=%s", c.baseURL, url.PathEscape(issueKey), url.QueryEscape(username))
+
+	// Create the HTTP request
+	req, err := http.NewRequest("DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// GetVotes retrieves the votes on an issue.
+func (c *JiraClient) GetVotes(issueKey string) (*domain.Votes, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s/votes", c.baseURL, url.PathEscape(issueKey))
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var votes domain.Votes
+	if err := json.NewDecoder(resp.Body).Decode(&votes); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if votes.Voters == nil {
+		votes.Voters = []domain.User{}
+	}
+	return &votes, nil
+}
+
+// AddVote casts the authenticated user's vote for an issue.
+func (c *JiraClient) AddVote(issueKey string) error {
+	return c.changeVote("POST", issueKey)
+}
+
+// RemoveVote withdraws the authenticated user's vote for an issue.
+func (c *JiraClient) RemoveVote(issueKey string) error {
+	return c.changeVote("DELETE", issueKey)
+}
+
+// changeVote adds (POST) or removes (DELETE) the authenticated user's vote.
+func (c *JiraClient) changeVote(method, issueKey string) error {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s/votes", c.baseURL, url.PathEscape(issueKey))
+
+	// Create the HTTP request
+	req, err := http.NewRequest(method, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// Notify sends an email notification about an issue.
+func (c *JiraClient) Notify(issueKey string, notification *domain.Notification) error {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s/notify", c.baseURL, url.PathEscape(issueKey))
+
+	// Marshal the notification to JSON
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// SearchUsers finds active users whose username, display name or email address
+// matches the query.
+func (c *JiraClient) SearchUsers(query string, maxResults int) ([]domain.User, error) {
+	// Construct the API endpoint
+	params := url.Values{}
+	params.Set("username", query)
+	if maxResults > 0 {
+		params.Set("maxResults", fmt.Sprintf("%d", maxResults))
+	}
+	endpoint := fmt.Sprintf("%s/rest/api/2/user/search?%s", c.baseURL, params.Encode())
+
+	return c.getUsers(endpoint)
+}
+
+// AssignableUserOptions contains options for finding assignable users.
+type AssignableUserOptions struct {
+	ProjectKey string // Users assignable to issues of this project
+	IssueKey   string // Users assignable to this issue (takes precedence over ProjectKey)
+	Query      string // Only users whose username, display name or email matches (optional)
+	MaxResults int    // The maximum number of users to return
+}
+
+// GetAssignableUsers finds the users that can be assigned issues of a project
+// or a specific issue.
+func (c *JiraClient) GetAssignableUsers(options *AssignableUserOptions) ([]domain.User, error) {
+	// Build query parameters
+	params := url.Values{}
+	if options.IssueKey != "" {
+		params.Set("issueKey", options.IssueKey)
+	} else {
+		params.Set("project", options.ProjectKey)
+	}
+	if options.Query != "" {
+		params.Set("username", options.Query)
+	}
+	if options.MaxResults > 0 {
+		params.Set("maxResults", fmt.Sprintf("%d", options.MaxResults))
+	}
+
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/user/assignable/search?%s", c.baseURL, params.Encode())
+
+	return c.getUsers(endpoint)
+}
+
+// getUsers retrieves a list of users from a user search endpoint.
+func (c *JiraClient) getUsers(endpoint string) ([]domain.User, error) {
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var users []domain.User
+	if err := json.NewDecoder(resp.Body).Decode(&users); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if users == nil {
+		users = []domain.User{}
+	}
+	return users, nil
+}
+
+// GetGroupMembers retrieves a page of the members of a group.
+func (c *JiraClient) GetGroupMembers(group string, includeInactive bool, startAt, maxResults int) (*domain.GroupMembers, error) {
+	// Build query parameters
+	params := url.Values{}
+	params.Set("groupname", group)
+	if includeInactive {
+		params.Set("includeInactiveUsers", "true")
+	}
+	if startAt > 0 {
+		params.Set("startAt", fmt.Sprintf("%d", startAt))
+	}
+	if maxResults > 0 {
+		params.Set("maxResults", fmt.Sprintf("%d", maxResults))
+	}
+
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/group/member?%s", c.baseURL, params.Encode())
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var members domain.GroupMembers
+	if err := json.NewDecoder(resp.Body).Decode(&members); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	members.Group = group
+	if members.Values == nil {
+		members.Values = []domain.User{}
+	}
+	return &members, nil
+}
+
+// JiraJQLParseResponse represents the response from the JQL parse endpoint.
+type JiraJQLParseResponse struct {
+	Queries []struct {
+		Query    string   `json:"query"`
+		Errors   []string `json:"errors"`
+		Warnings []string `json:"warnings"`
+	} `json:"queries"`
+}
+
+// ValidateJQL checks JQL queries with the parse endpoint. Servers without it are
+// checked by running each query as a strictly validated search for no issues.
+func (c *JiraClient) ValidateJQL(queries []string) ([]domain.JQLQueryValidation, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/jql/parse?validation=strict", c.baseURL)
+
+	// Marshal the request body
+	body, err := json.Marshal(map[string]interface{}{"queries": queries})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal queries: %w", err)
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Fall back to validating searches if the parse endpoint does not exist
+	if resp.StatusCode == http.StatusNotFound {
+		results := make([]domain.JQLQueryValidation, len(queries))
+		for i, query := range queries {
+			result, err := c.validateJQLBySearch(query)
+			if err != nil {
+				return nil, err
+			}
+			results[i] = *result
+		}
+		return results, nil
+	}
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var parsed JiraJQLParseResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	results := make([]domain.JQLQueryValidation, len(parsed.Queries))
+	for i, query := range parsed.Queries {
+		results[i] = domain.JQLQueryValidation{
+			Query:    query.Query,
+			Valid:    len(query.Errors) == 0,
+			Errors:   query.Errors,
+			Warnings: query.Warnings,
+		}
+	}
+	return results, nil
+}
+
+// validateJQLBySearch checks a JQL query by searching for no issues with strict
+// validation; Jira answers 400 with the problems of an invalid query.
+func (c *JiraClient) validateJQLBySearch(jql string) (*domain.JQLQueryValidation, error) {
+	// Construct the API endpoint
+	params := url.Values{}
+	params.Set("jql", jql)
+	params.Set("maxResults", "0")
+	params.Set("validateQuery", "strict")
+	params.Set("fields", "key")
+	endpoint := fmt.Sprintf("%s/rest/api/2/search?%s", c.baseURL, params.Encode())
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	result := &domain.JQLQueryValidation{Query: jql}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		var page struct {
+			WarningMessages []string `json:"warningMessages"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		result.Valid = true
+		result.Warnings = page.WarningMessages
+	case http.StatusBadRequest:
+		var problems struct {
+			ErrorMessages   []string          `json:"errorMessages"`
+			Errors          map[string]string `json:"errors"`
+			WarningMessages []string          `json:"warningMessages"`
+		}
+		body, _ := io.ReadAll(resp.Body)
+		if err := json.Unmarshal(body, &problems); err != nil {
+			problems.ErrorMessages = []string{strings.TrimSpace(string(body))}
+		}
+		result.Errors = problems.ErrorMessages
+		fields := make([]string, 0, len(problems.Errors))
+		for field := range problems.Errors {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			result.Errors = append(result.Errors, problems.Errors[field])
+		}
+		result.Warnings = problems.WarningMessages
+	default:
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+	return result, nil
+}
+
+// GetJQLAutocompleteData retrieves the fields, functions and reserved words usable in JQL.
+func (c *JiraClient) GetJQLAutocompleteData() (*domain.JQLAutocompleteData, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/jql/autocompletedata", c.baseURL)
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var data domain.JQLAutocompleteData
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if data.VisibleFieldNames == nil {
+		data.VisibleFieldNames = []domain.JQLFieldReference{}
+	}
+	if data.VisibleFunctionNames == nil {
+		data.VisibleFunctionNames = []domain.JQLFunctionReference{}
+	}
+	return &data, nil
+}
+
+// JiraJQLSuggestionsResponse represents the response from the JQL suggestions endpoint.
+type JiraJQLSuggestionsResponse struct {
+	Results []domain.JQLSuggestion `json:"results"`
+}
+
+// GetJQLSuggestions suggests values for a JQL field that start with the given text.
+func (c *JiraClient) GetJQLSuggestions(fieldName, fieldValue string) ([]domain.JQLSuggestion, error) {
+	// Construct the API endpoint
+	params := url.Values{}
+	params.Set("fieldName", fieldName)
+	if fieldValue != "" {
+		params.Set("fieldValue", fieldValue)
+	}
+	endpoint := fmt.Sprintf("%s/rest/api/2/jql/autocompletedata/suggestions?%s", c.baseURL, params.Encode())
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var suggestions JiraJQLSuggestionsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&suggestions); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if suggestions.Results == nil {
+		suggestions.Results = []domain.JQLSuggestion{}
+	}
+	return suggestions.Results, nil
+}
+
+// GetFavouriteFilters retrieves the filters the user has marked as favourite.
+func (c *JiraClient) GetFavouriteFilters() ([]domain.Filter, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/filter/favourite", c.baseURL)
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var filters []domain.Filter
+	if err := json.NewDecoder(resp.Body).Decode(&filters); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if filters == nil {
+		filters = []domain.Filter{}
+	}
+	for i := range filters {
+		if filters[i].SharePermissions == nil {
+			filters[i].SharePermissions = []domain.SharePermission{}
+		}
+	}
+	return filters, nil
+}
+
+// GetFilter retrieves a saved filter with its JQL and share permissions.
+func (c *JiraClient) GetFilter(filterID string) (*domain.Filter, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/filter/%s", c.baseURL, url.PathEscape(filterID))
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return c.doFilterRequest(req, http.StatusOK)
+}
+
+// CreateFilter saves a new filter.
+func (c *JiraClient) CreateFilter(filter *domain.FilterRequest) (*domain.Filter, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/filter", c.baseURL)
+
+	// Marshal the request body
+	body, err := json.Marshal(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal filter: %w", err)
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return c.doFilterRequest(req, http.StatusOK, http.StatusCreated)
+}
+
+// UpdateFilter changes the name, description, JQL or favourite flag of a filter.
+func (c *JiraClient) UpdateFilter(filterID string, filter *domain.FilterRequest) (*domain.Filter, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/filter/%s", c.baseURL, url.PathEscape(filterID))
+
+	// Marshal the request body
+	body, err := json.Marshal(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal filter: %w", err)
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("PUT", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return c.doFilterRequest(req, http.StatusOK)
+}
+
+// doFilterRequest executes a request that returns a filter.
+func (c *JiraClient) doFilterRequest(req *http.Request, okStatuses ...int) (*domain.Filter, error) {
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	ok := false
+	for _, status := range okStatuses {
+		ok = ok || resp.StatusCode == status
+	}
+	if !ok {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var filter domain.Filter
+	if err := json.NewDecoder(resp.Body).Decode(&filter); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if filter.SharePermissions == nil {
+		filter.SharePermissions = []domain.SharePermission{}
+	}
+	return &filter, nil
+}
+
+// AddFilterPermission shares a filter and returns its share permissions.
+func (c *JiraClient) AddFilterPermission(filterID string, permission *domain.SharePermissionRequest) ([]domain.SharePermission, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/filter/%s/permission", c.baseURL, url.PathEscape(filterID))
+
+	// Marshal the request body
+	body, err := json.Marshal(permission)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal share permission: %w", err)
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var permissions []domain.SharePermission
+	if err := json.NewDecoder(resp.Body).Decode(&permissions); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if permissions == nil {
+		permissions = []domain.SharePermission{}
+	}
+	return permissions, nil
+}
+
+// DeleteFilterPermission removes a share permission from a filter.
+func (c *JiraClient) DeleteFilterPermission(filterID, permissionID string) error {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/filter/%s/permission/%s", c.baseURL, url.PathEscape(filterID), url.PathEscape(permissionID))
+
+	// Create the HTTP request
+	req, err := http.NewRequest("DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// GetDashboards retrieves a page of dashboards. filter is "favourite", "my" or
+// empty for all dashboards visible to the user.
+func (c *JiraClient) GetDashboards(filter string, startAt, maxResults int) (*domain.DashboardPage, error) {
+	// Build query parameters
+	params := url.Values{}
+	if filter != "" {
+		params.Set("filter", filter)
+	}
+	if startAt > 0 {
+		params.Set("startAt", fmt.Sprintf("%d", startAt))
+	}
+	if maxResults > 0 {
+		params.Set("maxResults", fmt.Sprintf("%d", maxResults))
+	}
+
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/dashboard", c.baseURL)
+	if len(params) > 0 {
+		endpoint = endpoint + "?" + params.Encode()
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var page domain.DashboardPage
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if page.Dashboards == nil {
+		page.Dashboards = []domain.Dashboard{}
+	}
+	return &page, nil
+}
+
+// JiraDashboardGadgetsResponse represents the response from the dashboard gadget endpoint.
+type JiraDashboardGadgetsResponse struct {
+	Gadgets []domain.DashboardGadget `json:"gadgets"`
+}
+
+// GetDashboardGadgets retrieves the gadgets on a dashboard.
+func (c *JiraClient) GetDashboardGadgets(dashboardID string) ([]domain.DashboardGadget, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/dashboard/%s/gadget", c.baseURL, url.PathEscape(dashboardID))
+
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	var gadgets JiraDashboardGadgetsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&gadgets); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if gadgets.Gadgets == nil {
+		gadgets.Gadgets = []domain.DashboardGadget{}
+	}
+	return gadgets.Gadgets, nil
+}
+
+// JiraEntityPropertyKeysResponse represents the property keys of an entity.
+type JiraEntityPropertyKeysResponse struct {
+	Keys []struct {
+		Key string `json:"key"`
+	} `json:"keys"`
+}
+
+// GetDashboardItemProperties retrieves the configuration properties of a dashboard
+// item (gadget), keyed by property key.
+func (c *JiraClient) GetDashboardItemProperties(dashboardID, itemID string) (map[string]json.RawMessage, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/api/2/dashboard/%s/items/%s/properties", c.baseURL, url.PathEscape(dashboardID), url.PathEscape(itemID))
+
+	// List the property keys
+	var keys JiraEntityPropertyKeysResponse
+	if err := c.getJSON(endpoint, &keys); err != nil {
+		return nil, err
+	}
+
+	// Fetch each property
+	properties := make(map[string]json.RawMessage, len(keys.Keys))
+	for _, key := range keys.Keys {
+		var property struct {
+			Value json.RawMessage `json:"value"`
+		}
+		if err := c.getJSON(endpoint+"/"+url.PathEscape(key.Key), &property); err != nil {
+			return nil, err
+		}
+		properties[key.Key] = property.Value
+	}
+	return properties, nil
+}
+
+// getJSON retrieves endpoint and decodes the JSON response into out.
+func (c *JiraClient) getJSON(endpoint string, out interface{}) error {
+	// Create the HTTP request
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// serviceDeskPageParams returns the paging query parameters of Jira Service Management lists.
+func serviceDeskPageParams(start, limit int) url.Values {
+	params := url.Values{}
+	if start > 0 {
+		params.Set("start", fmt.Sprintf("%d", start))
+	}
+	if limit > 0 {
+		params.Set("limit", fmt.Sprintf("%d", limit))
+	}
+	return params
+}
+
+// serviceDeskRequest sends a request to the Jira Service Management API and decodes
+// the response into out. Some resources (e.g. queues) are experimental in older
+// versions, so the opt-in header is always sent.
+func (c *JiraClient) serviceDeskRequest(method, endpoint string, params url.Values, payload, out interface{}) error {
+	// Add query parameters to endpoint
+	if len(params) > 0 {
+		endpoint = endpoint + "?" + params.Encode()
+	}
+
+	// Marshal the request body
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	// Create the HTTP request
+	req, err := http.NewRequest(method, endpoint, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("X-ExperimentalApi", "opt-in")
+
+	// Execute the request
+	resp, err := c.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check for error status codes
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// Parse the response
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// GetServiceDesks retrieves a page of the service desks visible to the user.
+func (c *JiraClient) GetServiceDesks(start, limit int) (*domain.ServiceDeskList, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/servicedeskapi/servicedesk", c.baseURL)
+
+	// Call the Service Management API
+	var list domain.ServiceDeskList
+	if err := c.serviceDeskRequest("GET", endpoint, serviceDeskPageParams(start, limit), nil, &list); err != nil {
+		return nil, err
+	}
+
+	if list.Values == nil {
+		list.Values = []domain.ServiceDesk{}
+	}
+	return &list, nil
+}
+
+// GetRequestTypes retrieves a page of the request types of a service desk,
+// optionally filtered by a search query on their names.
+func (c *JiraClient) GetRequestTypes(serviceDeskID, query string, start, limit int) (*domain.RequestTypeList, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/servicedeskapi/servicedesk/%s/requesttype", c.baseURL, url.PathEscape(serviceDeskID))
+
+	// Build query parameters
+	params := serviceDeskPageParams(start, limit)
+	if query != "" {
+		params.Set("searchQuery", query)
+	}
+
+	// Call the Service Management API
+	var list domain.RequestTypeList
+	if err := c.serviceDeskRequest("GET", endpoint, params, nil, &list); err != nil {
+		return nil, err
+	}
+
+	if list.Values == nil {
+		list.Values = []domain.RequestType{}
+	}
+	return &list, nil
+}
+
+// GetRequestTypeFields retrieves the fields customers fill in for a request type.
+func (c *JiraClient) GetRequestTypeFields(serviceDeskID, requestTypeID string) (*domain.RequestTypeFields, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/servicedeskapi/servicedesk/%s/requesttype/%s/field", c.baseURL, url.PathEscape(serviceDeskID), url.PathEscape(requestTypeID))
+
+	// Call the Service Management API
+	var fields domain.RequestTypeFields
+	if err := c.serviceDeskRequest("GET", endpoint, nil, nil, &fields); err != nil {
+		return nil, err
+	}
+
+	if fields.RequestTypeFields == nil {
+		fields.RequestTypeFields = []domain.RequestTypeField{}
+	}
+	return &fields, nil
+}
+
+// CreateCustomerRequest raises a customer request on a service desk.
+func (c *JiraClient) CreateCustomerRequest(request *domain.CustomerRequestCreate) (*domain.CustomerRequest, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/servicedeskapi/request", c.baseURL)
+
+	// Call the Service Management API
+	var created domain.CustomerRequest
+	if err := c.serviceDeskRequest("POST", endpoint, nil, request, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// AddRequestComment adds a comment to a customer request. Public comments are
+// visible to the customer; internal comments only to agents.
+func (c *JiraClient) AddRequestComment(issueKey, body string, public bool) (*domain.RequestComment, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/servicedeskapi/request/%s/comment", c.baseURL, url.PathEscape(issueKey))
+
+	// Call the Service Management API
+	var comment domain.RequestComment
+	payload := map[string]interface{}{"body": body, "public": public}
+	if err := c.serviceDeskRequest("POST", endpoint, nil, payload, &comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// RequestCommentOptions contains options for listing the comments of a customer request.
+type RequestCommentOptions struct {
+	Public   bool // Include public comments
+	Internal bool // Include internal comments
+	Start    int  // The index of the first comment to return (0-based)
+	Limit    int  // The maximum number of comments to return
+}
+
+// GetRequestComments retrieves a page of the comments on a customer request.
+func (c *JiraClient) GetRequestComments(issueKey string, options *RequestCommentOptions) (*domain.RequestCommentList, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/servicedeskapi/request/%s/comment", c.baseURL, url.PathEscape(issueKey))
+
+	// Build query parameters
+	params := url.Values{}
+	if options != nil {
+		params = serviceDeskPageParams(options.Start, options.Limit)
+		params.Set("public", fmt.Sprintf("%t", options.Public))
+		params.Set("internal", fmt.Sprintf("%t", options.Internal))
+	}
+
+	// Call the Service Management API
+	var list domain.RequestCommentList
+	if err := c.serviceDeskRequest("GET", endpoint, params, nil, &list); err != nil {
+		return nil, err
+	}
+
+	if list.Values == nil {
+		list.Values = []domain.RequestComment{}
+	}
+	return &list, nil
+}
+
+// GetQueues retrieves a page of the queues of a service desk, with the number of
+// issues in each if includeCount is set.
+func (c *JiraClient) GetQueues(serviceDeskID string, includeCount bool, start, limit int) (*domain.QueueList, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/servicedeskapi/servicedesk/%s/queue", c.baseURL, url.PathEscape(serviceDeskID))
+
+	// Build query parameters
+	params := serviceDeskPageParams(start, limit)
+	if includeCount {
+		params.Set("includeCount", "true")
+	}
+
+	// Call the Service Management API
+	var list domain.QueueList
+	if err := c.serviceDeskRequest("GET", endpoint, params, nil, &list); err != nil {
+		return nil, err
+	}
+
+	if list.Values == nil {
+		list.Values = []domain.Queue{}
+	}
+	return &list, nil
+}
+
+// GetQueueIssues retrieves a page of the issues in a service desk queue.
+func (c *JiraClient) GetQueueIssues(serviceDeskID, queueID string, start, limit int) (*domain.QueueIssueList, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/servicedeskapi/servicedesk/%s/queue/%s/issue", c.baseURL, url.PathEscape(serviceDeskID), url.PathEscape(queueID))
+
+	// Call the Service Management API
+	var list domain.QueueIssueList
+	if err := c.serviceDeskRequest("GET", endpoint, serviceDeskPageParams(start, limit), nil, &list); err != nil {
+		return nil, err
+	}
+
+	if list.Values == nil {
+		list.Values = []domain.JiraIssue{}
+	}
+	return &list, nil
+}
+
+// GetRequestSLA retrieves the SLA metrics of a customer request.
+func (c *JiraClient) GetRequestSLA(issueKey string) (*domain.SLAList, error) {
+	// Construct the API endpoint
+	endpoint := fmt.Sprintf("%s/rest/servicedeskapi/request/%s/sla", c.baseURL, url.PathEscape(issueKey))
+
+	// Call the Service Management API
+	var list domain.SLAList
+	if err := c.serviceDeskRequest("GET", endpoint, nil, nil, &list); err != nil {
+		return nil, err
+	}
+
+	if list.Values == nil {
+		list.Values = []domain.SLA{}
+	}
+	for i := range list.Values {
+		if list.Values[i].CompletedCycles == nil {
+			list.Values[i].CompletedCycles = []domain.SLACycle{}
+		}
+	}
+	return &list, nil
+}
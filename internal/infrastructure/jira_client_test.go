@@ -2,8 +2,12 @@ package infrastructure
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 
 	"atlassian-mcp-server/internal/domain"
@@ -942,3 +946,1582 @@ func containsHelper(s, substr string) bool {
 	}
 	return false
 }
+
+func TestJiraClient_WhoAmI(t *testing.T) {
+	var permissionsQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/rest/api/2/myself":
+			json.NewEncoder(w).Encode(domain.User{Name: "jdoe", DisplayName: "Jane Doe", EmailAddress: "jdoe@example.com"})
+		case "/rest/api/2/mypermissions":
+			permissionsQuery = r.URL.Query().Get("permissions")
+			w.Write([]byte(`{"permissions":{
+				"EDIT_ISSUES":{"key":"EDIT_ISSUES","havePermission":true},
+				"BROWSE_PROJECTS":{"key":"BROWSE_PROJECTS","havePermission":true},
+				"ADMINISTER":{"key":"ADMINISTER","havePermission":false}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	identity, err := client.WhoAmI()
+	if err != nil {
+		t.Fatalf("WhoAmI() error = %v", err)
+	}
+
+	if identity.Tool != "jira" || identity.Username != "jdoe" || identity.DisplayName != "Jane Doe" || identity.Email != "jdoe@example.com" {
+		t.Errorf("unexpected identity: %+v", identity)
+	}
+	if len(identity.Permissions) != 2 || identity.Permissions[0] != "BROWSE_PROJECTS" || identity.Permissions[1] != "EDIT_ISSUES" {
+		t.Errorf("Permissions = %v, want [BROWSE_PROJECTS EDIT_ISSUES]", identity.Permissions)
+	}
+	if !contains(permissionsQuery, "BROWSE_PROJECTS") {
+		t.Errorf("expected permissions query parameter, got %q", permissionsQuery)
+	}
+
+	// Rejected credentials surface as an API error
+	unauthenticated := NewJiraClient(server.URL, http.DefaultClient)
+	if _, err := unauthenticated.WhoAmI(); err == nil || !contains(err.Error(), "status 401") {
+		t.Errorf("WhoAmI() without credentials error = %v, want status 401", err)
+	}
+}
+
+func TestJiraClient_WhoAmI_PermissionsUnavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/myself":
+			json.NewEncoder(w).Encode(domain.User{Name: "jdoe", DisplayName: "Jane Doe"})
+		case "/rest/api/2/mypermissions":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	// The identity is still resolved, only without permissions
+	identity, err := NewJiraClient(server.URL, getAuthenticatedClient()).WhoAmI()
+	if err != nil {
+		t.Fatalf("WhoAmI() error = %v", err)
+	}
+	if identity.Username != "jdoe" || len(identity.Permissions) != 0 {
+		t.Errorf("unexpected identity: %+v", identity)
+	}
+}
+
+func TestJiraClient_GetFields(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/rest/api/2/field" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		requests++
+		w.Write([]byte(`[
+			{"id":"summary","name":"Summary","custom":false,"schema":{"type":"string","system":"summary"}},
+			{"id":"customfield_10002","name":"Story Points","custom":true,"schema":{"type":"number","custom":"com.atlassian.jira.plugin.system.customfieldtypes:float","customId":10002}}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+
+	// CachedFields fetches on first use
+	fields, err := client.CachedFields()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(fields) != 2 {
+		t.Fatalf("Expected 2 fields, got %d", len(fields))
+	}
+	if !fields[1].Custom || fields[1].Schema == nil || fields[1].Schema.CustomID != 10002 {
+		t.Errorf("Unexpected custom field: %+v", fields[1])
+	}
+
+	// Subsequent calls use the cache
+	if _, err := client.CachedFields(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+
+	// GetFields always refreshes
+	if _, err := client.GetFields(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+}
+
+func TestJiraClient_GetIssue_CustomFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"10001","key":"TEST-1","fields":{"summary":"Test","customfield_10002":8,"labels":["api"]}}`))
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	issue, err := client.GetIssue("TEST-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if string(issue.Fields.Extra["customfield_10002"]) != "8" {
+		t.Errorf("Expected story points to be preserved, got %v", issue.Fields.Extra)
+	}
+	if string(issue.Fields.Extra["labels"]) != `["api"]` {
+		t.Errorf("Expected labels to be preserved, got %v", issue.Fields.Extra)
+	}
+}
+
+func TestJiraClient_GetCreateMetaIssueTypes(t *testing.T) {
+	var startAts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/issue/createmeta/TEST/issuetypes" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		startAt := r.URL.Query().Get("startAt")
+		startAts = append(startAts, startAt)
+		if startAt == "0" {
+			w.Write([]byte(`{"startAt":0,"maxResults":1,"total":2,"isLast":false,"values":[{"id":"1","name":"Bug","subtask":false}]}`))
+			return
+		}
+		w.Write([]byte(`{"startAt":1,"maxResults":1,"total":2,"isLast":true,"values":[{"id":"5","name":"Sub-task","subtask":true}]}`))
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	issueTypes, err := client.GetCreateMetaIssueTypes("TEST")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(issueTypes) != 2 || issueTypes[0].Name != "Bug" || !issueTypes[1].Subtask {
+		t.Errorf("Unexpected issue types: %+v", issueTypes)
+	}
+	if len(startAts) != 2 || startAts[1] != "1" {
+		t.Errorf("Expected two pages, got requests with startAt %v", startAts)
+	}
+
+	// Unknown project surfaces the API error
+	if _, err := client.GetCreateMetaIssueTypes("NOPE"); err == nil || !contains(err.Error(), "status 404") {
+		t.Errorf("Expected 404 error, got %v", err)
+	}
+}
+
+func TestJiraClient_GetCreateMetaFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/issue/createmeta/TEST/issuetypes/10001" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"startAt":0,"maxResults":100,"total":2,"isLast":true,"values":[
+			{"fieldId":"summary","name":"Summary","required":true,"hasDefaultValue":false,"schema":{"type":"string","system":"summary"}},
+			{"fieldId":"priority","name":"Priority","required":true,"hasDefaultValue":true,"schema":{"type":"priority","system":"priority"},"allowedValues":[{"id":"1","name":"High"}]}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	fields, err := client.GetCreateMetaFields("TEST", "10001")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(fields) != 2 {
+		t.Fatalf("Expected 2 fields, got %d", len(fields))
+	}
+	if fields[1].FieldID != "priority" || !fields[1].HasDefaultValue || fields[1].AllowedValues[0].Name != "High" {
+		t.Errorf("Unexpected field: %+v", fields[1])
+	}
+}
+
+func TestJiraClient_GetTransitions(t *testing.T) {
+	var expand string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/rest/api/2/issue/TEST-123/transitions" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		expand = r.URL.Query().Get("expand")
+		w.Write([]byte(`{"expand":"transitions","transitions":[
+			{"id":"21","name":"Resolve","to":{"id":"5","name":"Done"},"hasScreen":true,
+			 "fields":{"resolution":{"required":true,"name":"Resolution","allowedValues":[{"id":"1","name":"Fixed"}]}}}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	transitions, err := client.GetTransitions("TEST-123")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expand != "transitions.fields" {
+		t.Errorf("Expected transitions.fields expansion, got %q", expand)
+	}
+	if len(transitions) != 1 || transitions[0].To.Name != "Done" || !transitions[0].Fields["resolution"].Required {
+		t.Errorf("Unexpected transitions: %+v", transitions)
+	}
+}
+
+func TestJiraClient_TransitionIssue_WithFields(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	err := client.TransitionIssue("TEST-123", &domain.IssueTransition{
+		Transition: domain.TransitionRef{ID: "21"},
+		Fields:     map[string]interface{}{"resolution": map[string]interface{}{"name": "Fixed"}},
+		Update: map[string][]map[string]interface{}{
+			"comment": {{"add": map[string]interface{}{"body": "Done"}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if payload["fields"].(map[string]interface{})["resolution"].(map[string]interface{})["name"] != "Fixed" {
+		t.Errorf("Expected resolution in payload, got %v", payload)
+	}
+	comment := payload["update"].(map[string]interface{})["comment"].([]interface{})[0]
+	if comment.(map[string]interface{})["add"].(map[string]interface{})["body"] != "Done" {
+		t.Errorf("Expected comment in payload, got %v", payload)
+	}
+}
+
+func TestJiraClient_GetComments(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/rest/api/2/issue/TEST-123/comment" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query = r.URL.Query()
+		w.Write([]byte(`{"startAt":10,"maxResults":5,"total":12,"comments":[
+			{"id":"100","body":"First","author":{"name":"jdoe"},"created":"2024-01-01T10:00:00.000+0000"},
+			{"id":"101","body":"Second","visibility":{"type":"group","value":"staff"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	page, err := client.GetComments("TEST-123", &CommentOptions{StartAt: 10, MaxResults: 5, OrderBy: "-created"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if query.Get("startAt") != "10" || query.Get("maxResults") != "5" || query.Get("orderBy") != "-created" {
+		t.Errorf("Unexpected query: %v", query)
+	}
+	if page.Total != 12 || len(page.Comments) != 2 || page.Comments[1].Visibility.Value != "staff" {
+		t.Errorf("Unexpected page: %+v", page)
+	}
+}
+
+func TestJiraClient_RenderedText(t *testing.T) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		switch r.URL.Path {
+		case "/rest/api/2/issue/TEST-123":
+			w.Write([]byte(`{"key":"TEST-123","fields":{"description":"*bold*"},"renderedFields":{"description":"<b>bold</b>"}}`))
+		case "/rest/api/2/issue/TEST-123/comment":
+			w.Write([]byte(`{"total":1,"comments":[{"id":"100","body":"_x_","renderedBody":"<em>x</em>"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	issue, err := client.GetIssue("TEST-123", "renderedFields", "names")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if queries[0].Get("expand") != "renderedFields,names" || string(issue.RenderedFields["description"]) != `"<b>bold</b>"` {
+		t.Errorf("Unexpected issue: %v %+v", queries[0], issue)
+	}
+
+	page, err := client.GetComments("TEST-123", &CommentOptions{Expand: "renderedBody"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if queries[1].Get("expand") != "renderedBody" || page.Comments[0].RenderedBody != "<em>x</em>" {
+		t.Errorf("Unexpected comments: %v %+v", queries[1], page)
+	}
+}
+
+func TestJiraClient_UpdateAndDeleteComment(t *testing.T) {
+	var updateBody map[string]interface{}
+	deleted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "PUT" && r.URL.Path == "/rest/api/2/issue/TEST-123/comment/100":
+			json.NewDecoder(r.Body).Decode(&updateBody)
+			w.Write([]byte(`{"id":"100","body":"Edited","updated":"2024-01-03T10:00:00.000+0000"}`))
+		case r.Method == "DELETE" && r.URL.Path == "/rest/api/2/issue/TEST-123/comment/100":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	comment, err := client.UpdateComment("TEST-123", "100", &domain.Comment{Body: "Edited"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if comment.Body != "Edited" || comment.Updated == "" || updateBody["body"] != "Edited" {
+		t.Errorf("Unexpected update: %+v (sent %v)", comment, updateBody)
+	}
+
+	if err := client.DeleteComment("TEST-123", "100"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !deleted {
+		t.Error("Expected comment to be deleted")
+	}
+
+	if err := client.DeleteComment("TEST-123", "999"); err == nil || !contains(err.Error(), "status 404") {
+		t.Errorf("Expected 404 error, got %v", err)
+	}
+}
+
+func TestJiraClient_IssueLinks(t *testing.T) {
+	var created map[string]interface{}
+	deleted := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/rest/api/2/issueLinkType":
+			w.Write([]byte(`{"issueLinkTypes":[{"id":"10000","name":"Blocks","inward":"is blocked by","outward":"blocks"}]}`))
+		case r.Method == "POST" && r.URL.Path == "/rest/api/2/issueLink":
+			json.NewDecoder(r.Body).Decode(&created)
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == "DELETE" && r.URL.Path == "/rest/api/2/issueLink/20000":
+			deleted = "20000"
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+
+	linkTypes, err := client.GetIssueLinkTypes()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(linkTypes) != 1 || linkTypes[0].Outward != "blocks" {
+		t.Errorf("Unexpected link types: %+v", linkTypes)
+	}
+
+	err = client.CreateIssueLink(&domain.IssueLinkRequest{
+		Type:         domain.IssueLinkTypeRef{Name: "Blocks"},
+		InwardIssue:  domain.IssueRef{Key: "TEST-1"},
+		OutwardIssue: domain.IssueRef{Key: "TEST-2"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if created["inwardIssue"].(map[string]interface{})["key"] != "TEST-1" {
+		t.Errorf("Unexpected link payload: %v", created)
+	}
+
+	if err := client.DeleteIssueLink("20000"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if deleted != "20000" {
+		t.Error("Expected link to be deleted")
+	}
+	if err := client.DeleteIssueLink("1"); err == nil {
+		t.Error("Expected error for unknown link")
+	}
+}
+
+func TestJiraClient_Attachments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/rest/api/2/issue/TEST-123" && r.URL.Query().Get("fields") == "attachment":
+			w.Write([]byte(`{"fields":{"attachment":[{"id":"1","filename":"log.txt","size":5,"mimeType":"text/plain"}]}}`))
+		case r.URL.Path == "/rest/api/2/issue/TEST-124":
+			w.Write([]byte(`{"fields":{}}`))
+		case r.URL.Path == "/rest/api/2/attachment/1":
+			w.Write([]byte(`{"id":"1","filename":"my log.txt","size":5,"mimeType":"text/plain"}`))
+		case r.URL.EscapedPath() == "/secure/attachment/1/my%20log.txt":
+			if r.Header.Get("Accept") != "*/*" {
+				w.WriteHeader(http.StatusNotAcceptable)
+				return
+			}
+			w.Write([]byte("hello"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+
+	attachments, err := client.GetAttachments("TEST-123")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(attachments) != 1 || attachments[0].Filename != "log.txt" {
+		t.Errorf("Unexpected attachments: %+v", attachments)
+	}
+
+	attachments, err = client.GetAttachments("TEST-124")
+	if err != nil || attachments == nil || len(attachments) != 0 {
+		t.Errorf("Expected empty attachment list, got %v (%v)", attachments, err)
+	}
+
+	attachment, err := client.GetAttachment("1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	content, err := client.DownloadAttachment(attachment, 5)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if string(content) != "hello" {
+		t.Errorf("Expected content 'hello', got %q", content)
+	}
+
+	if _, err := client.DownloadAttachment(attachment, 4); err == nil || !contains(err.Error(), "larger than 4 bytes") {
+		t.Errorf("Expected size limit error, got %v", err)
+	}
+}
+
+func TestJiraClient_AddAttachment(t *testing.T) {
+	var token, filename, partType string
+	var content []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/api/2/issue/TEST-123/attachments" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		token = r.Header.Get("X-Atlassian-Token")
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+		filename = header.Filename
+		partType = header.Header.Get("Content-Type")
+		content, _ = io.ReadAll(file)
+		w.Write([]byte(`[{"id":"2","filename":"report.json","size":13,"mimeType":"application/json"}]`))
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	attachments, err := client.AddAttachment("TEST-123", "report.json", []byte(`{"ok": true}`+"\n"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if token != "no-check" {
+		t.Errorf("Expected X-Atlassian-Token no-check, got %q", token)
+	}
+	if filename != "report.json" || partType != "application/json" || string(content) != "{\"ok\": true}\n" {
+		t.Errorf("Unexpected upload: %q %q %q", filename, partType, content)
+	}
+	if len(attachments) != 1 || attachments[0].ID != "2" {
+		t.Errorf("Unexpected attachments: %+v", attachments)
+	}
+}
+
+func TestJiraClient_Worklogs(t *testing.T) {
+	var addQuery, deleteQuery url.Values
+	var added, updated map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/rest/api/2/issue/TEST-123/worklog":
+			w.Write([]byte(`{"startAt":0,"maxResults":1,"total":1,"worklogs":[
+				{"id":"10100","author":{"name":"jdoe"},"timeSpent":"1h","timeSpentSeconds":3600,"started":"2024-01-15T09:00:00.000+0000"}
+			]}`))
+		case r.Method == "POST" && r.URL.Path == "/rest/api/2/issue/TEST-123/worklog":
+			addQuery = r.URL.Query()
+			json.NewDecoder(r.Body).Decode(&added)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"10101","timeSpent":"2h","timeSpentSeconds":7200}`))
+		case r.Method == "PUT" && r.URL.Path == "/rest/api/2/issue/TEST-123/worklog/10101":
+			json.NewDecoder(r.Body).Decode(&updated)
+			w.Write([]byte(`{"id":"10101","timeSpent":"3h","comment":"Edited"}`))
+		case r.Method == "DELETE" && r.URL.Path == "/rest/api/2/issue/TEST-123/worklog/10101":
+			deleteQuery = r.URL.Query()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+
+	page, err := client.GetWorklogs("TEST-123")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(page.Worklogs) != 1 || page.Worklogs[0].ID != "10100" || page.Worklogs[0].TimeSpentSeconds != 3600 {
+		t.Errorf("Unexpected worklogs: %+v", page)
+	}
+
+	worklog, err := client.AddWorklog("TEST-123", &domain.Worklog{
+		TimeSpent: "2h",
+		Started:   "2024-01-16T09:00:00.000+0000",
+	}, &WorklogOptions{AdjustEstimate: "manual", ReduceBy: "1h"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if worklog.ID != "10101" || added["timeSpent"] != "2h" || added["started"] != "2024-01-16T09:00:00.000+0000" {
+		t.Errorf("Unexpected add: %+v (sent %v)", worklog, added)
+	}
+	if addQuery.Get("adjustEstimate") != "manual" || addQuery.Get("reduceBy") != "1h" {
+		t.Errorf("Unexpected add query: %v", addQuery)
+	}
+
+	worklog, err = client.UpdateWorklog("TEST-123", "10101", &domain.Worklog{Comment: "Edited"}, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if worklog.Comment != "Edited" || updated["comment"] != "Edited" || updated["timeSpent"] != nil {
+		t.Errorf("Unexpected update: %+v (sent %v)", worklog, updated)
+	}
+
+	if err := client.DeleteWorklog("TEST-123", "10101", &WorklogOptions{AdjustEstimate: "leave"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if deleteQuery.Get("adjustEstimate") != "leave" {
+		t.Errorf("Unexpected delete query: %v", deleteQuery)
+	}
+
+	if err := client.DeleteWorklog("TEST-123", "999", nil); err == nil || !contains(err.Error(), "status 404") {
+		t.Errorf("Expected 404 error, got %v", err)
+	}
+}
+
+func TestJiraClient_GetTimeTracking(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/rest/api/2/issue/TEST-123" && r.URL.Query().Get("fields") == "timetracking":
+			w.Write([]byte(`{"key":"TEST-123","fields":{"timetracking":{
+				"originalEstimate":"3d","remainingEstimate":"1d 4h","timeSpent":"1d 4h",
+				"originalEstimateSeconds":86400,"remainingEstimateSeconds":43200,"timeSpentSeconds":43200
+			}}}`))
+		case r.URL.Path == "/rest/api/2/issue/TEST-124":
+			w.Write([]byte(`{"key":"TEST-124","fields":{}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+
+	timeTracking, err := client.GetTimeTracking("TEST-123")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if timeTracking.OriginalEstimate != "3d" || timeTracking.RemainingEstimateSeconds != 43200 {
+		t.Errorf("Unexpected time tracking: %+v", timeTracking)
+	}
+
+	timeTracking, err = client.GetTimeTracking("TEST-124")
+	if err != nil || timeTracking == nil || timeTracking.OriginalEstimate != "" {
+		t.Errorf("Expected empty time tracking, got %+v (%v)", timeTracking, err)
+	}
+}
+
+func TestJiraClient_BoardsAndSprints(t *testing.T) {
+	var boardQuery, sprintQuery, issueQuery url.Values
+	var sprintUpdate map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/rest/agile/1.0/board":
+			boardQuery = r.URL.Query()
+			w.Write([]byte(`{"startAt":0,"maxResults":50,"total":1,"isLast":true,"values":[
+				{"id":7,"name":"TEST board","type":"scrum","location":{"projectKey":"TEST"}}
+			]}`))
+		case r.Method == "GET" && r.URL.Path == "/rest/agile/1.0/board/7/sprint":
+			sprintQuery = r.URL.Query()
+			w.Write([]byte(`{"startAt":0,"maxResults":50,"isLast":true,"values":[
+				{"id":42,"name":"Sprint 1","state":"active","originBoardId":7}
+			]}`))
+		case r.Method == "GET" && r.URL.Path == "/rest/agile/1.0/sprint/42":
+			w.Write([]byte(`{"id":42,"name":"Sprint 1","state":"future","endDate":"2024-01-29T09:00:00.000Z"}`))
+		case r.Method == "POST" && r.URL.Path == "/rest/agile/1.0/sprint/42":
+			json.NewDecoder(r.Body).Decode(&sprintUpdate)
+			w.Write([]byte(`{"id":42,"name":"Sprint 1","state":"active"}`))
+		case r.Method == "GET" && r.URL.Path == "/rest/agile/1.0/sprint/42/issue":
+			issueQuery = r.URL.Query()
+			w.Write([]byte(`{"startAt":0,"maxResults":50,"total":1,"issues":[{"key":"TEST-1","fields":{"summary":"Story"}}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+
+	boards, err := client.GetBoards(&BoardOptions{Type: "scrum", ProjectKeyOrID: "TEST"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if boardQuery.Get("type") != "scrum" || boardQuery.Get("projectKeyOrId") != "TEST" {
+		t.Errorf("Unexpected board query: %v", boardQuery)
+	}
+	if len(boards.Values) != 1 || boards.Values[0].ID != 7 {
+		t.Errorf("Unexpected boards: %+v", boards)
+	}
+
+	sprints, err := client.GetSprints(7, &SprintOptions{States: []string{"active", "future"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if sprintQuery.Get("state") != "active,future" {
+		t.Errorf("Unexpected sprint query: %v", sprintQuery)
+	}
+	if len(sprints.Values) != 1 || sprints.Values[0].State != "active" {
+		t.Errorf("Unexpected sprints: %+v", sprints)
+	}
+
+	sprint, err := client.GetSprint(42)
+	if err != nil || sprint.EndDate == "" {
+		t.Fatalf("Unexpected sprint: %+v (%v)", sprint, err)
+	}
+
+	sprint, err = client.UpdateSprint(42, &domain.SprintUpdate{State: domain.SprintStateActive})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if sprint.State != "active" || sprintUpdate["state"] != "active" || len(sprintUpdate) != 1 {
+		t.Errorf("Unexpected update: %+v (sent %v)", sprint, sprintUpdate)
+	}
+
+	issues, err := client.GetSprintIssues(42, &SearchOptions{JQL: "assignee = jdoe", Fields: []string{"summary", "status"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if issueQuery.Get("jql") != "assignee = jdoe" || issueQuery.Get("fields") != "summary,status" {
+		t.Errorf("Unexpected issue query: %v", issueQuery)
+	}
+	if len(issues.Issues) != 1 || issues.Issues[0].Key != "TEST-1" {
+		t.Errorf("Unexpected issues: %+v", issues)
+	}
+}
+
+func TestJiraClient_MoveIssues_Batches(t *testing.T) {
+	var sprintBatches, backlogBatches []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Issues []string `json:"issues"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/rest/agile/1.0/sprint/42/issue":
+			sprintBatches = append(sprintBatches, len(body.Issues))
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "POST" && r.URL.Path == "/rest/agile/1.0/backlog/issue":
+			backlogBatches = append(backlogBatches, len(body.Issues))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+
+	keys := make([]string, 120)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("TEST-%d", i+1)
+	}
+	if err := client.MoveIssuesToSprint(42, keys); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(sprintBatches) != 3 || sprintBatches[0] != 50 || sprintBatches[2] != 20 {
+		t.Errorf("Unexpected batches: %v", sprintBatches)
+	}
+
+	if err := client.MoveIssuesToBacklog(keys[:2]); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(backlogBatches) != 1 || backlogBatches[0] != 2 {
+		t.Errorf("Unexpected batches: %v", backlogBatches)
+	}
+
+	if err := client.MoveIssuesToSprint(99, keys[:1]); err == nil || !contains(err.Error(), "status 404") {
+		t.Errorf("Expected 404 error, got %v", err)
+	}
+}
+
+func TestJiraClient_RankIssues(t *testing.T) {
+	var requests []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/rest/agile/1.0/issue/rank" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		requests = append(requests, body)
+		if body["rankBeforeIssue"] == "TEST-999" {
+			w.WriteHeader(http.StatusMultiStatus)
+			w.Write([]byte(`{"entries":[
+				{"issueId":1,"issueKey":"TEST-1","status":200},
+				{"issueId":2,"issueKey":"TEST-2","status":400,"errors":["Issue is not on the board"]}
+			]}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+
+	keys := make([]string, 60)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("TEST-%d", i+1)
+	}
+	if err := client.RankIssues(&domain.RankRequest{Issues: keys, RankBeforeIssue: "TEST-100"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("Expected 2 batches, got %d", len(requests))
+	}
+	if requests[0]["rankBeforeIssue"] != "TEST-100" || requests[1]["rankAfterIssue"] != "TEST-50" || requests[1]["rankBeforeIssue"] != nil {
+		t.Errorf("Expected the second batch to follow the first: %v", requests[1])
+	}
+
+	err := client.RankIssues(&domain.RankRequest{Issues: []string{"TEST-1", "TEST-2"}, RankBeforeIssue: "TEST-999"})
+	if err == nil || !contains(err.Error(), "TEST-2: Issue is not on the board") || contains(err.Error(), "TEST-1:") {
+		t.Errorf("Expected partial failure naming TEST-2, got %v", err)
+	}
+}
+
+func TestJiraClient_Versions(t *testing.T) {
+	var created, updated map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/rest/api/2/project/TEST":
+			w.Write([]byte(`{"id":"10000","key":"TEST","name":"Test","versions":[{"id":"10001","name":"1.0"}],"components":[{"id":"10100","name":"API"}]}`))
+		case r.Method == "GET" && r.URL.Path == "/rest/api/2/project/TEST/versions":
+			w.Write([]byte(`[{"id":"10001","name":"1.0","released":true},{"id":"10002","name":"1.1"}]`))
+		case r.Method == "POST" && r.URL.Path == "/rest/api/2/version":
+			json.NewDecoder(r.Body).Decode(&created)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"10003","name":"2.0"}`))
+		case r.Method == "PUT" && r.URL.Path == "/rest/api/2/version/10002":
+			json.NewDecoder(r.Body).Decode(&updated)
+			w.Write([]byte(`{"id":"10002","name":"1.1","released":true}`))
+		case r.Method == "GET" && r.URL.Path == "/rest/api/2/version/10002/relatedIssueCounts":
+			w.Write([]byte(`{"issuesFixedCount":12,"issuesAffectedCount":3}`))
+		case r.Method == "GET" && r.URL.Path == "/rest/api/2/version/10002/unresolvedIssueCount":
+			w.Write([]byte(`{"issuesUnresolvedCount":4}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+
+	project, err := client.GetProject("TEST")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(project.Versions) != 1 || len(project.Components) != 1 {
+		t.Errorf("Unexpected project: %+v", project)
+	}
+
+	versions, err := client.GetProjectVersions("TEST")
+	if err != nil || len(versions) != 2 || !versions[0].Released {
+		t.Errorf("Unexpected versions: %+v (%v)", versions, err)
+	}
+
+	version, err := client.CreateVersion(&domain.JiraVersionRequest{Project: "TEST", Name: "2.0"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if version.ID != "10003" || created["project"] != "TEST" || created["name"] != "2.0" {
+		t.Errorf("Unexpected create: %+v (sent %v)", version, created)
+	}
+
+	released := true
+	version, err = client.UpdateVersion("10002", &domain.JiraVersionRequest{Released: &released})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !version.Released || updated["released"] != true || len(updated) != 1 {
+		t.Errorf("Unexpected update: %+v (sent %v)", version, updated)
+	}
+
+	counts, err := client.GetVersionIssueCounts("10002")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if counts.IssuesFixedCount != 12 || counts.IssuesAffectedCount != 3 || counts.IssuesUnresolvedCount != 4 || counts.VersionID != "10002" {
+		t.Errorf("Unexpected counts: %+v", counts)
+	}
+
+	if _, err := client.GetVersionIssueCounts("999"); err == nil || !contains(err.Error(), "status 404") {
+		t.Errorf("Expected 404 error, got %v", err)
+	}
+}
+
+func TestJiraClient_Components(t *testing.T) {
+	var created map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/rest/api/2/project/TEST/components":
+			w.Write([]byte(`[{"id":"10100","name":"API","lead":{"name":"jdoe"},"assigneeType":"COMPONENT_LEAD"}]`))
+		case r.Method == "POST" && r.URL.Path == "/rest/api/2/component":
+			json.NewDecoder(r.Body).Decode(&created)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"10101","name":"UI","project":"TEST"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+
+	components, err := client.GetProjectComponents("TEST")
+	if err != nil || len(components) != 1 || components[0].Lead.Name != "jdoe" {
+		t.Errorf("Unexpected components: %+v (%v)", components, err)
+	}
+
+	component, err := client.CreateComponent(&domain.ComponentRequest{Name: "UI", Project: "TEST", LeadUserName: "asmith"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if component.ID != "10101" || created["leadUserName"] != "asmith" || created["project"] != "TEST" {
+		t.Errorf("Unexpected create: %+v (sent %v)", component, created)
+	}
+}
+
+func TestJiraClient_GetChangelog(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/rest/api/2/issue/TEST-123/changelog" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query = r.URL.Query()
+		w.Write([]byte(`{"startAt":1,"maxResults":1,"total":3,"values":[
+			{"id":"101","author":{"name":"jdoe"},"created":"2024-01-02T10:00:00.000+0000","items":[{"field":"status","fromString":"Open","toString":"Done"}]}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	changelog, err := client.GetChangelog("TEST-123", 1, 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if query.Get("startAt") != "1" || query.Get("maxResults") != "1" {
+		t.Errorf("Unexpected query: %v", query)
+	}
+	if changelog.Total != 3 || len(changelog.Histories) != 1 || changelog.Histories[0].Items[0].ToString != "Done" {
+		t.Errorf("Unexpected changelog: %+v", changelog)
+	}
+}
+
+func TestJiraClient_GetChangelog_ExpandFallback(t *testing.T) {
+	var expand string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/rest/api/2/issue/TEST-123":
+			expand = r.URL.Query().Get("expand")
+			w.Write([]byte(`{"key":"TEST-123","fields":{},"changelog":{"startAt":0,"maxResults":3,"total":3,"histories":[
+				{"id":"100","created":"2024-01-01T10:00:00.000+0000","items":[]},
+				{"id":"101","created":"2024-01-02T10:00:00.000+0000","items":[]},
+				{"id":"102","created":"2024-01-03T10:00:00.000+0000","items":[]}
+			]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	changelog, err := client.GetChangelog("TEST-123", 1, 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if expand != "changelog" {
+		t.Errorf("Expected expand=changelog, got %q", expand)
+	}
+	if changelog.Total != 3 || changelog.StartAt != 1 || len(changelog.Histories) != 1 || changelog.Histories[0].ID != "101" {
+		t.Errorf("Unexpected changelog page: %+v", changelog)
+	}
+
+	changelog, err = client.GetChangelog("TEST-123", 5, 10)
+	if err != nil || len(changelog.Histories) != 0 || changelog.Histories == nil {
+		t.Errorf("Expected empty page past the end, got %+v (%v)", changelog, err)
+	}
+}
+
+func TestJiraClient_Watchers(t *testing.T) {
+	var requests []string
+	var added string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		if r.URL.Path != "/rest/api/2/issue/TEST-123/watchers" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(`{"watchCount":2,"isWatching":true,"watchers":[{"name":"jdoe"},{"name":"asmith"}]}`))
+		case "POST":
+			json.NewDecoder(r.Body).Decode(&added)
+			w.WriteHeader(http.StatusNoContent)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	watchers, err := client.GetWatchers("TEST-123")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if watchers.WatchCount != 2 || !watchers.IsWatching || len(watchers.Watchers) != 2 || watchers.Watchers[1].Name != "asmith" {
+		t.Errorf("Unexpected watchers: %+v", watchers)
+	}
+
+	if err := client.AddWatcher("TEST-123", "bob"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if added != "bob" {
+		t.Errorf("Expected watcher bob to be added, got %q", added)
+	}
+
+	if err := client.RemoveWatcher("TEST-123", "bob smith"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if last := requests[len(requests)-1]; last != "DELETE /rest/api/2/issue/TEST-123/watchers?username=bob+smith" {
+		t.Errorf("Unexpected delete request: %s", last)
+	}
+}
+
+func TestJiraClient_VotesAndNotify(t *testing.T) {
+	var requests []string
+	var notification map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.URL.Path == "/rest/api/2/issue/TEST-123/votes" && r.Method == "GET":
+			w.Write([]byte(`{"votes":1,"hasVoted":false,"voters":[{"name":"jdoe"}]}`))
+		case r.URL.Path == "/rest/api/2/issue/TEST-123/votes":
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/rest/api/2/issue/TEST-123/notify" && r.Method == "POST":
+			json.NewDecoder(r.Body).Decode(&notification)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	votes, err := client.GetVotes("TEST-123")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if votes.Votes != 1 || votes.HasVoted || len(votes.Voters) != 1 {
+		t.Errorf("Unexpected votes: %+v", votes)
+	}
+	if err := client.AddVote("TEST-123"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := client.RemoveVote("TEST-123"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	err = client.Notify("TEST-123", &domain.Notification{
+		Subject:  "Heads up",
+		TextBody: "Please review",
+		To:       domain.NotificationRecipients{Watchers: true, Users: []domain.UserRef{{Name: "jdoe"}}},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if notification["subject"] != "Heads up" || notification["textBody"] != "Please review" {
+		t.Errorf("Unexpected notification: %v", notification)
+	}
+	to, _ := notification["to"].(map[string]interface{})
+	if to["watchers"] != true || len(to["users"].([]interface{})) != 1 {
+		t.Errorf("Unexpected recipients: %v", to)
+	}
+
+	expected := []string{
+		"GET /rest/api/2/issue/TEST-123/votes",
+		"POST /rest/api/2/issue/TEST-123/votes",
+		"DELETE /rest/api/2/issue/TEST-123/votes",
+		"POST /rest/api/2/issue/TEST-123/notify",
+	}
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Errorf("Unexpected requests: %v", requests)
+	}
+}
+
+func TestJiraClient_SearchUsers(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RequestURI())
+		switch r.URL.Path {
+		case "/rest/api/2/user/search":
+			w.Write([]byte(`[{"name":"jdoe","displayName":"John Doe","emailAddress":"john@example.com"}]`))
+		case "/rest/api/2/user/assignable/search":
+			w.Write([]byte(`[]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	users, err := client.SearchUsers("john@example.com", 10)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(users) != 1 || users[0].Name != "jdoe" {
+		t.Errorf("Unexpected users: %+v", users)
+	}
+
+	users, err = client.GetAssignableUsers(&AssignableUserOptions{IssueKey: "TEST-1", ProjectKey: "TEST", Query: "john"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if users == nil || len(users) != 0 {
+		t.Errorf("Expected empty user list, got %+v", users)
+	}
+
+	expected := []string{
+		"/rest/api/2/user/search?maxResults=10&username=john%40example.com",
+		"/rest/api/2/user/assignable/search?issueKey=TEST-1&username=john",
+	}
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Errorf("Unexpected requests: %v", requests)
+	}
+}
+
+func TestJiraClient_GetGroupMembers(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/group/member" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query = r.URL.Query()
+		w.Write([]byte(`{"startAt":0,"maxResults":50,"total":1,"isLast":true,"values":[{"name":"jdoe"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	members, err := client.GetGroupMembers("jira-developers", true, 0, 50)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if query.Get("groupname") != "jira-developers" || query.Get("includeInactiveUsers") != "true" || query.Get("maxResults") != "50" {
+		t.Errorf("Unexpected query: %v", query)
+	}
+	if members.Group != "jira-developers" || members.Total != 1 || !members.IsLast || members.Values[0].Name != "jdoe" {
+		t.Errorf("Unexpected members: %+v", members)
+	}
+}
+
+func TestJiraClient_SearchJQL_FieldsAndExpand(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"total":0,"issues":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	_, err := client.SearchJQL("project = TEST", &SearchOptions{
+		Fields: []string{"summary", "status"},
+		Expand: []string{"names", "changelog"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if fields := query["fields"]; len(fields) != 2 || fields[0] != "summary" {
+		t.Errorf("Unexpected fields: %v", fields)
+	}
+	if query.Get("expand") != "names,changelog" {
+		t.Errorf("Unexpected expand: %q", query.Get("expand"))
+	}
+}
+
+func TestJiraClient_ValidateJQL(t *testing.T) {
+	var received map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/api/2/jql/parse" || r.URL.Query().Get("validation") != "strict" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&received)
+		w.Write([]byte(`{"queries":[
+			{"query":"project = TEST","structure":{}},
+			{"query":"stauts = Open","errors":["Field 'stauts' does not exist or you do not have permission to view it."]}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	results, err := client.ValidateJQL([]string{"project = TEST", "stauts = Open"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(received["queries"]) != 2 {
+		t.Errorf("Unexpected request body: %v", received)
+	}
+	if len(results) != 2 || !results[0].Valid || results[1].Valid || !strings.Contains(results[1].Errors[0], "stauts") {
+		t.Errorf("Unexpected results: %+v", results)
+	}
+}
+
+func TestJiraClient_ValidateJQL_SearchFallback(t *testing.T) {
+	var searches []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/search" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query := r.URL.Query()
+		searches = append(searches, query)
+		if query.Get("jql") == "project = TEST" {
+			w.Write([]byte(`{"total":4,"issues":[]}`))
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"errorMessages":["Error in the JQL Query: Expecting operator but got 'Open'."],"errors":{}}`))
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	results, err := client.ValidateJQL([]string{"project = TEST", "status Open"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(searches) != 2 || searches[0].Get("validateQuery") != "strict" || searches[0].Get("maxResults") != "0" {
+		t.Errorf("Unexpected searches: %v", searches)
+	}
+	if !results[0].Valid || results[1].Valid || !strings.Contains(results[1].Errors[0], "Expecting operator") {
+		t.Errorf("Unexpected results: %+v", results)
+	}
+}
+
+func TestJiraClient_JQLAutocomplete(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/jql/autocompletedata":
+			w.Write([]byte(`{"visibleFieldNames":[{"value":"status","displayName":"status","orderable":"true","operators":["=","in"]},
+				{"value":"cf[10002]","displayName":"Story Points - cf[10002]","cfid":"cf[10002]"}],
+				"visibleFunctionNames":[{"value":"currentUser()","displayName":"currentUser()"}],
+				"jqlReservedWords":["and","or"]}`))
+		case "/rest/api/2/jql/autocompletedata/suggestions":
+			query = r.URL.Query()
+			w.Write([]byte(`{"results":[{"value":"\"In Progress\"","displayName":"<b>In</b> Progress"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	data, err := client.GetJQLAutocompleteData()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(data.VisibleFieldNames) != 2 || data.VisibleFieldNames[1].CFID != "cf[10002]" || len(data.VisibleFunctionNames) != 1 {
+		t.Errorf("Unexpected autocomplete data: %+v", data)
+	}
+
+	suggestions, err := client.GetJQLSuggestions("status", "In")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if query.Get("fieldName") != "status" || query.Get("fieldValue") != "In" {
+		t.Errorf("Unexpected query: %v", query)
+	}
+	if len(suggestions) != 1 || suggestions[0].Value != `"In Progress"` {
+		t.Errorf("Unexpected suggestions: %+v", suggestions)
+	}
+}
+
+func TestJiraClient_Filters(t *testing.T) {
+	var requests []string
+	var bodies []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/rest/api/2/filter/favourite":
+			w.Write([]byte(`[{"id":"10000","name":"My bugs","jql":"type = Bug","favourite":true}]`))
+		case r.Method == "GET" && r.URL.Path == "/rest/api/2/filter/10000":
+			w.Write([]byte(`{"id":"10000","name":"My bugs","jql":"type = Bug","sharePermissions":[{"id":5,"type":"global"}]}`))
+		case r.Method == "POST" && r.URL.Path == "/rest/api/2/filter":
+			w.Write([]byte(`{"id":"10001","name":"New","jql":"project = TEST"}`))
+		case r.Method == "PUT" && r.URL.Path == "/rest/api/2/filter/10001":
+			w.Write([]byte(`{"id":"10001","name":"Renamed","jql":"project = TEST"}`))
+		case r.Method == "POST" && r.URL.Path == "/rest/api/2/filter/10001/permission":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`[{"id":6,"type":"group","group":{"name":"jira-users"}}]`))
+		case r.Method == "DELETE" && r.URL.Path == "/rest/api/2/filter/10001/permission/5":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	favourites, err := client.GetFavouriteFilters()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(favourites) != 1 || favourites[0].JQL != "type = Bug" || favourites[0].SharePermissions == nil {
+		t.Errorf("Unexpected favourites: %+v", favourites)
+	}
+
+	filter, err := client.GetFilter("10000")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if filter.Name != "My bugs" || len(filter.SharePermissions) != 1 || filter.SharePermissions[0].Type != domain.ShareGlobal {
+		t.Errorf("Unexpected filter: %+v", filter)
+	}
+
+	favourite := true
+	created, err := client.CreateFilter(&domain.FilterRequest{Name: "New", JQL: "project = TEST", Favourite: &favourite})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if created.ID != "10001" || bodies[2]["favourite"] != true || bodies[2]["name"] != "New" {
+		t.Errorf("Unexpected create: %+v %v", created, bodies[2])
+	}
+
+	updated, err := client.UpdateFilter("10001", &domain.FilterRequest{Name: "Renamed"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if updated.Name != "Renamed" || bodies[3]["jql"] != nil {
+		t.Errorf("Unexpected update: %+v %v", updated, bodies[3])
+	}
+
+	permissions, err := client.AddFilterPermission("10001", &domain.SharePermissionRequest{Type: domain.ShareGroup, GroupName: "jira-users"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(permissions) != 1 || permissions[0].Group.Name != "jira-users" || bodies[4]["groupname"] != "jira-users" {
+		t.Errorf("Unexpected permissions: %+v %v", permissions, bodies[4])
+	}
+
+	if err := client.DeleteFilterPermission("10001", "5"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestJiraClient_Dashboards(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/dashboard":
+			query = r.URL.Query()
+			w.Write([]byte(`{"startAt":0,"maxResults":20,"total":1,"dashboards":[{"id":"10100","name":"Team","view":"https://jira/secure/Dashboard.jspa?selectPageId=10100"}]}`))
+		case "/rest/api/2/dashboard/10100/gadget":
+			w.Write([]byte(`{"gadgets":[{"id":10200,"moduleKey":"com.atlassian.jira.gadgets:filter-results-gadget","title":"Open bugs","position":{"row":1,"column":0}}]}`))
+		case "/rest/api/2/dashboard/10100/items/10200/properties":
+			w.Write([]byte(`{"keys":[{"key":"config"}]}`))
+		case "/rest/api/2/dashboard/10100/items/10200/properties/config":
+			w.Write([]byte(`{"key":"config","value":{"filterId":"filter-10000"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	page, err := client.GetDashboards("favourite", 0, 20)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if query.Get("filter") != "favourite" || query.Get("maxResults") != "20" {
+		t.Errorf("Unexpected query: %v", query)
+	}
+	if page.Total != 1 || page.Dashboards[0].ID != "10100" {
+		t.Errorf("Unexpected dashboards: %+v", page)
+	}
+
+	gadgets, err := client.GetDashboardGadgets("10100")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(gadgets) != 1 || gadgets[0].ID != "10200" || gadgets[0].Position.Row != 1 {
+		t.Errorf("Unexpected gadgets: %+v", gadgets)
+	}
+
+	properties, err := client.GetDashboardItemProperties("10100", "10200")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if string(properties["config"]) != `{"filterId":"filter-10000"}` {
+		t.Errorf("Unexpected properties: %v", properties)
+	}
+}
+
+func TestJiraClient_ServiceDesk(t *testing.T) {
+	var requests []string
+	var created map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-ExperimentalApi") != "opt-in" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		requests = append(requests, r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery)
+		switch {
+		case r.URL.Path == "/rest/servicedeskapi/servicedesk":
+			w.Write([]byte(`{"size":1,"start":0,"limit":50,"isLastPage":true,"values":[{"id":"1","projectId":"10100","projectKey":"HELP","projectName":"Help Desk"}]}`))
+		case r.URL.Path == "/rest/servicedeskapi/servicedesk/1/requesttype/10/field":
+			w.Write([]byte(`{"requestTypeFields":[{"fieldId":"summary","name":"Summary","required":true}],"canRaiseOnBehalfOf":true}`))
+		case r.Method == "POST" && r.URL.Path == "/rest/servicedeskapi/request":
+			json.NewDecoder(r.Body).Decode(&created)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"issueId":"20000","issueKey":"HELP-1","requestTypeId":"10","serviceDeskId":"1","currentStatus":{"status":"Waiting for support"}}`))
+		case r.URL.Path == "/rest/servicedeskapi/request/HELP-1/comment":
+			w.Write([]byte(`{"size":0,"start":0,"limit":50,"isLastPage":true}`))
+		case r.URL.Path == "/rest/servicedeskapi/request/HELP-1/sla":
+			w.Write([]byte(`{"values":[{"id":"1","name":"Time to first response","ongoingCycle":{"breached":false,"remainingTime":{"millis":3600000,"friendly":"1h"}}}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+
+	desks, err := client.GetServiceDesks(0, 10)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(desks.Values) != 1 || desks.Values[0].ProjectKey != "HELP" || !desks.IsLastPage || requests[0] != "GET /rest/servicedeskapi/servicedesk?limit=10" {
+		t.Errorf("Unexpected service desks: %+v %v", desks, requests)
+	}
+
+	fields, err := client.GetRequestTypeFields("1", "10")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(fields.RequestTypeFields) != 1 || !fields.CanRaiseOnBehalfOf {
+		t.Errorf("Unexpected fields: %+v", fields)
+	}
+
+	request, err := client.CreateCustomerRequest(&domain.CustomerRequestCreate{
+		ServiceDeskID:      "1",
+		RequestTypeID:      "10",
+		RequestFieldValues: map[string]interface{}{"summary": "Printer on fire"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if request.IssueKey != "HELP-1" || created["requestTypeId"] != "10" || created["requestFieldValues"].(map[string]interface{})["summary"] != "Printer on fire" {
+		t.Errorf("Unexpected request: %+v, body %v", request, created)
+	}
+
+	comments, err := client.GetRequestComments("HELP-1", &RequestCommentOptions{Public: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if comments.Values == nil || !strings.HasSuffix(requests[len(requests)-1], "?internal=false&public=true") {
+		t.Errorf("Unexpected comments: %+v %v", comments, requests)
+	}
+
+	slas, err := client.GetRequestSLA("HELP-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(slas.Values) != 1 || slas.Values[0].OngoingCycle.RemainingTime.Millis != 3600000 || slas.Values[0].CompletedCycles == nil {
+		t.Errorf("Unexpected SLAs: %+v", slas)
+	}
+
+	if _, err := client.GetQueues("2", true, 0, 0); err == nil || !strings.Contains(err.Error(), "status 404") {
+		t.Errorf("Expected API error, got %v", err)
+	}
+}
+
+// setupEscapedPathServer records the escaped path of every request and answers 404.
+func setupEscapedPathServer(paths *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*paths = append(*paths, r.URL.EscapedPath())
+		w.WriteHeader(http.StatusNotFound)
+	}))
+}
+
+func TestJiraClient_GetTransitions_EscapesIssueKey(t *testing.T) {
+	var paths []string
+	server := setupEscapedPathServer(&paths)
+	defer server.Close()
+
+	NewJiraClient(server.URL, getAuthenticatedClient()).GetTransitions("TEST-1/../2")
+	if len(paths) != 1 || paths[0] != "/rest/api/2/issue/TEST-1%2F..%2F2/transitions" {
+		t.Errorf("unexpected paths: %v", paths)
+	}
+}
+
+func TestJiraClient_CommentEndpoints_EscapePathSegments(t *testing.T) {
+	var paths []string
+	server := setupEscapedPathServer(&paths)
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	client.GetComments("TEST-1?x", nil)
+	client.UpdateComment("TEST-1", "10/../20", &domain.Comment{Body: "x"})
+	client.DeleteComment("TEST-1", "10?x")
+	want := []string{
+		"/rest/api/2/issue/TEST-1%3Fx/comment",
+		"/rest/api/2/issue/TEST-1/comment/10%2F..%2F20",
+		"/rest/api/2/issue/TEST-1/comment/10%3Fx",
+	}
+	if strings.Join(paths, " ") != strings.Join(want, " ") {
+		t.Errorf("paths = %v, want %v", paths, want)
+	}
+}
+
+func TestJiraClient_DeleteIssueLink_EscapesLinkID(t *testing.T) {
+	var paths []string
+	server := setupEscapedPathServer(&paths)
+	defer server.Close()
+
+	NewJiraClient(server.URL, getAuthenticatedClient()).DeleteIssueLink("100/../../issue/TEST-1")
+	if len(paths) != 1 || paths[0] != "/rest/api/2/issueLink/100%2F..%2F..%2Fissue%2FTEST-1" {
+		t.Errorf("unexpected paths: %v", paths)
+	}
+}
+
+func TestJiraClient_AttachmentEndpoints_EscapePathSegments(t *testing.T) {
+	var paths []string
+	server := setupEscapedPathServer(&paths)
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	client.GetAttachments("TEST-1/..")
+	client.GetAttachment("10?x")
+	client.AddAttachment("TEST-1/..", "a.txt", []byte("a"))
+	want := []string{
+		"/rest/api/2/issue/TEST-1%2F..",
+		"/rest/api/2/attachment/10%3Fx",
+		"/rest/api/2/issue/TEST-1%2F../attachments",
+	}
+	if strings.Join(paths, " ") != strings.Join(want, " ") {
+		t.Errorf("paths = %v, want %v", paths, want)
+	}
+}
+
+func TestJiraClient_WorklogEndpoints_EscapePathSegments(t *testing.T) {
+	var paths []string
+	server := setupEscapedPathServer(&paths)
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	client.GetWorklogs("TEST-1/..")
+	client.AddWorklog("TEST-1?x", &domain.Worklog{}, nil)
+	client.UpdateWorklog("TEST-1", "5/../6", &domain.Worklog{}, nil)
+	client.DeleteWorklog("TEST-1", "5?x", nil)
+	client.GetTimeTracking("TEST-1/..")
+	want := []string{
+		"/rest/api/2/issue/TEST-1%2F../worklog",
+		"/rest/api/2/issue/TEST-1%3Fx/worklog",
+		"/rest/api/2/issue/TEST-1/worklog/5%2F..%2F6",
+		"/rest/api/2/issue/TEST-1/worklog/5%3Fx",
+		"/rest/api/2/issue/TEST-1%2F..",
+	}
+	if strings.Join(paths, " ") != strings.Join(want, " ") {
+		t.Errorf("paths = %v, want %v", paths, want)
+	}
+}
+
+func TestJiraClient_ProjectEndpoints_EscapePathSegments(t *testing.T) {
+	var paths []string
+	server := setupEscapedPathServer(&paths)
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	client.GetProject("TEST/..")
+	client.GetProjectVersions("TEST?x")
+	client.UpdateVersion("10/../11", &domain.JiraVersionRequest{})
+	client.GetVersionIssueCounts("10?x")
+	client.GetProjectComponents("TEST/..")
+	want := []string{
+		"/rest/api/2/project/TEST%2F..",
+		"/rest/api/2/project/TEST%3Fx/versions",
+		"/rest/api/2/version/10%2F..%2F11",
+		"/rest/api/2/version/10%3Fx/relatedIssueCounts",
+		"/rest/api/2/project/TEST%2F../components",
+	}
+	if strings.Join(paths, " ") != strings.Join(want, " ") {
+		t.Errorf("paths = %v, want %v", paths, want)
+	}
+}
+
+func TestJiraClient_GetChangelog_EscapesIssueKey(t *testing.T) {
+	var paths []string
+	server := setupEscapedPathServer(&paths)
+	defer server.Close()
+
+	NewJiraClient(server.URL, getAuthenticatedClient()).GetChangelog("TEST-1/..", 0, 10)
+	if len(paths) == 0 || paths[0] != "/rest/api/2/issue/TEST-1%2F../changelog" {
+		t.Errorf("unexpected paths: %v", paths)
+	}
+	for _, path := range paths[1:] {
+		if path != "/rest/api/2/issue/TEST-1%2F.." {
+			t.Errorf("unexpected fallback path: %s", path)
+		}
+	}
+}
+
+func TestJiraClient_WatcherEndpoints_EscapeIssueKey(t *testing.T) {
+	var paths []string
+	server := setupEscapedPathServer(&paths)
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, getAuthenticatedClient())
+	client.GetWatchers("TEST-1/..")
+	client.AddWatcher("TEST-1/..", "jdoe")
+	client.RemoveWatcher("TEST-1/..", "jdoe")
+	client.GetVotes("TEST-1?x")
+	client.AddVote("TEST-1?x")
+	client.Notify("TEST-1/..", &domain.Notification{})
+	want := []string{
+		"/rest/api/2/issue/TEST-1%2F../watchers",
+		"/rest/api/2/issue/TEST-1%2F../watchers",
+		"/rest/api/2/issue/TEST-1%2F../watchers",
+		"/rest/api/2/issue/TEST-1%3Fx/votes",
+		"/rest/api/2/issue/TEST-1%3Fx/votes",
+		"/rest/api/2/issue/TEST-1%2F../notify",
+	}
+	if strings.Join(paths, " ") != strings.Join(want, " ") {
+		t.Errorf("paths = %v, want %v", paths, want)
+	}
+}
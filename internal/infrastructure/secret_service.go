@@ -0,0 +1,205 @@
+package infrastructure
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// Names of the freedesktop.org Secret Service D-Bus API.
+const (
+	secretServiceName     = "org.freedesktop.secrets"
+	secretServicePath     = dbus.ObjectPath("/org/freedesktop/secrets")
+	secretServiceIface    = "org.freedesktop.Secret.Service"
+	secretSessionIface    = "org.freedesktop.Secret.Session"
+	secretCollectionIface = "org.freedesktop.Secret.Collection"
+	secretItemIface       = "org.freedesktop.Secret.Item"
+	secretPromptIface     = "org.freedesktop.Secret.Prompt"
+
+	// noPrompt is the prompt path returned when no user interaction is needed.
+	noPrompt = dbus.ObjectPath("/")
+)
+
+// secretService is the part of the Secret Service API used by KeyringCredentialStore.
+type secretService interface {
+	// search returns the items whose attributes include attrs, unlocking them if needed.
+	search(attrs map[string]string) ([]dbus.ObjectPath, error)
+	// secret returns the secret value of an item.
+	secret(item dbus.ObjectPath) ([]byte, error)
+	// attributes returns the lookup attributes of an item.
+	attributes(item dbus.ObjectPath) (map[string]string, error)
+	// store creates an item in the default collection, replacing the item with the same attributes.
+	store(label string, attrs map[string]string, secret []byte) error
+	// delete removes an item.
+	delete(item dbus.ObjectPath) error
+	// close ends the session and releases the connection.
+	close()
+}
+
+// dbusSecret is the Secret structure of the Secret Service API (signature (oayays)).
+type dbusSecret struct {
+	Session     dbus.ObjectPath
+	Parameters  []byte
+	Value       []byte
+	ContentType string
+}
+
+// dbusSecretService talks to the Secret Service provider (GNOME Keyring, KWallet,
+// KeePassXC, ...) on the D-Bus session bus. Secrets are transferred with the "plain"
+// algorithm, which relies on the session bus being private to the user.
+type dbusSecretService struct {
+	conn    *dbus.Conn
+	session dbus.ObjectPath
+}
+
+// connectSecretService connects to the session bus and opens a Secret Service session.
+// Returns an error explaining what is missing if there is no session bus or no
+// Secret Service provider, as on most headless systems and in containers.
+func connectSecretService() (secretService, error) {
+	conn, err := dbus.ConnectSessionBus()
+	if err != nil {
+		return nil, fmt.Errorf("keyring unavailable: cannot connect to the D-Bus session bus (use the file backend on headless systems): %w", err)
+	}
+
+	var output dbus.Variant
+	var session dbus.ObjectPath
+	err = conn.Object(secretServiceName, secretServicePath).
+		Call(secretServiceIface+".OpenSession", 0, "plain", dbus.MakeVariant("")).
+		Store(&output, &session)
+	if err != nil {
+		conn.Close()
+		var dbusErr dbus.Error
+		if errors.As(err, &dbusErr) && dbusErr.Name == "org.freedesktop.DBus.Error.ServiceUnknown" {
+			return nil, fmt.Errorf("keyring unavailable: no Secret Service provider (e.g. GNOME Keyring, KWallet or KeePassXC) is running on the D-Bus session bus")
+		}
+		return nil, fmt.Errorf("failed to open a Secret Service session: %w", err)
+	}
+
+	return &dbusSecretService{conn: conn, session: session}, nil
+}
+
+// object returns the Secret Service object at path.
+func (s *dbusSecretService) object(path dbus.ObjectPath) dbus.BusObject {
+	return s.conn.Object(secretServiceName, path)
+}
+
+// search returns the items whose attributes include attrs, unlocking them if needed.
+func (s *dbusSecretService) search(attrs map[string]string) ([]dbus.ObjectPath, error) {
+	var unlocked, locked []dbus.ObjectPath
+	if err := s.object(secretServicePath).Call(secretServiceIface+".SearchItems", 0, attrs).Store(&unlocked, &locked); err != nil {
+		return nil, fmt.Errorf("keyring search failed: %w", err)
+	}
+	if len(locked) > 0 {
+		if err := s.unlock(locked); err != nil {
+			return nil, err
+		}
+	}
+	return append(unlocked, locked...), nil
+}
+
+// secret returns the secret value of an item.
+func (s *dbusSecretService) secret(item dbus.ObjectPath) ([]byte, error) {
+	var secret dbusSecret
+	if err := s.object(item).Call(secretItemIface+".GetSecret", 0, s.session).Store(&secret); err != nil {
+		return nil, fmt.Errorf("keyring lookup failed: %w", err)
+	}
+	return secret.Value, nil
+}
+
+// attributes returns the lookup attributes of an item.
+func (s *dbusSecretService) attributes(item dbus.ObjectPath) (map[string]string, error) {
+	value, err := s.object(item).GetProperty(secretItemIface + ".Attributes")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keyring item attributes: %w", err)
+	}
+	attrs, ok := value.Value().(map[string]string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected keyring item attributes: %v", value)
+	}
+	return attrs, nil
+}
+
+// store creates an item in the default collection, replacing the item with the same attributes.
+func (s *dbusSecretService) store(label string, attrs map[string]string, secret []byte) error {
+	var collection dbus.ObjectPath
+	if err := s.object(secretServicePath).Call(secretServiceIface+".ReadAlias", 0, "default").Store(&collection); err != nil {
+		return fmt.Errorf("failed to find the default keyring: %w", err)
+	}
+	if collection == noPrompt {
+		return fmt.Errorf("keyring has no default collection")
+	}
+	if err := s.unlock([]dbus.ObjectPath{collection}); err != nil {
+		return err
+	}
+
+	properties := map[string]dbus.Variant{
+		secretItemIface + ".Label":      dbus.MakeVariant(label),
+		secretItemIface + ".Attributes": dbus.MakeVariant(attrs),
+	}
+	value := dbusSecret{Session: s.session, Value: secret, ContentType: "application/json"}
+	var item, prompt dbus.ObjectPath
+	if err := s.object(collection).Call(secretCollectionIface+".CreateItem", 0, properties, value, true).Store(&item, &prompt); err != nil {
+		return fmt.Errorf("keyring store failed: %w", err)
+	}
+	return s.prompt(prompt)
+}
+
+// delete removes an item.
+func (s *dbusSecretService) delete(item dbus.ObjectPath) error {
+	var prompt dbus.ObjectPath
+	if err := s.object(item).Call(secretItemIface+".Delete", 0).Store(&prompt); err != nil {
+		return fmt.Errorf("keyring delete failed: %w", err)
+	}
+	return s.prompt(prompt)
+}
+
+// close ends the session and releases the connection.
+func (s *dbusSecretService) close() {
+	s.object(s.session).Call(secretSessionIface+".Close", 0)
+	s.conn.Close()
+}
+
+// unlock unlocks items or collections, prompting the user for the keyring password if needed.
+func (s *dbusSecretService) unlock(objects []dbus.ObjectPath) error {
+	var unlocked []dbus.ObjectPath
+	var prompt dbus.ObjectPath
+	if err := s.object(secretServicePath).Call(secretServiceIface+".Unlock", 0, objects).Store(&unlocked, &prompt); err != nil {
+		return fmt.Errorf("failed to unlock the keyring: %w", err)
+	}
+	return s.prompt(prompt)
+}
+
+// prompt shows a Secret Service prompt and waits until the user completes it.
+func (s *dbusSecretService) prompt(path dbus.ObjectPath) error {
+	if path == noPrompt || path == "" {
+		return nil
+	}
+
+	match := []dbus.MatchOption{
+		dbus.WithMatchObjectPath(path),
+		dbus.WithMatchInterface(secretPromptIface),
+		dbus.WithMatchMember("Completed"),
+	}
+	if err := s.conn.AddMatchSignal(match...); err != nil {
+		return fmt.Errorf("failed to watch the keyring prompt: %w", err)
+	}
+	defer s.conn.RemoveMatchSignal(match...)
+	signals := make(chan *dbus.Signal, 1)
+	s.conn.Signal(signals)
+	defer s.conn.RemoveSignal(signals)
+
+	if err := s.object(path).Call(secretPromptIface+".Prompt", 0, "").Err; err != nil {
+		return fmt.Errorf("failed to show the keyring prompt: %w", err)
+	}
+	for signal := range signals {
+		if signal.Path != path || signal.Name != secretPromptIface+".Completed" || len(signal.Body) == 0 {
+			continue
+		}
+		if dismissed, _ := signal.Body[0].(bool); dismissed {
+			return fmt.Errorf("keyring prompt was dismissed")
+		}
+		return nil
+	}
+	return fmt.Errorf("keyring connection closed while waiting for the prompt")
+}
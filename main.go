@@ -7,6 +7,7 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	"atlassian-mcp-server/internal/application"
@@ -14,7 +15,24 @@ import (
 	"atlassian-mcp-server/internal/infrastructure"
 )
 
+// subcommands maps subcommand names to their implementations.
+var subcommands = map[string]func(args []string) error{
+	"credentials":     func(args []string) error { return runCredentialsCommand(args, os.Stdin, os.Stdout) },
+	"validate-config": func(args []string) error { return runValidateConfigCommand(args, os.Stdout) },
+	"config-schema":   func(args []string) error { return runConfigSchemaCommand(os.Stdout) },
+}
+
 func main() {
+	// Dispatch subcommands before parsing server flags
+	if len(os.Args) > 1 {
+		if run, ok := subcommands[os.Args[1]]; ok {
+			if err := run(os.Args[2:]); err != nil {
+				log.Fatalf("%s: %v", os.Args[1], err)
+			}
+			return
+		}
+	}
+
 	// Parse command-line flags
 	configPath := flag.String("config", "config.yaml", "Path to configuration file")
 	flag.Parse()
@@ -28,8 +46,31 @@ func main() {
 
 	log.Println("Configuration loaded successfully")
 
+	// Warn loudly about disabled certificate verification
+	for name, tc := range map[string]*domain.ToolConfig{
+		"Jira":       config.Tools.Jira,
+		"Confluence": config.Tools.Confluence,
+		"Bitbucket":  config.Tools.Bitbucket,
+		"Bamboo":     config.Tools.Bamboo,
+	} {
+		if tc != nil && tc.TLS != nil && tc.TLS.InsecureSkipVerify {
+			log.Printf("WARNING: TLS certificate verification is disabled for %s (tls.insecure_skip_verify)", name)
+		}
+	}
+
+	// Open the credential store, if one is configured
+	var credentialStore domain.CredentialProvider
+	if config.Credentials.Backend != "" {
+		store, err := infrastructure.NewCredentialStore(config.Credentials)
+		if err != nil {
+			log.Fatalf("Failed to open credential store: %v", err)
+		}
+		credentialStore = store
+		log.Printf("Credential store initialized (%s backend)", config.Credentials.Backend)
+	}
+
 	// Create authentication manager
-	authManager := domain.NewAuthenticationManagerFromConfig(config)
+	authManager := domain.NewAuthenticationManagerFromConfigWithProvider(config, credentialStore)
 	log.Println("Authentication manager initialized")
 
 	// Create response mapper
@@ -51,6 +92,11 @@ func main() {
 				log.Fatalf("Failed to create authenticated client for Jira: %v", err)
 			}
 			jiraClient = infrastructure.NewJiraClient(config.Tools.Jira.BaseURL, httpClient)
+
+			// Without working default credentials, Jira still serves client-provided credentials
+			if config.Startup.VerifyCredentials && !verifyCredentials("jira", jiraClient, config.Startup) {
+				jiraClient = nil
+			}
 		} else {
 			// No default credentials - client must provide credentials
 			log.Println("Jira configured without default credentials - clients must provide auth")
@@ -58,6 +104,7 @@ func main() {
 		}
 
 		jiraHandler := application.NewJiraHandler(jiraClient, mapper, authManager, config.Tools.Jira.BaseURL)
+		jiraHandler.SetMaxSearchResults(config.Tools.Jira.MaxSearchResults)
 		handlers = append(handlers, jiraHandler)
 		log.Println("Jira handler registered")
 	}
@@ -70,9 +117,11 @@ func main() {
 			log.Fatalf("Failed to create authenticated client for Confluence: %v", err)
 		}
 		confluenceClient := infrastructure.NewConfluenceClient(config.Tools.Confluence.BaseURL, httpClient)
-		confluenceHandler := application.NewConfluenceHandler(confluenceClient, mapper)
-		handlers = append(handlers, confluenceHandler)
-		log.Println("Confluence handler registered")
+		if !config.Startup.VerifyCredentials || verifyCredentials("confluence", confluenceClient, config.Startup) {
+			confluenceHandler := application.NewConfluenceHandler(confluenceClient, mapper)
+			handlers = append(handlers, confluenceHandler)
+			log.Println("Confluence handler registered")
+		}
 	}
 
 	// Bitbucket
@@ -83,9 +132,11 @@ func main() {
 			log.Fatalf("Failed to create authenticated client for Bitbucket: %v", err)
 		}
 		bitbucketClient := infrastructure.NewBitbucketClient(config.Tools.Bitbucket.BaseURL, httpClient)
-		bitbucketHandler := application.NewBitbucketHandler(bitbucketClient, mapper)
-		handlers = append(handlers, bitbucketHandler)
-		log.Println("Bitbucket handler registered")
+		if !config.Startup.VerifyCredentials || verifyCredentials("bitbucket", bitbucketClient, config.Startup) {
+			bitbucketHandler := application.NewBitbucketHandler(bitbucketClient, mapper)
+			handlers = append(handlers, bitbucketHandler)
+			log.Println("Bitbucket handler registered")
+		}
 	}
 
 	// Bamboo
@@ -96,9 +147,11 @@ func main() {
 			log.Fatalf("Failed to create authenticated client for Bamboo: %v", err)
 		}
 		bambooClient := infrastructure.NewBambooClient(config.Tools.Bamboo.BaseURL, httpClient)
-		bambooHandler := application.NewBambooHandler(bambooClient, mapper)
-		handlers = append(handlers, bambooHandler)
-		log.Println("Bamboo handler registered")
+		if !config.Startup.VerifyCredentials || verifyCredentials("bamboo", bambooClient, config.Startup) {
+			bambooHandler := application.NewBambooHandler(bambooClient, mapper)
+			handlers = append(handlers, bambooHandler)
+			log.Println("Bamboo handler registered")
+		}
 	}
 
 	// Verify at least one handler is registered
@@ -118,7 +171,14 @@ func main() {
 		transport = domain.NewStdioTransport()
 	case "http":
 		log.Printf("Initializing HTTP transport on %s:%d", config.Transport.HTTP.Host, config.Transport.HTTP.Port)
-		transport = domain.NewHTTPTransport(config.Transport.HTTP.Host, config.Transport.HTTP.Port)
+		httpTransport, err := domain.NewHTTPTransportFromConfig(config.Transport.HTTP)
+		if err != nil {
+			log.Fatalf("Failed to initialize HTTP transport: %v", err)
+		}
+		transport = httpTransport
+		if config.Transport.HTTP.Auth == nil {
+			log.Println("WARNING: HTTP transport accepts unauthenticated clients (configure transport.http.auth)")
+		}
 	default:
 		log.Fatalf("Invalid transport type: %s", config.Transport.Type)
 	}
@@ -176,3 +236,33 @@ func main() {
 
 	log.Println("Server shutdown complete")
 }
+
+// identityResolver is implemented by every Atlassian client.
+type identityResolver interface {
+	WhoAmI() (*domain.Identity, error)
+}
+
+// verifyCredentials checks a tool's default credentials against its current-user endpoint
+// and logs the resolved identity. Exits if verification fails and the startup config
+// requires it; otherwise returns false so the caller can disable the tool.
+func verifyCredentials(tool string, client identityResolver, startup domain.StartupConfig) bool {
+	identity, err := client.WhoAmI()
+	if err != nil {
+		if startup.FailFast() {
+			log.Fatalf("Credential check failed for %s: %v", tool, err)
+		}
+		log.Printf("WARNING: credential check failed for %s, disabling default credentials: %v", tool, err)
+		return false
+	}
+
+	if identity.DisplayName != "" {
+		log.Printf("Authenticated to %s as %s (%s)", tool, identity.Username, identity.DisplayName)
+	} else {
+		log.Printf("Authenticated to %s as %s", tool, identity.Username)
+	}
+	if len(identity.Permissions) > 0 {
+		log.Printf("%s permissions: %s", tool, strings.Join(identity.Permissions, ", "))
+	}
+
+	return true
+}
@@ -69,7 +69,7 @@ func TestAuthenticationManagerCreation(t *testing.T) {
 		Tools: domain.ToolsConfig{
 			Jira: &domain.ToolConfig{
 				BaseURL: "https://jira.example.com",
-				Auth: domain.AuthConfig{
+				Auth: &domain.AuthConfig{
 					Type:     "basic",
 					Username: "testuser",
 					Password: "testpass",